@@ -4,11 +4,16 @@ package mianshiba
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	interviewAPI "mianshiba/api/model/interview"
 	"mianshiba/application/interview"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/hertz/pkg/protocol/sse"
 )
 
 // GetResumeUploadUrl .
@@ -58,11 +63,15 @@ func GetResumeDownloadUrl(ctx context.Context, c *app.RequestContext) {
 	var req interviewAPI.ResumeDownloadUrlRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(interviewAPI.ResumeDownloadUrlResponse)
+	resp, err := interview.InterviewApplicationSVC.GetResumeDownloadUrl(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -74,11 +83,15 @@ func GetResumeDeleteUrl(ctx context.Context, c *app.RequestContext) {
 	var req interviewAPI.ResumeDeleteUrlRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(interviewAPI.ResumeDeleteUrlResponse)
+	resp, err := interview.InterviewApplicationSVC.GetResumeDeleteUrl(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -90,11 +103,15 @@ func RecordResumeDeleteInfo(ctx context.Context, c *app.RequestContext) {
 	var req interviewAPI.ResumeDeleteInfoRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(interviewAPI.ResumeDeleteInfoResponse)
+	resp, err := interview.InterviewApplicationSVC.RecordResumeDeleteInfo(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -106,27 +123,532 @@ func GetResumeList(ctx context.Context, c *app.RequestContext) {
 	var req interviewAPI.ResumeListRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(interviewAPI.ResumeListResponse)
+	resp, err := interview.InterviewApplicationSVC.GetResumeList(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
 
 // GetResumeDetail .
-// @router /api/interview/resume/detail/:file_key [GET]
+// @router /api/interview/resume/detail/*file_key [GET]
 func GetResumeDetail(ctx context.Context, c *app.RequestContext) {
 	var err error
 	var req interviewAPI.ResumeDetailRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetResumeDetail(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// StartInterviewSession .
+// @router /api/interview/session/start [POST]
+func StartInterviewSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.StartInterviewSessionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.StartInterviewSession(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SubmitInterviewAnswer .
+// @router /api/interview/session/:id/answer [POST]
+func SubmitInterviewAnswer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.SubmitInterviewAnswerRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.SubmitInterviewAnswer(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetNextInterviewQuestion .
+// @router /api/interview/session/:id/next [GET]
+func GetNextInterviewQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewSessionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetNextInterviewQuestion(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// EndInterviewSession .
+// @router /api/interview/session/:id/end [POST]
+func EndInterviewSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewSessionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.EndInterviewSession(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// StreamInterviewQuestion .
+// @router /api/interview/session/:id/stream [GET]
+func StreamInterviewQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewSessionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	// 客户端断开后写入失败，取消上下文以中止智能体生成
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := sse.NewWriter(c)
+	defer w.Close()
+
+	turn, err := interview.InterviewApplicationSVC.StreamInterviewQuestion(ctx, &req, func(delta string) error {
+		if err := writeInterviewStreamEvent(w, "delta", &interviewAPI.InterviewStreamEvent{Delta: &delta}); err != nil {
+			cancel()
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		writeInterviewStreamError(ctx, w, err)
+		return
+	}
+
+	_ = writeInterviewStreamEvent(w, "done", &interviewAPI.InterviewStreamEvent{Turn: turn})
+}
+
+func writeInterviewStreamEvent(w *sse.Writer, eventType string, event *interviewAPI.InterviewStreamEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return w.WriteEvent("", eventType, data)
+}
+
+func writeInterviewStreamError(ctx context.Context, w *sse.Writer, err error) {
+	event := &interviewAPI.InterviewStreamEvent{Code: 500, Msg: "internal server error"}
+
+	var customErr errorx.StatusError
+	if errors.As(err, &customErr) && customErr.Code() != 0 {
+		logs.CtxWarnf(ctx, "[ErrorX] error:  %v %v \n", customErr.Code(), err)
+		event.Code = customErr.Code()
+		event.Msg = customErr.Msg()
+	} else {
+		logs.CtxErrorf(ctx, "[StreamInterviewQuestion] error: %v \n", err)
+	}
+
+	_ = writeInterviewStreamEvent(w, "error", event)
+}
+
+// GetInterviewEvaluation .
+// @router /api/interview/session/:id/evaluation [GET]
+func GetInterviewEvaluation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewSessionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetInterviewEvaluation(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateResumeProfile .
+// @router /api/interview/resume/profile [PUT]
+func UpdateResumeProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.UpdateResumeProfileRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.UpdateResumeProfile(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetResumeProfileVersions .
+// @router /api/interview/resume/profile/versions [GET]
+func GetResumeProfileVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.ResumeProfileVersionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetResumeProfileVersions(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ReparseResume .
+// @router /api/interview/resume/:file_key/reparse [POST]
+func ReparseResume(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.ReparseResumeRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.ReparseResume(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetJobDescriptionUploadUrl .
+// @router /api/interview/jd/upload/url [GET]
+func GetJobDescriptionUploadUrl(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobDescriptionUploadUrlRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetJobDescriptionUploadUrl(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateJobDescription .
+// @router /api/interview/jd/create [POST]
+func CreateJobDescription(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.CreateJobDescriptionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.CreateJobDescription(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetJobDescriptionList .
+// @router /api/interview/jd/list [GET]
+func GetJobDescriptionList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobDescriptionListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetJobDescriptionList(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetJobDescriptionDetail .
+// @router /api/interview/jd/details/:id [GET]
+func GetJobDescriptionDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobDescriptionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(interviewAPI.ResumeDetailResponse)
+	resp, err := interview.InterviewApplicationSVC.GetJobDescriptionDetail(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteJobDescription .
+// @router /api/interview/jd/delete/:id [DELETE]
+func DeleteJobDescription(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobDescriptionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.DeleteJobDescription(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ReparseJobDescription .
+// @router /api/interview/jd/:id/reparse [POST]
+func ReparseJobDescription(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobDescriptionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.ReparseJobDescription(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetJobMatchReport .
+// @router /api/interview/jd/:id/match [GET]
+func GetJobMatchReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.JobMatchRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetJobMatchReport(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetInterviewPlanList .
+// @router /api/interview/plan/list [GET]
+func GetInterviewPlanList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.EmptyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetInterviewPlanList(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetInterviewPlanDetail .
+// @router /api/interview/plan/details/:id [GET]
+func GetInterviewPlanDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewPlanIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetInterviewPlanDetail(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateInterviewPlan .
+// @router /api/interview/plan/create [POST]
+func CreateInterviewPlan(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.CreateInterviewPlanRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.CreateInterviewPlan(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateInterviewPlan .
+// @router /api/interview/plan/update/:id [PUT]
+func UpdateInterviewPlan(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.UpdateInterviewPlanRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.UpdateInterviewPlan(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteInterviewPlan .
+// @router /api/interview/plan/delete/:id [DELETE]
+func DeleteInterviewPlan(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewPlanIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.DeleteInterviewPlan(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetInterviewSessionRounds .
+// @router /api/interview/session/:id/rounds [GET]
+func GetInterviewSessionRounds(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.InterviewSessionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetInterviewSessionRounds(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetInterviewPersonaList .
+// @router /api/interview/panel/personas [GET]
+func GetInterviewPersonaList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req interviewAPI.EmptyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := interview.InterviewApplicationSVC.GetInterviewPersonaList(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
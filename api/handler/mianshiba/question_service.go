@@ -0,0 +1,199 @@
+// Code generated by hertz generator.
+
+package mianshiba
+
+import (
+	"context"
+	"fmt"
+	"io"
+	questionAPI "mianshiba/api/model/question"
+	"mianshiba/application/question"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// CreateQuestion .
+// @router /api/question/create [POST]
+func CreateQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.CreateQuestionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.CreateQuestion(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListQuestions .
+// @router /api/question/list [GET]
+func ListQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.ListQuestionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.ListQuestions(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetQuestion .
+// @router /api/question/details/:id [GET]
+func GetQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.QuestionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.GetQuestion(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateQuestion .
+// @router /api/question/update/:id [PUT]
+func UpdateQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.UpdateQuestionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.UpdateQuestion(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteQuestion .
+// @router /api/question/delete/:id [DELETE]
+func DeleteQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.QuestionIDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.DeleteQuestion(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListQuestionTags .
+// @router /api/question/tags [GET]
+func ListQuestionTags(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.ListQuestionTagsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.ListQuestionTags(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ImportQuestions .
+// @router /api/question/import [POST]
+func ImportQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.ImportQuestionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	// 题目文件通过 multipart 的 file 字段上传
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		invalidParamRequestResponse(c, "file is required")
+		return
+	}
+	if fileHeader.Size > question.MaxImportFileSize {
+		invalidParamRequestResponse(c, fmt.Sprintf("file size exceeds %d bytes", question.MaxImportFileSize))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	resp, err := question.QuestionApplicationSVC.ImportQuestions(ctx, &req, fileHeader.Filename, data)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ExportQuestions .
+// @router /api/question/export [GET]
+func ExportQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req questionAPI.ExportQuestionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	data, filename, contentType, err := question.QuestionApplicationSVC.ExportQuestions(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(consts.StatusOK, contentType, data)
+}
@@ -46,11 +46,15 @@ func ListUserModels(ctx context.Context, c *app.RequestContext) {
 	var req userAPI.ListUserModelsRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(userAPI.ListUserModelsResponse)
+	resp, err := user.UserApplicationSVC.ListUserModels(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -62,11 +66,15 @@ func GetUserModel(ctx context.Context, c *app.RequestContext) {
 	var req userAPI.IDRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(userAPI.GetUserModelResponse)
+	resp, err := user.UserApplicationSVC.GetUserModel(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -78,11 +86,15 @@ func UpdateUserModel(ctx context.Context, c *app.RequestContext) {
 	var req userAPI.UpdateUserModelRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(userAPI.UpdateUserModelResponse)
+	resp, err := user.UserApplicationSVC.UpdateUserModel(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -94,11 +106,15 @@ func DeleteUserModel(ctx context.Context, c *app.RequestContext) {
 	var req userAPI.IDRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(userAPI.DeleteUserModelResponse)
+	resp, err := user.UserApplicationSVC.DeleteUserModel(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -229,11 +245,15 @@ func CheckUserModelConfigured(ctx context.Context, c *app.RequestContext) {
 	var req userAPI.EmptyRequest
 	err = c.BindAndValidate(&req)
 	if err != nil {
-		c.String(consts.StatusBadRequest, err.Error())
+		invalidParamRequestResponse(c, err.Error())
 		return
 	}
 
-	resp := new(userAPI.CheckUserModelConfiguredResponse)
+	resp, err := user.UserApplicationSVC.CheckUserModelConfigured(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
 
 	c.JSON(consts.StatusOK, resp)
 }
@@ -297,3 +317,63 @@ func Logout(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// TestUserModel .
+// @router /api/user/model/:id/test [POST]
+func TestUserModel(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req userAPI.IDRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := user.UserApplicationSVC.TestUserModel(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// TestUserModelParams .
+// @router /api/user/model/test [POST]
+func TestUserModelParams(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req userAPI.TestUserModelParamsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := user.UserApplicationSVC.TestUserModelParams(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetUsage .
+// @router /api/user/usage [GET]
+func GetUsage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req userAPI.GetUsageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		invalidParamRequestResponse(c, err.Error())
+		return
+	}
+
+	resp, err := user.UserApplicationSVC.GetUsage(ctx, &req)
+	if err != nil {
+		internalServerErrorResponse(ctx, c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
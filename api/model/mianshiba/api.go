@@ -5,6 +5,7 @@ package mianshiba
 import (
 	"github.com/apache/thrift/lib/go/thrift"
 	"mianshiba/api/model/interview"
+	"mianshiba/api/model/question"
 	"mianshiba/api/model/user"
 )
 
@@ -60,6 +61,32 @@ func NewInterviewServiceClient(c thrift.TClient) *InterviewServiceClient {
 	}
 }
 
+type QuestionService interface {
+	question.QuestionService
+}
+
+type QuestionServiceClient struct {
+	*question.QuestionServiceClient
+}
+
+func NewQuestionServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *QuestionServiceClient {
+	return &QuestionServiceClient{
+		QuestionServiceClient: question.NewQuestionServiceClientFactory(t, f),
+	}
+}
+
+func NewQuestionServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *QuestionServiceClient {
+	return &QuestionServiceClient{
+		QuestionServiceClient: question.NewQuestionServiceClientProtocol(t, iprot, oprot),
+	}
+}
+
+func NewQuestionServiceClient(c thrift.TClient) *QuestionServiceClient {
+	return &QuestionServiceClient{
+		QuestionServiceClient: question.NewQuestionServiceClient(c),
+	}
+}
+
 type UserServiceProcessor struct {
 	*user.UserServiceProcessor
 }
@@ -77,3 +104,12 @@ func NewInterviewServiceProcessor(handler InterviewService) *InterviewServicePro
 	self := &InterviewServiceProcessor{interview.NewInterviewServiceProcessor(handler)}
 	return self
 }
+
+type QuestionServiceProcessor struct {
+	*question.QuestionServiceProcessor
+}
+
+func NewQuestionServiceProcessor(handler QuestionService) *QuestionServiceProcessor {
+	self := &QuestionServiceProcessor{question.NewQuestionServiceProcessor(handler)}
+	return self
+}
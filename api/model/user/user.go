@@ -3138,6 +3138,8 @@ func (p *UserModelDetail) String() string {
 // 获取用户模型详情响应
 type GetUserModelResponse struct {
 	Data *UserModelDetail `thrift:"data,1,required" form:"data,required" json:"data,required" query:"data,required"`
+	Code int32            `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg  string           `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
 }
 
 func NewGetUserModelResponse() *GetUserModelResponse {
@@ -3156,8 +3158,18 @@ func (p *GetUserModelResponse) GetData() (v *UserModelDetail) {
 	return p.Data
 }
 
+func (p *GetUserModelResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetUserModelResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
 var fieldIDToName_GetUserModelResponse = map[int16]string{
-	1: "data",
+	1:   "data",
+	253: "code",
+	254: "msg",
 }
 
 func (p *GetUserModelResponse) IsSetData() bool {
@@ -3169,6 +3181,8 @@ func (p *GetUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetData bool = false
+	var issetCode bool = false
+	var issetMsg bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3193,6 +3207,24 @@ func (p *GetUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 253:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField253(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField254(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3210,6 +3242,16 @@ func (p *GetUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCode {
+		fieldId = 253
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 254
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
@@ -3236,6 +3278,28 @@ func (p *GetUserModelResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Data = _field
 	return nil
 }
+func (p *GetUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *GetUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
 
 func (p *GetUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3247,6 +3311,14 @@ func (p *GetUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField253(oprot); err != nil {
+			fieldId = 253
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3282,6 +3354,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
+func (p *GetUserModelResponse) writeField253(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
+}
+
+func (p *GetUserModelResponse) writeField254(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+
 func (p *GetUserModelResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -4111,6 +4217,9 @@ func (p *UpdateUserModelRequest) String() string {
 
 // 更新用户模型响应
 type UpdateUserModelResponse struct {
+	Data *UserModelDetail `thrift:"data,1,required" form:"data,required" json:"data,required" query:"data,required"`
+	Code int32            `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg  string           `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
 }
 
 func NewUpdateUserModelResponse() *UpdateUserModelResponse {
@@ -4120,110 +4229,38 @@ func NewUpdateUserModelResponse() *UpdateUserModelResponse {
 func (p *UpdateUserModelResponse) InitDefault() {
 }
 
-var fieldIDToName_UpdateUserModelResponse = map[int16]string{}
-
-func (p *UpdateUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *UpdateUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("UpdateUserModelResponse"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
+var UpdateUserModelResponse_Data_DEFAULT *UserModelDetail
 
-func (p *UpdateUserModelResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateUserModelResponse) GetData() (v *UserModelDetail) {
+	if !p.IsSetData() {
+		return UpdateUserModelResponse_Data_DEFAULT
 	}
-	return fmt.Sprintf("UpdateUserModelResponse(%+v)", *p)
-
-}
-
-// ==================== 5. 删除用户模型 ====================
-// 删除用户模型响应
-type DeleteUserModelResponse struct {
-	Code int32  `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
-	Msg  string `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
-}
-
-func NewDeleteUserModelResponse() *DeleteUserModelResponse {
-	return &DeleteUserModelResponse{}
-}
-
-func (p *DeleteUserModelResponse) InitDefault() {
+	return p.Data
 }
 
-func (p *DeleteUserModelResponse) GetCode() (v int32) {
+func (p *UpdateUserModelResponse) GetCode() (v int32) {
 	return p.Code
 }
 
-func (p *DeleteUserModelResponse) GetMsg() (v string) {
+func (p *UpdateUserModelResponse) GetMsg() (v string) {
 	return p.Msg
 }
 
-var fieldIDToName_DeleteUserModelResponse = map[int16]string{
+var fieldIDToName_UpdateUserModelResponse = map[int16]string{
+	1:   "data",
 	253: "code",
 	254: "msg",
 }
 
-func (p *DeleteUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateUserModelResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *UpdateUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetData bool = false
 	var issetCode bool = false
 	var issetMsg bool = false
 
@@ -4241,6 +4278,15 @@ func (p *DeleteUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 253:
 			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField253(iprot); err != nil {
@@ -4272,6 +4318,11 @@ func (p *DeleteUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetData {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
 	if !issetCode {
 		fieldId = 253
 		goto RequiredFieldNotSetError
@@ -4287,7 +4338,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserModelResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserModelResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4296,10 +4347,18 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteUserModelResponse[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateUserModelResponse[fieldId]))
 }
 
-func (p *DeleteUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
+func (p *UpdateUserModelResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserModelDetail()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *UpdateUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -4310,7 +4369,7 @@ func (p *DeleteUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
-func (p *DeleteUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
+func (p *UpdateUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4322,12 +4381,16 @@ func (p *DeleteUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteUserModelResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserModelResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 		if err = p.writeField253(oprot); err != nil {
 			fieldId = 253
 			goto WriteFieldError
@@ -4354,7 +4417,24 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteUserModelResponse) writeField253(oprot thrift.TProtocol) (err error) {
+func (p *UpdateUserModelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Data.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateUserModelResponse) writeField253(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4371,7 +4451,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
 }
 
-func (p *DeleteUserModelResponse) writeField254(oprot thrift.TProtocol) (err error) {
+func (p *UpdateUserModelResponse) writeField254(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4388,16 +4468,219 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
 }
 
-func (p *DeleteUserModelResponse) String() string {
+func (p *UpdateUserModelResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteUserModelResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateUserModelResponse(%+v)", *p)
 
 }
 
-// ==================== 6. 用户认证与资料相关 ====================
-// 注册请求
+// ==================== 5. 删除用户模型 ====================
+// 删除用户模型响应
+type DeleteUserModelResponse struct {
+	Code int32  `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg  string `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
+}
+
+func NewDeleteUserModelResponse() *DeleteUserModelResponse {
+	return &DeleteUserModelResponse{}
+}
+
+func (p *DeleteUserModelResponse) InitDefault() {
+}
+
+func (p *DeleteUserModelResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *DeleteUserModelResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_DeleteUserModelResponse = map[int16]string{
+	253: "code",
+	254: "msg",
+}
+
+func (p *DeleteUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCode bool = false
+	var issetMsg bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 253:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField253(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField254(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCode {
+		fieldId = 253
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 254
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteUserModelResponse[fieldId]))
+}
+
+func (p *DeleteUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *DeleteUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *DeleteUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteUserModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField253(oprot); err != nil {
+			fieldId = 253
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteUserModelResponse) writeField253(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
+}
+
+func (p *DeleteUserModelResponse) writeField254(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+
+func (p *DeleteUserModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteUserModelResponse(%+v)", *p)
+
+}
+
+// ==================== 6. 用户认证与资料相关 ====================
+// 注册请求
 type RegisterRequest struct {
 	Username string `thrift:"username,1,required" form:"username,required" json:"username,required"`
 	Email    string `thrift:"email,2,required" form:"email,required" json:"email,required"`
@@ -7244,7 +7527,9 @@ func (p *ResetPasswordRequest) String() string {
 // ==================== 7. 检查用户是否配置了模型 ====================
 type CheckUserModelConfiguredResponse struct {
 	// 是否已配置并启用默认模型（is_default = 1）
-	Configured bool `thrift:"configured,1,required" form:"configured,required" json:"configured,required" query:"configured,required"`
+	Configured bool   `thrift:"configured,1,required" form:"configured,required" json:"configured,required" query:"configured,required"`
+	Code       int32  `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg        string `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
 }
 
 func NewCheckUserModelConfiguredResponse() *CheckUserModelConfiguredResponse {
@@ -7258,8 +7543,18 @@ func (p *CheckUserModelConfiguredResponse) GetConfigured() (v bool) {
 	return p.Configured
 }
 
+func (p *CheckUserModelConfiguredResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *CheckUserModelConfiguredResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
 var fieldIDToName_CheckUserModelConfiguredResponse = map[int16]string{
-	1: "configured",
+	1:   "configured",
+	253: "code",
+	254: "msg",
 }
 
 func (p *CheckUserModelConfiguredResponse) Read(iprot thrift.TProtocol) (err error) {
@@ -7267,6 +7562,8 @@ func (p *CheckUserModelConfiguredResponse) Read(iprot thrift.TProtocol) (err err
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetConfigured bool = false
+	var issetCode bool = false
+	var issetMsg bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -7291,6 +7588,24 @@ func (p *CheckUserModelConfiguredResponse) Read(iprot thrift.TProtocol) (err err
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 253:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField253(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField254(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7308,6 +7623,16 @@ func (p *CheckUserModelConfiguredResponse) Read(iprot thrift.TProtocol) (err err
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCode {
+		fieldId = 253
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 254
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
@@ -7337,16 +7662,46 @@ func (p *CheckUserModelConfiguredResponse) ReadField1(iprot thrift.TProtocol) er
 	p.Configured = _field
 	return nil
 }
+func (p *CheckUserModelConfiguredResponse) ReadField253(iprot thrift.TProtocol) error {
 
-func (p *CheckUserModelConfiguredResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckUserModelConfiguredResponse"); err != nil {
-		goto WriteStructBeginError
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+	p.Code = _field
+	return nil
+}
+func (p *CheckUserModelConfiguredResponse) ReadField254(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *CheckUserModelConfiguredResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CheckUserModelConfiguredResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField253(oprot); err != nil {
+			fieldId = 253
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
+			goto WriteFieldError
 		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -7383,6 +7738,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
+func (p *CheckUserModelConfiguredResponse) writeField253(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
+}
+
+func (p *CheckUserModelConfiguredResponse) writeField254(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+
 func (p *CheckUserModelConfiguredResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -7391,267 +7780,4023 @@ func (p *CheckUserModelConfiguredResponse) String() string {
 
 }
 
-// 服务定义
-type UserService interface {
-	// 1. 创建用户模型
-	CreateUserModel(ctx context.Context, request *CreateUserModelRequest) (r *CreateUserModelResponse, err error)
-	// 2. 获取用户模型列表
-	ListUserModels(ctx context.Context, request *ListUserModelsRequest) (r *ListUserModelsResponse, err error)
-	// 3. 获取用户模型详情
-	GetUserModel(ctx context.Context, request *IDRequest) (r *GetUserModelResponse, err error)
-	// 4. 更新用户模型
-	UpdateUserModel(ctx context.Context, request *UpdateUserModelRequest) (r *UpdateUserModelResponse, err error)
-	// 5. 删除用户模型
-	DeleteUserModel(ctx context.Context, request *IDRequest) (r *DeleteUserModelResponse, err error)
-	// 6. 用户注册
-	Register(ctx context.Context, request *RegisterRequest) (r *LoginResponse, err error)
-	// 7. 用户登录
-	Login(ctx context.Context, request *LoginRequest) (r *LoginResponse, err error)
-	// 8. 获取用户资料
-	GetProfile(ctx context.Context, request *EmptyRequest) (r *GetProfileResponse, err error)
-	// 9. 更新用户资料
-	UpdateProfile(ctx context.Context, request *UpdateProfileRequest) (r *UpdateProfileResponse, err error)
-	// 10. 获取微信登录二维码
-	WechatLogin(ctx context.Context, request *EmptyRequest) (r *WechatLoginQRResponse, err error)
-	// 11. 微信登录回调
-	WechatCallback(ctx context.Context, request *WechatCallbackRequest) (r *LoginResponse, err error)
-	// 12. 检查用户是否配置了模型
-	CheckUserModelConfigured(ctx context.Context, request *EmptyRequest) (r *CheckUserModelConfiguredResponse, err error)
-	// 13. 忘记密码
-	ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (r *EmptyResponse, err error)
-	// 14. 重置密码
-	ResetPassword(ctx context.Context, request *ResetPasswordRequest) (r *BaseResponse, err error)
-	// 15. 退出登录
-	Logout(ctx context.Context, request *EmptyRequest) (r *BaseResponse, err error)
+// ==================== 8. 测试用户模型连通性 ====================
+// 测试未保存的模型参数请求
+type TestUserModelParamsRequest struct {
+	ModelKey string `thrift:"model_key,1,required" form:"model_key,required" json:"model_key,required"`
+	Protocol string `thrift:"protocol,2,required" form:"protocol,required" json:"protocol,required"`
+	BaseURL  string `thrift:"base_url,3,required" form:"base_url,required" json:"base_url,required"`
+	APIKey   string `thrift:"api_key,4,required" form:"api_key,required" json:"api_key,required"`
+	// JSON string
+	DefaultParams *string `thrift:"default_params,5,optional" form:"default_params" json:"default_params,omitempty"`
+	// JSON string
+	ConfigJSON *string `thrift:"config_json,6,optional" form:"config_json" json:"config_json,omitempty"`
 }
 
-type UserServiceClient struct {
-	c thrift.TClient
+func NewTestUserModelParamsRequest() *TestUserModelParamsRequest {
+	return &TestUserModelParamsRequest{}
 }
 
-func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *TestUserModelParamsRequest) InitDefault() {
 }
 
-func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *TestUserModelParamsRequest) GetModelKey() (v string) {
+	return p.ModelKey
 }
 
-func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
-	return &UserServiceClient{
-		c: c,
-	}
+func (p *TestUserModelParamsRequest) GetProtocol() (v string) {
+	return p.Protocol
 }
 
-func (p *UserServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *TestUserModelParamsRequest) GetBaseURL() (v string) {
+	return p.BaseURL
 }
 
-func (p *UserServiceClient) CreateUserModel(ctx context.Context, request *CreateUserModelRequest) (r *CreateUserModelResponse, err error) {
-	var _args UserServiceCreateUserModelArgs
-	_args.Request = request
-	var _result UserServiceCreateUserModelResult
-	if err = p.Client_().Call(ctx, "CreateUserModel", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) ListUserModels(ctx context.Context, request *ListUserModelsRequest) (r *ListUserModelsResponse, err error) {
-	var _args UserServiceListUserModelsArgs
-	_args.Request = request
-	var _result UserServiceListUserModelsResult
-	if err = p.Client_().Call(ctx, "ListUserModels", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) GetUserModel(ctx context.Context, request *IDRequest) (r *GetUserModelResponse, err error) {
-	var _args UserServiceGetUserModelArgs
-	_args.Request = request
-	var _result UserServiceGetUserModelResult
-	if err = p.Client_().Call(ctx, "GetUserModel", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UpdateUserModel(ctx context.Context, request *UpdateUserModelRequest) (r *UpdateUserModelResponse, err error) {
-	var _args UserServiceUpdateUserModelArgs
-	_args.Request = request
-	var _result UserServiceUpdateUserModelResult
-	if err = p.Client_().Call(ctx, "UpdateUserModel", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) DeleteUserModel(ctx context.Context, request *IDRequest) (r *DeleteUserModelResponse, err error) {
-	var _args UserServiceDeleteUserModelArgs
-	_args.Request = request
-	var _result UserServiceDeleteUserModelResult
-	if err = p.Client_().Call(ctx, "DeleteUserModel", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) Register(ctx context.Context, request *RegisterRequest) (r *LoginResponse, err error) {
-	var _args UserServiceRegisterArgs
-	_args.Request = request
-	var _result UserServiceRegisterResult
-	if err = p.Client_().Call(ctx, "Register", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) Login(ctx context.Context, request *LoginRequest) (r *LoginResponse, err error) {
-	var _args UserServiceLoginArgs
-	_args.Request = request
-	var _result UserServiceLoginResult
-	if err = p.Client_().Call(ctx, "Login", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) GetProfile(ctx context.Context, request *EmptyRequest) (r *GetProfileResponse, err error) {
-	var _args UserServiceGetProfileArgs
-	_args.Request = request
-	var _result UserServiceGetProfileResult
-	if err = p.Client_().Call(ctx, "GetProfile", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *TestUserModelParamsRequest) GetAPIKey() (v string) {
+	return p.APIKey
 }
-func (p *UserServiceClient) UpdateProfile(ctx context.Context, request *UpdateProfileRequest) (r *UpdateProfileResponse, err error) {
-	var _args UserServiceUpdateProfileArgs
-	_args.Request = request
-	var _result UserServiceUpdateProfileResult
-	if err = p.Client_().Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
-		return
+
+var TestUserModelParamsRequest_DefaultParams_DEFAULT string
+
+func (p *TestUserModelParamsRequest) GetDefaultParams() (v string) {
+	if !p.IsSetDefaultParams() {
+		return TestUserModelParamsRequest_DefaultParams_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.DefaultParams
 }
-func (p *UserServiceClient) WechatLogin(ctx context.Context, request *EmptyRequest) (r *WechatLoginQRResponse, err error) {
-	var _args UserServiceWechatLoginArgs
-	_args.Request = request
-	var _result UserServiceWechatLoginResult
-	if err = p.Client_().Call(ctx, "WechatLogin", &_args, &_result); err != nil {
-		return
+
+var TestUserModelParamsRequest_ConfigJSON_DEFAULT string
+
+func (p *TestUserModelParamsRequest) GetConfigJSON() (v string) {
+	if !p.IsSetConfigJSON() {
+		return TestUserModelParamsRequest_ConfigJSON_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return *p.ConfigJSON
 }
-func (p *UserServiceClient) WechatCallback(ctx context.Context, request *WechatCallbackRequest) (r *LoginResponse, err error) {
-	var _args UserServiceWechatCallbackArgs
-	_args.Request = request
-	var _result UserServiceWechatCallbackResult
-	if err = p.Client_().Call(ctx, "WechatCallback", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+var fieldIDToName_TestUserModelParamsRequest = map[int16]string{
+	1: "model_key",
+	2: "protocol",
+	3: "base_url",
+	4: "api_key",
+	5: "default_params",
+	6: "config_json",
 }
-func (p *UserServiceClient) CheckUserModelConfigured(ctx context.Context, request *EmptyRequest) (r *CheckUserModelConfiguredResponse, err error) {
-	var _args UserServiceCheckUserModelConfiguredArgs
-	_args.Request = request
-	var _result UserServiceCheckUserModelConfiguredResult
-	if err = p.Client_().Call(ctx, "CheckUserModelConfigured", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TestUserModelParamsRequest) IsSetDefaultParams() bool {
+	return p.DefaultParams != nil
 }
-func (p *UserServiceClient) ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (r *EmptyResponse, err error) {
-	var _args UserServiceForgotPasswordArgs
-	_args.Request = request
-	var _result UserServiceForgotPasswordResult
-	if err = p.Client_().Call(ctx, "ForgotPassword", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *TestUserModelParamsRequest) IsSetConfigJSON() bool {
+	return p.ConfigJSON != nil
 }
-func (p *UserServiceClient) ResetPassword(ctx context.Context, request *ResetPasswordRequest) (r *BaseResponse, err error) {
-	var _args UserServiceResetPasswordArgs
-	_args.Request = request
-	var _result UserServiceResetPasswordResult
-	if err = p.Client_().Call(ctx, "ResetPassword", &_args, &_result); err != nil {
-		return
+
+func (p *TestUserModelParamsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetModelKey bool = false
+	var issetProtocol bool = false
+	var issetBaseURL bool = false
+	var issetAPIKey bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetModelKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetProtocol = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseURL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAPIKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetModelKey {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetProtocol {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetBaseURL {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAPIKey {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TestUserModelParamsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TestUserModelParamsRequest[fieldId]))
+}
+
+func (p *TestUserModelParamsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ModelKey = _field
+	return nil
+}
+func (p *TestUserModelParamsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Protocol = _field
+	return nil
+}
+func (p *TestUserModelParamsRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.BaseURL = _field
+	return nil
+}
+func (p *TestUserModelParamsRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.APIKey = _field
+	return nil
+}
+func (p *TestUserModelParamsRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DefaultParams = _field
+	return nil
+}
+func (p *TestUserModelParamsRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ConfigJSON = _field
+	return nil
+}
+
+func (p *TestUserModelParamsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TestUserModelParamsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("model_key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ModelKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("protocol", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Protocol); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.BaseURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("api_key", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.APIKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultParams() {
+		if err = oprot.WriteFieldBegin("default_params", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DefaultParams); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfigJSON() {
+		if err = oprot.WriteFieldBegin("config_json", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ConfigJSON); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TestUserModelParamsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TestUserModelParamsRequest(%+v)", *p)
+
+}
+
+// 连通性测试结果
+type TestUserModelResult struct {
+	// 基础对话是否成功
+	Success bool `thrift:"success,1,required" form:"success,required" json:"success,required" query:"success,required"`
+	// 基础对话耗时（毫秒）
+	LatencyMs int64 `thrift:"latency_ms,2,required" form:"latency_ms,required" json:"latency_ms,required" query:"latency_ms,required"`
+	// 是否支持工具调用
+	SupportToolCall bool `thrift:"support_tool_call,3,required" form:"support_tool_call,required" json:"support_tool_call,required" query:"support_tool_call,required"`
+	// 是否支持 JSON 格式输出
+	SupportJSONFormat bool `thrift:"support_json_format,4,required" form:"support_json_format,required" json:"support_json_format,required" query:"support_json_format,required"`
+	// 错误分类（auth/network/model_not_found/quota/unknown）
+	ErrorType *string `thrift:"error_type,5,optional" form:"error_type" json:"error_type,omitempty" query:"error_type"`
+	// 原始错误信息
+	ErrorMsg *string `thrift:"error_msg,6,optional" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+}
+
+func NewTestUserModelResult() *TestUserModelResult {
+	return &TestUserModelResult{}
+}
+
+func (p *TestUserModelResult) InitDefault() {
+}
+
+func (p *TestUserModelResult) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *TestUserModelResult) GetLatencyMs() (v int64) {
+	return p.LatencyMs
+}
+
+func (p *TestUserModelResult) GetSupportToolCall() (v bool) {
+	return p.SupportToolCall
+}
+
+func (p *TestUserModelResult) GetSupportJSONFormat() (v bool) {
+	return p.SupportJSONFormat
+}
+
+var TestUserModelResult_ErrorType_DEFAULT string
+
+func (p *TestUserModelResult) GetErrorType() (v string) {
+	if !p.IsSetErrorType() {
+		return TestUserModelResult_ErrorType_DEFAULT
+	}
+	return *p.ErrorType
+}
+
+var TestUserModelResult_ErrorMsg_DEFAULT string
+
+func (p *TestUserModelResult) GetErrorMsg() (v string) {
+	if !p.IsSetErrorMsg() {
+		return TestUserModelResult_ErrorMsg_DEFAULT
+	}
+	return *p.ErrorMsg
+}
+
+var fieldIDToName_TestUserModelResult = map[int16]string{
+	1: "success",
+	2: "latency_ms",
+	3: "support_tool_call",
+	4: "support_json_format",
+	5: "error_type",
+	6: "error_msg",
+}
+
+func (p *TestUserModelResult) IsSetErrorType() bool {
+	return p.ErrorType != nil
+}
+
+func (p *TestUserModelResult) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *TestUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSuccess bool = false
+	var issetLatencyMs bool = false
+	var issetSupportToolCall bool = false
+	var issetSupportJSONFormat bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccess = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLatencyMs = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSupportToolCall = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSupportJSONFormat = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSuccess {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLatencyMs {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSupportToolCall {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSupportJSONFormat {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TestUserModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TestUserModelResult[fieldId]))
+}
+
+func (p *TestUserModelResult) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *TestUserModelResult) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LatencyMs = _field
+	return nil
+}
+func (p *TestUserModelResult) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SupportToolCall = _field
+	return nil
+}
+func (p *TestUserModelResult) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SupportJSONFormat = _field
+	return nil
+}
+func (p *TestUserModelResult) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorType = _field
+	return nil
+}
+func (p *TestUserModelResult) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMsg = _field
+	return nil
+}
+
+func (p *TestUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TestUserModelResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("latency_ms", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LatencyMs); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("support_tool_call", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.SupportToolCall); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("support_json_format", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.SupportJSONFormat); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorType() {
+		if err = oprot.WriteFieldBegin("error_type", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TestUserModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TestUserModelResult(%+v)", *p)
+
+}
+
+// 连通性测试响应
+type TestUserModelResponse struct {
+	Data *TestUserModelResult `thrift:"data,1,required" form:"data,required" json:"data,required" query:"data,required"`
+	Code int32                `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg  string               `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
+}
+
+func NewTestUserModelResponse() *TestUserModelResponse {
+	return &TestUserModelResponse{}
+}
+
+func (p *TestUserModelResponse) InitDefault() {
+}
+
+var TestUserModelResponse_Data_DEFAULT *TestUserModelResult
+
+func (p *TestUserModelResponse) GetData() (v *TestUserModelResult) {
+	if !p.IsSetData() {
+		return TestUserModelResponse_Data_DEFAULT
+	}
+	return p.Data
+}
+
+func (p *TestUserModelResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *TestUserModelResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_TestUserModelResponse = map[int16]string{
+	1:   "data",
+	253: "code",
+	254: "msg",
+}
+
+func (p *TestUserModelResponse) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *TestUserModelResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetData bool = false
+	var issetCode bool = false
+	var issetMsg bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 253:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField253(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField254(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetData {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCode {
+		fieldId = 253
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 254
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TestUserModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TestUserModelResponse[fieldId]))
+}
+
+func (p *TestUserModelResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTestUserModelResult()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *TestUserModelResponse) ReadField253(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *TestUserModelResponse) ReadField254(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *TestUserModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TestUserModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField253(oprot); err != nil {
+			fieldId = 253
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TestUserModelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Data.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TestUserModelResponse) writeField253(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
+}
+
+func (p *TestUserModelResponse) writeField254(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+
+func (p *TestUserModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TestUserModelResponse(%+v)", *p)
+
+}
+
+// ==================== 9. 模型用量 ====================
+// 用量查询请求
+type GetUsageRequest struct {
+	// 最近几天（含今天），默认 7，最多 90
+	Days *int32 `thrift:"days,1,optional" json:"days,omitempty" query:"days"`
+}
+
+func NewGetUsageRequest() *GetUsageRequest {
+	return &GetUsageRequest{}
+}
+
+func (p *GetUsageRequest) InitDefault() {
+}
+
+var GetUsageRequest_Days_DEFAULT int32
+
+func (p *GetUsageRequest) GetDays() (v int32) {
+	if !p.IsSetDays() {
+		return GetUsageRequest_Days_DEFAULT
+	}
+	return *p.Days
+}
+
+var fieldIDToName_GetUsageRequest = map[int16]string{
+	1: "days",
+}
+
+func (p *GetUsageRequest) IsSetDays() bool {
+	return p.Days != nil
+}
+
+func (p *GetUsageRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsageRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUsageRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Days = _field
+	return nil
+}
+
+func (p *GetUsageRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUsageRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUsageRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDays() {
+		if err = oprot.WriteFieldBegin("days", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Days); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUsageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUsageRequest(%+v)", *p)
+
+}
+
+// 某功能、模型的用量
+type UsageItem struct {
+	// 功能（resume_parse/interview/evaluation/jd_parse）
+	Feature string `thrift:"feature,1,required" form:"feature,required" json:"feature,required" query:"feature,required"`
+	// 模型（协议/模型）
+	Model string `thrift:"model,2,required" form:"model,required" json:"model,required" query:"model,required"`
+	// 是否使用平台共享密钥
+	SharedKey bool `thrift:"shared_key,3,required" form:"shared_key,required" json:"shared_key,required" query:"shared_key,required"`
+	// 请求次数
+	Requests int64 `thrift:"requests,4,required" form:"requests,required" json:"requests,required" query:"requests,required"`
+	// 输入 token 数
+	PromptTokens int64 `thrift:"prompt_tokens,5,required" form:"prompt_tokens,required" json:"prompt_tokens,required" query:"prompt_tokens,required"`
+	// 输出 token 数
+	CompletionTokens int64 `thrift:"completion_tokens,6,required" form:"completion_tokens,required" json:"completion_tokens,required" query:"completion_tokens,required"`
+	// 总 token 数
+	TotalTokens int64 `thrift:"total_tokens,7,required" form:"total_tokens,required" json:"total_tokens,required" query:"total_tokens,required"`
+}
+
+func NewUsageItem() *UsageItem {
+	return &UsageItem{}
+}
+
+func (p *UsageItem) InitDefault() {
+}
+
+func (p *UsageItem) GetFeature() (v string) {
+	return p.Feature
+}
+
+func (p *UsageItem) GetModel() (v string) {
+	return p.Model
+}
+
+func (p *UsageItem) GetSharedKey() (v bool) {
+	return p.SharedKey
+}
+
+func (p *UsageItem) GetRequests() (v int64) {
+	return p.Requests
+}
+
+func (p *UsageItem) GetPromptTokens() (v int64) {
+	return p.PromptTokens
+}
+
+func (p *UsageItem) GetCompletionTokens() (v int64) {
+	return p.CompletionTokens
+}
+
+func (p *UsageItem) GetTotalTokens() (v int64) {
+	return p.TotalTokens
+}
+
+var fieldIDToName_UsageItem = map[int16]string{
+	1: "feature",
+	2: "model",
+	3: "shared_key",
+	4: "requests",
+	5: "prompt_tokens",
+	6: "completion_tokens",
+	7: "total_tokens",
+}
+
+func (p *UsageItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetFeature bool = false
+	var issetModel bool = false
+	var issetSharedKey bool = false
+	var issetRequests bool = false
+	var issetPromptTokens bool = false
+	var issetCompletionTokens bool = false
+	var issetTotalTokens bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFeature = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetModel = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSharedKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRequests = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPromptTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCompletionTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetFeature {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetModel {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSharedKey {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRequests {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPromptTokens {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompletionTokens {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalTokens {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UsageItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UsageItem[fieldId]))
+}
+
+func (p *UsageItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Feature = _field
+	return nil
+}
+func (p *UsageItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Model = _field
+	return nil
+}
+func (p *UsageItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SharedKey = _field
+	return nil
+}
+func (p *UsageItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Requests = _field
+	return nil
+}
+func (p *UsageItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PromptTokens = _field
+	return nil
+}
+func (p *UsageItem) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CompletionTokens = _field
+	return nil
+}
+func (p *UsageItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalTokens = _field
+	return nil
+}
+
+func (p *UsageItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UsageItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UsageItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("feature", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Feature); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("model", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Model); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shared_key", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.SharedKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("requests", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Requests); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prompt_tokens", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PromptTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("completion_tokens", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CompletionTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UsageItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_tokens", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *UsageItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsageItem(%+v)", *p)
+
+}
+
+// 每日用量
+type DailyUsage struct {
+	// 日期（YYYY-MM-DD）
+	Date string `thrift:"date,1,required" form:"date,required" json:"date,required" query:"date,required"`
+	// 请求次数
+	Requests int64 `thrift:"requests,2,required" form:"requests,required" json:"requests,required" query:"requests,required"`
+	// 输入 token 数
+	PromptTokens int64 `thrift:"prompt_tokens,3,required" form:"prompt_tokens,required" json:"prompt_tokens,required" query:"prompt_tokens,required"`
+	// 输出 token 数
+	CompletionTokens int64 `thrift:"completion_tokens,4,required" form:"completion_tokens,required" json:"completion_tokens,required" query:"completion_tokens,required"`
+	// 总 token 数
+	TotalTokens int64 `thrift:"total_tokens,5,required" form:"total_tokens,required" json:"total_tokens,required" query:"total_tokens,required"`
+	// 使用平台共享密钥的 token 数，计入配额
+	SharedKeyTokens int64 `thrift:"shared_key_tokens,6,required" form:"shared_key_tokens,required" json:"shared_key_tokens,required" query:"shared_key_tokens,required"`
+	// 按功能、模型的明细
+	Items []*UsageItem `thrift:"items,7,required,list<UsageItem>" form:"items,required" json:"items,required" query:"items,required"`
+}
+
+func NewDailyUsage() *DailyUsage {
+	return &DailyUsage{}
+}
+
+func (p *DailyUsage) InitDefault() {
+}
+
+func (p *DailyUsage) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *DailyUsage) GetRequests() (v int64) {
+	return p.Requests
+}
+
+func (p *DailyUsage) GetPromptTokens() (v int64) {
+	return p.PromptTokens
+}
+
+func (p *DailyUsage) GetCompletionTokens() (v int64) {
+	return p.CompletionTokens
+}
+
+func (p *DailyUsage) GetTotalTokens() (v int64) {
+	return p.TotalTokens
+}
+
+func (p *DailyUsage) GetSharedKeyTokens() (v int64) {
+	return p.SharedKeyTokens
+}
+
+func (p *DailyUsage) GetItems() (v []*UsageItem) {
+	return p.Items
+}
+
+var fieldIDToName_DailyUsage = map[int16]string{
+	1: "date",
+	2: "requests",
+	3: "prompt_tokens",
+	4: "completion_tokens",
+	5: "total_tokens",
+	6: "shared_key_tokens",
+	7: "items",
+}
+
+func (p *DailyUsage) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetDate bool = false
+	var issetRequests bool = false
+	var issetPromptTokens bool = false
+	var issetCompletionTokens bool = false
+	var issetTotalTokens bool = false
+	var issetSharedKeyTokens bool = false
+	var issetItems bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDate = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRequests = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPromptTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCompletionTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotalTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSharedKeyTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetItems = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetDate {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRequests {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPromptTokens {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompletionTokens {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotalTokens {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSharedKeyTokens {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItems {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DailyUsage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DailyUsage[fieldId]))
+}
+
+func (p *DailyUsage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Date = _field
+	return nil
+}
+func (p *DailyUsage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Requests = _field
+	return nil
+}
+func (p *DailyUsage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PromptTokens = _field
+	return nil
+}
+func (p *DailyUsage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CompletionTokens = _field
+	return nil
+}
+func (p *DailyUsage) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TotalTokens = _field
+	return nil
+}
+func (p *DailyUsage) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SharedKeyTokens = _field
+	return nil
+}
+func (p *DailyUsage) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UsageItem, 0, size)
+	values := make([]UsageItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+
+func (p *DailyUsage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DailyUsage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("date", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Date); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("requests", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Requests); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prompt_tokens", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PromptTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("completion_tokens", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CompletionTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_tokens", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shared_key_tokens", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SharedKeyTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *DailyUsage) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("items", thrift.LIST, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+		return err
+	}
+	for _, v := range p.Items {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *DailyUsage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DailyUsage(%+v)", *p)
+
+}
+
+// 某功能的每日配额
+type FeatureQuota struct {
+	// 功能（resume_parse/interview/evaluation/jd_parse）
+	Feature string `thrift:"feature,1,required" form:"feature,required" json:"feature,required" query:"feature,required"`
+	// 每天可用的 token 数
+	DailyTokens int64 `thrift:"daily_tokens,2,required" form:"daily_tokens,required" json:"daily_tokens,required" query:"daily_tokens,required"`
+}
+
+func NewFeatureQuota() *FeatureQuota {
+	return &FeatureQuota{}
+}
+
+func (p *FeatureQuota) InitDefault() {
+}
+
+func (p *FeatureQuota) GetFeature() (v string) {
+	return p.Feature
+}
+
+func (p *FeatureQuota) GetDailyTokens() (v int64) {
+	return p.DailyTokens
+}
+
+var fieldIDToName_FeatureQuota = map[int16]string{
+	1: "feature",
+	2: "daily_tokens",
+}
+
+func (p *FeatureQuota) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetFeature bool = false
+	var issetDailyTokens bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFeature = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDailyTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetFeature {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDailyTokens {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FeatureQuota[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FeatureQuota[fieldId]))
+}
+
+func (p *FeatureQuota) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Feature = _field
+	return nil
+}
+func (p *FeatureQuota) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DailyTokens = _field
+	return nil
+}
+
+func (p *FeatureQuota) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FeatureQuota"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FeatureQuota) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("feature", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Feature); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FeatureQuota) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("daily_tokens", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DailyTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *FeatureQuota) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FeatureQuota(%+v)", *p)
+
+}
+
+// 每日配额
+type UsageQuota struct {
+	// 每天可用的 token 数，0 为不限制
+	DailyTokens int64 `thrift:"daily_tokens,1,required" form:"daily_tokens,required" json:"daily_tokens,required" query:"daily_tokens,required"`
+	// 单独限制的功能
+	Features []*FeatureQuota `thrift:"features,2,required,list<FeatureQuota>" form:"features,required" json:"features,required" query:"features,required"`
+}
+
+func NewUsageQuota() *UsageQuota {
+	return &UsageQuota{}
+}
+
+func (p *UsageQuota) InitDefault() {
+}
+
+func (p *UsageQuota) GetDailyTokens() (v int64) {
+	return p.DailyTokens
+}
+
+func (p *UsageQuota) GetFeatures() (v []*FeatureQuota) {
+	return p.Features
+}
+
+var fieldIDToName_UsageQuota = map[int16]string{
+	1: "daily_tokens",
+	2: "features",
+}
+
+func (p *UsageQuota) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetDailyTokens bool = false
+	var issetFeatures bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDailyTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFeatures = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetDailyTokens {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFeatures {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UsageQuota[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UsageQuota[fieldId]))
+}
+
+func (p *UsageQuota) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DailyTokens = _field
+	return nil
+}
+func (p *UsageQuota) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FeatureQuota, 0, size)
+	values := make([]FeatureQuota, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Features = _field
+	return nil
+}
+
+func (p *UsageQuota) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UsageQuota"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UsageQuota) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("daily_tokens", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DailyTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UsageQuota) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("features", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Features)); err != nil {
+		return err
+	}
+	for _, v := range p.Features {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UsageQuota) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsageQuota(%+v)", *p)
+
+}
+
+// 用量查询响应
+type GetUsageResponse struct {
+	// 按日期倒序
+	Data []*DailyUsage `thrift:"data,1,required,list<DailyUsage>" form:"data,required" json:"data,required" query:"data,required"`
+	// 平台共享密钥的每日配额
+	Quota *UsageQuota `thrift:"quota,2,required" form:"quota,required" json:"quota,required" query:"quota,required"`
+	Code  int32       `thrift:"code,253,required" form:"code,required" json:"code,required" query:"code,required"`
+	Msg   string      `thrift:"msg,254,required" form:"msg,required" json:"msg,required" query:"msg,required"`
+}
+
+func NewGetUsageResponse() *GetUsageResponse {
+	return &GetUsageResponse{}
+}
+
+func (p *GetUsageResponse) InitDefault() {
+}
+
+func (p *GetUsageResponse) GetData() (v []*DailyUsage) {
+	return p.Data
+}
+
+var GetUsageResponse_Quota_DEFAULT *UsageQuota
+
+func (p *GetUsageResponse) GetQuota() (v *UsageQuota) {
+	if !p.IsSetQuota() {
+		return GetUsageResponse_Quota_DEFAULT
+	}
+	return p.Quota
+}
+
+func (p *GetUsageResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetUsageResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_GetUsageResponse = map[int16]string{
+	1:   "data",
+	2:   "quota",
+	253: "code",
+	254: "msg",
+}
+
+func (p *GetUsageResponse) IsSetQuota() bool {
+	return p.Quota != nil
+}
+
+func (p *GetUsageResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetData bool = false
+	var issetQuota bool = false
+	var issetCode bool = false
+	var issetMsg bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetQuota = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 253:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField253(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 254:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField254(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMsg = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetData {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetQuota {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCode {
+		fieldId = 253
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMsg {
+		fieldId = 254
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsageResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetUsageResponse[fieldId]))
+}
+
+func (p *GetUsageResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*DailyUsage, 0, size)
+	values := make([]DailyUsage, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *GetUsageResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewUsageQuota()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Quota = _field
+	return nil
+}
+func (p *GetUsageResponse) ReadField253(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *GetUsageResponse) ReadField254(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *GetUsageResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUsageResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField253(oprot); err != nil {
+			fieldId = 253
+			goto WriteFieldError
+		}
+		if err = p.writeField254(oprot); err != nil {
+			fieldId = 254
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUsageResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUsageResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("quota", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Quota.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUsageResponse) writeField253(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 253); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 253 end error: ", p), err)
+}
+
+func (p *GetUsageResponse) writeField254(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 254); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 254 end error: ", p), err)
+}
+
+func (p *GetUsageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUsageResponse(%+v)", *p)
+
+}
+
+// 服务定义
+type UserService interface {
+	// 1. 创建用户模型
+	CreateUserModel(ctx context.Context, request *CreateUserModelRequest) (r *CreateUserModelResponse, err error)
+	// 2. 获取用户模型列表
+	ListUserModels(ctx context.Context, request *ListUserModelsRequest) (r *ListUserModelsResponse, err error)
+	// 3. 获取用户模型详情
+	GetUserModel(ctx context.Context, request *IDRequest) (r *GetUserModelResponse, err error)
+	// 4. 更新用户模型
+	UpdateUserModel(ctx context.Context, request *UpdateUserModelRequest) (r *UpdateUserModelResponse, err error)
+	// 5. 删除用户模型
+	DeleteUserModel(ctx context.Context, request *IDRequest) (r *DeleteUserModelResponse, err error)
+	// 6. 用户注册
+	Register(ctx context.Context, request *RegisterRequest) (r *LoginResponse, err error)
+	// 7. 用户登录
+	Login(ctx context.Context, request *LoginRequest) (r *LoginResponse, err error)
+	// 8. 获取用户资料
+	GetProfile(ctx context.Context, request *EmptyRequest) (r *GetProfileResponse, err error)
+	// 9. 更新用户资料
+	UpdateProfile(ctx context.Context, request *UpdateProfileRequest) (r *UpdateProfileResponse, err error)
+	// 10. 获取微信登录二维码
+	WechatLogin(ctx context.Context, request *EmptyRequest) (r *WechatLoginQRResponse, err error)
+	// 11. 微信登录回调
+	WechatCallback(ctx context.Context, request *WechatCallbackRequest) (r *LoginResponse, err error)
+	// 12. 检查用户是否配置了模型
+	CheckUserModelConfigured(ctx context.Context, request *EmptyRequest) (r *CheckUserModelConfiguredResponse, err error)
+	// 13. 忘记密码
+	ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (r *EmptyResponse, err error)
+	// 14. 重置密码
+	ResetPassword(ctx context.Context, request *ResetPasswordRequest) (r *BaseResponse, err error)
+	// 15. 退出登录
+	Logout(ctx context.Context, request *EmptyRequest) (r *BaseResponse, err error)
+	// 16. 测试已保存的用户模型连通性
+	TestUserModel(ctx context.Context, request *IDRequest) (r *TestUserModelResponse, err error)
+	// 17. 测试未保存的模型参数连通性
+	TestUserModelParams(ctx context.Context, request *TestUserModelParamsRequest) (r *TestUserModelResponse, err error)
+	// 18. 获取每日模型用量
+	GetUsage(ctx context.Context, request *GetUsageRequest) (r *GetUsageResponse, err error)
+}
+
+type UserServiceClient struct {
+	c thrift.TClient
+}
+
+func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
+	return &UserServiceClient{
+		c: c,
+	}
+}
+
+func (p *UserServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserServiceClient) CreateUserModel(ctx context.Context, request *CreateUserModelRequest) (r *CreateUserModelResponse, err error) {
+	var _args UserServiceCreateUserModelArgs
+	_args.Request = request
+	var _result UserServiceCreateUserModelResult
+	if err = p.Client_().Call(ctx, "CreateUserModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ListUserModels(ctx context.Context, request *ListUserModelsRequest) (r *ListUserModelsResponse, err error) {
+	var _args UserServiceListUserModelsArgs
+	_args.Request = request
+	var _result UserServiceListUserModelsResult
+	if err = p.Client_().Call(ctx, "ListUserModels", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetUserModel(ctx context.Context, request *IDRequest) (r *GetUserModelResponse, err error) {
+	var _args UserServiceGetUserModelArgs
+	_args.Request = request
+	var _result UserServiceGetUserModelResult
+	if err = p.Client_().Call(ctx, "GetUserModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdateUserModel(ctx context.Context, request *UpdateUserModelRequest) (r *UpdateUserModelResponse, err error) {
+	var _args UserServiceUpdateUserModelArgs
+	_args.Request = request
+	var _result UserServiceUpdateUserModelResult
+	if err = p.Client_().Call(ctx, "UpdateUserModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) DeleteUserModel(ctx context.Context, request *IDRequest) (r *DeleteUserModelResponse, err error) {
+	var _args UserServiceDeleteUserModelArgs
+	_args.Request = request
+	var _result UserServiceDeleteUserModelResult
+	if err = p.Client_().Call(ctx, "DeleteUserModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) Register(ctx context.Context, request *RegisterRequest) (r *LoginResponse, err error) {
+	var _args UserServiceRegisterArgs
+	_args.Request = request
+	var _result UserServiceRegisterResult
+	if err = p.Client_().Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) Login(ctx context.Context, request *LoginRequest) (r *LoginResponse, err error) {
+	var _args UserServiceLoginArgs
+	_args.Request = request
+	var _result UserServiceLoginResult
+	if err = p.Client_().Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetProfile(ctx context.Context, request *EmptyRequest) (r *GetProfileResponse, err error) {
+	var _args UserServiceGetProfileArgs
+	_args.Request = request
+	var _result UserServiceGetProfileResult
+	if err = p.Client_().Call(ctx, "GetProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdateProfile(ctx context.Context, request *UpdateProfileRequest) (r *UpdateProfileResponse, err error) {
+	var _args UserServiceUpdateProfileArgs
+	_args.Request = request
+	var _result UserServiceUpdateProfileResult
+	if err = p.Client_().Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) WechatLogin(ctx context.Context, request *EmptyRequest) (r *WechatLoginQRResponse, err error) {
+	var _args UserServiceWechatLoginArgs
+	_args.Request = request
+	var _result UserServiceWechatLoginResult
+	if err = p.Client_().Call(ctx, "WechatLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) WechatCallback(ctx context.Context, request *WechatCallbackRequest) (r *LoginResponse, err error) {
+	var _args UserServiceWechatCallbackArgs
+	_args.Request = request
+	var _result UserServiceWechatCallbackResult
+	if err = p.Client_().Call(ctx, "WechatCallback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) CheckUserModelConfigured(ctx context.Context, request *EmptyRequest) (r *CheckUserModelConfiguredResponse, err error) {
+	var _args UserServiceCheckUserModelConfiguredArgs
+	_args.Request = request
+	var _result UserServiceCheckUserModelConfiguredResult
+	if err = p.Client_().Call(ctx, "CheckUserModelConfigured", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (r *EmptyResponse, err error) {
+	var _args UserServiceForgotPasswordArgs
+	_args.Request = request
+	var _result UserServiceForgotPasswordResult
+	if err = p.Client_().Call(ctx, "ForgotPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ResetPassword(ctx context.Context, request *ResetPasswordRequest) (r *BaseResponse, err error) {
+	var _args UserServiceResetPasswordArgs
+	_args.Request = request
+	var _result UserServiceResetPasswordResult
+	if err = p.Client_().Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) Logout(ctx context.Context, request *EmptyRequest) (r *BaseResponse, err error) {
+	var _args UserServiceLogoutArgs
+	_args.Request = request
+	var _result UserServiceLogoutResult
+	if err = p.Client_().Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) TestUserModel(ctx context.Context, request *IDRequest) (r *TestUserModelResponse, err error) {
+	var _args UserServiceTestUserModelArgs
+	_args.Request = request
+	var _result UserServiceTestUserModelResult
+	if err = p.Client_().Call(ctx, "TestUserModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) TestUserModelParams(ctx context.Context, request *TestUserModelParamsRequest) (r *TestUserModelResponse, err error) {
+	var _args UserServiceTestUserModelParamsArgs
+	_args.Request = request
+	var _result UserServiceTestUserModelParamsResult
+	if err = p.Client_().Call(ctx, "TestUserModelParams", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetUsage(ctx context.Context, request *GetUsageRequest) (r *GetUsageResponse, err error) {
+	var _args UserServiceGetUsageArgs
+	_args.Request = request
+	var _result UserServiceGetUsageResult
+	if err = p.Client_().Call(ctx, "GetUsage", &_args, &_result); err != nil {
+		return
 	}
 	return _result.GetSuccess(), nil
 }
-func (p *UserServiceClient) Logout(ctx context.Context, request *EmptyRequest) (r *BaseResponse, err error) {
-	var _args UserServiceLogoutArgs
-	_args.Request = request
-	var _result UserServiceLogoutResult
-	if err = p.Client_().Call(ctx, "Logout", &_args, &_result); err != nil {
+
+type UserServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserService
+}
+
+func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
+	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateUserModel", &userServiceProcessorCreateUserModel{handler: handler})
+	self.AddToProcessorMap("ListUserModels", &userServiceProcessorListUserModels{handler: handler})
+	self.AddToProcessorMap("GetUserModel", &userServiceProcessorGetUserModel{handler: handler})
+	self.AddToProcessorMap("UpdateUserModel", &userServiceProcessorUpdateUserModel{handler: handler})
+	self.AddToProcessorMap("DeleteUserModel", &userServiceProcessorDeleteUserModel{handler: handler})
+	self.AddToProcessorMap("Register", &userServiceProcessorRegister{handler: handler})
+	self.AddToProcessorMap("Login", &userServiceProcessorLogin{handler: handler})
+	self.AddToProcessorMap("GetProfile", &userServiceProcessorGetProfile{handler: handler})
+	self.AddToProcessorMap("UpdateProfile", &userServiceProcessorUpdateProfile{handler: handler})
+	self.AddToProcessorMap("WechatLogin", &userServiceProcessorWechatLogin{handler: handler})
+	self.AddToProcessorMap("WechatCallback", &userServiceProcessorWechatCallback{handler: handler})
+	self.AddToProcessorMap("CheckUserModelConfigured", &userServiceProcessorCheckUserModelConfigured{handler: handler})
+	self.AddToProcessorMap("ForgotPassword", &userServiceProcessorForgotPassword{handler: handler})
+	self.AddToProcessorMap("ResetPassword", &userServiceProcessorResetPassword{handler: handler})
+	self.AddToProcessorMap("Logout", &userServiceProcessorLogout{handler: handler})
+	self.AddToProcessorMap("TestUserModel", &userServiceProcessorTestUserModel{handler: handler})
+	self.AddToProcessorMap("TestUserModelParams", &userServiceProcessorTestUserModelParams{handler: handler})
+	self.AddToProcessorMap("GetUsage", &userServiceProcessorGetUsage{handler: handler})
+	return self
+}
+func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userServiceProcessorCreateUserModel struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorCreateUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceCreateUserModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceCreateUserModelResult{}
+	var retval *CreateUserModelResponse
+	if retval, err2 = p.handler.CreateUserModel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateUserModel: "+err2.Error())
+		oprot.WriteMessageBegin("CreateUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateUserModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorListUserModels struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorListUserModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceListUserModelsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListUserModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceListUserModelsResult{}
+	var retval *ListUserModelsResponse
+	if retval, err2 = p.handler.ListUserModels(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListUserModels: "+err2.Error())
+		oprot.WriteMessageBegin("ListUserModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListUserModels", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorGetUserModel struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetUserModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetUserModelResult{}
+	var retval *GetUserModelResponse
+	if retval, err2 = p.handler.GetUserModel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserModel: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdateUserModel struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdateUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateUserModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdateUserModelResult{}
+	var retval *UpdateUserModelResponse
+	if retval, err2 = p.handler.UpdateUserModel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserModel: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateUserModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorDeleteUserModel struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorDeleteUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceDeleteUserModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceDeleteUserModelResult{}
+	var retval *DeleteUserModelResponse
+	if retval, err2 = p.handler.DeleteUserModel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteUserModel: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteUserModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteUserModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorRegister struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRegisterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Register", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRegisterResult{}
+	var retval *LoginResponse
+	if retval, err2 = p.handler.Register(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Register: "+err2.Error())
+		oprot.WriteMessageBegin("Register", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Register", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorLogin struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceLoginArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Login", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceLoginResult{}
+	var retval *LoginResponse
+	if retval, err2 = p.handler.Login(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Login: "+err2.Error())
+		oprot.WriteMessageBegin("Login", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Login", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorGetProfile struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetProfileArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetProfileResult{}
+	var retval *GetProfileResponse
+	if retval, err2 = p.handler.GetProfile(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetProfile: "+err2.Error())
+		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetProfile", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdateProfile struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdateProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateProfileArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdateProfileResult{}
+	var retval *UpdateProfileResponse
+	if retval, err2 = p.handler.UpdateProfile(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateProfile: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateProfile", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorWechatLogin struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorWechatLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceWechatLoginArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("WechatLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceWechatLoginResult{}
+	var retval *WechatLoginQRResponse
+	if retval, err2 = p.handler.WechatLogin(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WechatLogin: "+err2.Error())
+		oprot.WriteMessageBegin("WechatLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("WechatLogin", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorWechatCallback struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorWechatCallback) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceWechatCallbackArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("WechatCallback", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceWechatCallbackResult{}
+	var retval *LoginResponse
+	if retval, err2 = p.handler.WechatCallback(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WechatCallback: "+err2.Error())
+		oprot.WriteMessageBegin("WechatCallback", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("WechatCallback", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
 
-type UserServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      UserService
+type userServiceProcessorCheckUserModelConfigured struct {
+	handler UserService
 }
 
-func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *userServiceProcessorCheckUserModelConfigured) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceCheckUserModelConfiguredArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceCheckUserModelConfiguredResult{}
+	var retval *CheckUserModelConfiguredResponse
+	if retval, err2 = p.handler.CheckUserModelConfigured(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckUserModelConfigured: "+err2.Error())
+		oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type userServiceProcessorForgotPassword struct {
+	handler UserService
 }
 
-func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *userServiceProcessorForgotPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceForgotPasswordArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ForgotPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceForgotPasswordResult{}
+	var retval *EmptyResponse
+	if retval, err2 = p.handler.ForgotPassword(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ForgotPassword: "+err2.Error())
+		oprot.WriteMessageBegin("ForgotPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ForgotPassword", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
-	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreateUserModel", &userServiceProcessorCreateUserModel{handler: handler})
-	self.AddToProcessorMap("ListUserModels", &userServiceProcessorListUserModels{handler: handler})
-	self.AddToProcessorMap("GetUserModel", &userServiceProcessorGetUserModel{handler: handler})
-	self.AddToProcessorMap("UpdateUserModel", &userServiceProcessorUpdateUserModel{handler: handler})
-	self.AddToProcessorMap("DeleteUserModel", &userServiceProcessorDeleteUserModel{handler: handler})
-	self.AddToProcessorMap("Register", &userServiceProcessorRegister{handler: handler})
-	self.AddToProcessorMap("Login", &userServiceProcessorLogin{handler: handler})
-	self.AddToProcessorMap("GetProfile", &userServiceProcessorGetProfile{handler: handler})
-	self.AddToProcessorMap("UpdateProfile", &userServiceProcessorUpdateProfile{handler: handler})
-	self.AddToProcessorMap("WechatLogin", &userServiceProcessorWechatLogin{handler: handler})
-	self.AddToProcessorMap("WechatCallback", &userServiceProcessorWechatCallback{handler: handler})
-	self.AddToProcessorMap("CheckUserModelConfigured", &userServiceProcessorCheckUserModelConfigured{handler: handler})
-	self.AddToProcessorMap("ForgotPassword", &userServiceProcessorForgotPassword{handler: handler})
-	self.AddToProcessorMap("ResetPassword", &userServiceProcessorResetPassword{handler: handler})
-	self.AddToProcessorMap("Logout", &userServiceProcessorLogout{handler: handler})
-	return self
+type userServiceProcessorResetPassword struct {
+	handler UserService
 }
-func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
+
+func (p *userServiceProcessorResetPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceResetPasswordArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ResetPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceResetPasswordResult{}
+	var retval *BaseResponse
+	if retval, err2 = p.handler.ResetPassword(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetPassword: "+err2.Error())
+		oprot.WriteMessageBegin("ResetPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	iprot.Skip(thrift.STRUCT)
+	if err2 = oprot.WriteMessageBegin("ResetPassword", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorLogout struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorLogout) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceLogoutArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Logout", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
 	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	var err2 error
+	result := UserServiceLogoutResult{}
+	var retval *BaseResponse
+	if retval, err2 = p.handler.Logout(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Logout: "+err2.Error())
+		oprot.WriteMessageBegin("Logout", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Logout", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type userServiceProcessorCreateUserModel struct {
+type userServiceProcessorTestUserModel struct {
 	handler UserService
 }
 
-func (p *userServiceProcessorCreateUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceCreateUserModelArgs{}
+func (p *userServiceProcessorTestUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceTestUserModelArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateUserModel", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("TestUserModel", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7660,11 +11805,11 @@ func (p *userServiceProcessorCreateUserModel) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserServiceCreateUserModelResult{}
-	var retval *CreateUserModelResponse
-	if retval, err2 = p.handler.CreateUserModel(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateUserModel: "+err2.Error())
-		oprot.WriteMessageBegin("CreateUserModel", thrift.EXCEPTION, seqId)
+	result := UserServiceTestUserModelResult{}
+	var retval *TestUserModelResponse
+	if retval, err2 = p.handler.TestUserModel(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TestUserModel: "+err2.Error())
+		oprot.WriteMessageBegin("TestUserModel", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7672,7 +11817,7 @@ func (p *userServiceProcessorCreateUserModel) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("CreateUserModel", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("TestUserModel", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -7690,16 +11835,16 @@ func (p *userServiceProcessorCreateUserModel) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type userServiceProcessorListUserModels struct {
+type userServiceProcessorTestUserModelParams struct {
 	handler UserService
 }
 
-func (p *userServiceProcessorListUserModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceListUserModelsArgs{}
+func (p *userServiceProcessorTestUserModelParams) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceTestUserModelParamsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListUserModels", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("TestUserModelParams", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7708,11 +11853,11 @@ func (p *userServiceProcessorListUserModels) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserServiceListUserModelsResult{}
-	var retval *ListUserModelsResponse
-	if retval, err2 = p.handler.ListUserModels(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListUserModels: "+err2.Error())
-		oprot.WriteMessageBegin("ListUserModels", thrift.EXCEPTION, seqId)
+	result := UserServiceTestUserModelParamsResult{}
+	var retval *TestUserModelResponse
+	if retval, err2 = p.handler.TestUserModelParams(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TestUserModelParams: "+err2.Error())
+		oprot.WriteMessageBegin("TestUserModelParams", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7720,7 +11865,7 @@ func (p *userServiceProcessorListUserModels) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ListUserModels", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("TestUserModelParams", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -7738,16 +11883,16 @@ func (p *userServiceProcessorListUserModels) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type userServiceProcessorGetUserModel struct {
+type userServiceProcessorGetUsage struct {
 	handler UserService
 }
 
-func (p *userServiceProcessorGetUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceGetUserModelArgs{}
+func (p *userServiceProcessorGetUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetUsageArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserModel", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetUsage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7756,11 +11901,11 @@ func (p *userServiceProcessorGetUserModel) Process(ctx context.Context, seqId in
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserServiceGetUserModelResult{}
-	var retval *GetUserModelResponse
-	if retval, err2 = p.handler.GetUserModel(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserModel: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserModel", thrift.EXCEPTION, seqId)
+	result := UserServiceGetUsageResult{}
+	var retval *GetUsageResponse
+	if retval, err2 = p.handler.GetUsage(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUsage: "+err2.Error())
+		oprot.WriteMessageBegin("GetUsage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -7768,7 +11913,7 @@ func (p *userServiceProcessorGetUserModel) Process(ctx context.Context, seqId in
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserModel", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetUsage", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -7786,611 +11931,917 @@ func (p *userServiceProcessorGetUserModel) Process(ctx context.Context, seqId in
 	return true, err
 }
 
-type userServiceProcessorUpdateUserModel struct {
-	handler UserService
+type UserServiceCreateUserModelArgs struct {
+	Request *CreateUserModelRequest `thrift:"request,1"`
+}
+
+func NewUserServiceCreateUserModelArgs() *UserServiceCreateUserModelArgs {
+	return &UserServiceCreateUserModelArgs{}
+}
+
+func (p *UserServiceCreateUserModelArgs) InitDefault() {
+}
+
+var UserServiceCreateUserModelArgs_Request_DEFAULT *CreateUserModelRequest
+
+func (p *UserServiceCreateUserModelArgs) GetRequest() (v *CreateUserModelRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceCreateUserModelArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserServiceCreateUserModelArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserServiceCreateUserModelArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceCreateUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceCreateUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateUserModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserServiceCreateUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateUserModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceCreateUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceCreateUserModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateUserModelArgs(%+v)", *p)
+
+}
+
+type UserServiceCreateUserModelResult struct {
+	Success *CreateUserModelResponse `thrift:"success,0,optional"`
+}
+
+func NewUserServiceCreateUserModelResult() *UserServiceCreateUserModelResult {
+	return &UserServiceCreateUserModelResult{}
+}
+
+func (p *UserServiceCreateUserModelResult) InitDefault() {
+}
+
+var UserServiceCreateUserModelResult_Success_DEFAULT *CreateUserModelResponse
+
+func (p *UserServiceCreateUserModelResult) GetSuccess() (v *CreateUserModelResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceCreateUserModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceCreateUserModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceCreateUserModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCreateUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorUpdateUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdateUserModelArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateUserModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceCreateUserModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateUserModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdateUserModelResult{}
-	var retval *UpdateUserModelResponse
-	if retval, err2 = p.handler.UpdateUserModel(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserModel: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateUserModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServiceCreateUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateUserModel_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateUserModel", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceCreateUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceCreateUserModelResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServiceCreateUserModelResult(%+v)", *p)
+
 }
 
-type userServiceProcessorDeleteUserModel struct {
-	handler UserService
+type UserServiceListUserModelsArgs struct {
+	Request *ListUserModelsRequest `thrift:"request,1"`
 }
 
-func (p *userServiceProcessorDeleteUserModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceDeleteUserModelArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteUserModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserServiceListUserModelsArgs() *UserServiceListUserModelsArgs {
+	return &UserServiceListUserModelsArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceDeleteUserModelResult{}
-	var retval *DeleteUserModelResponse
-	if retval, err2 = p.handler.DeleteUserModel(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteUserModel: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteUserModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteUserModel", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *UserServiceListUserModelsArgs) InitDefault() {
+}
+
+var UserServiceListUserModelsArgs_Request_DEFAULT *ListUserModelsRequest
+
+func (p *UserServiceListUserModelsArgs) GetRequest() (v *ListUserModelsRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceListUserModelsArgs_Request_DEFAULT
 	}
-	return true, err
+	return p.Request
 }
 
-type userServiceProcessorRegister struct {
-	handler UserService
+var fieldIDToName_UserServiceListUserModelsArgs = map[int16]string{
+	1: "request",
 }
 
-func (p *userServiceProcessorRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceRegisterArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Register", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UserServiceListUserModelsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceRegisterResult{}
-	var retval *LoginResponse
-	if retval, err2 = p.handler.Register(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Register: "+err2.Error())
-		oprot.WriteMessageBegin("Register", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Register", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *UserServiceListUserModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListUserModelsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type userServiceProcessorLogin struct {
-	handler UserService
+func (p *UserServiceListUserModelsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListUserModelsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
 }
 
-func (p *userServiceProcessorLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceLoginArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Login", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceListUserModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserModels_args"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceLoginResult{}
-	var retval *LoginResponse
-	if retval, err2 = p.handler.Login(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Login: "+err2.Error())
-		oprot.WriteMessageBegin("Login", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("Login", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceListUserModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type userServiceProcessorGetProfile struct {
-	handler UserService
+func (p *UserServiceListUserModelsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListUserModelsArgs(%+v)", *p)
+
 }
 
-func (p *userServiceProcessorGetProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceGetProfileArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type UserServiceListUserModelsResult struct {
+	Success *ListUserModelsResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceGetProfileResult{}
-	var retval *GetProfileResponse
-	if retval, err2 = p.handler.GetProfile(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetProfile: "+err2.Error())
-		oprot.WriteMessageBegin("GetProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetProfile", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewUserServiceListUserModelsResult() *UserServiceListUserModelsResult {
+	return &UserServiceListUserModelsResult{}
 }
 
-type userServiceProcessorUpdateProfile struct {
-	handler UserService
+func (p *UserServiceListUserModelsResult) InitDefault() {
 }
 
-func (p *userServiceProcessorUpdateProfile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdateProfileArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var UserServiceListUserModelsResult_Success_DEFAULT *ListUserModelsResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdateProfileResult{}
-	var retval *UpdateProfileResponse
-	if retval, err2 = p.handler.UpdateProfile(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateProfile: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateProfile", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateProfile", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *UserServiceListUserModelsResult) GetSuccess() (v *ListUserModelsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceListUserModelsResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type userServiceProcessorWechatLogin struct {
-	handler UserService
+var fieldIDToName_UserServiceListUserModelsResult = map[int16]string{
+	0: "success",
 }
 
-func (p *userServiceProcessorWechatLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceWechatLoginArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("WechatLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UserServiceListUserModelsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceWechatLoginResult{}
-	var retval *WechatLoginQRResponse
-	if retval, err2 = p.handler.WechatLogin(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WechatLogin: "+err2.Error())
-		oprot.WriteMessageBegin("WechatLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("WechatLogin", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *UserServiceListUserModelsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServiceProcessorWechatCallback struct {
-	handler UserService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListUserModelsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorWechatCallback) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceWechatCallbackArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("WechatCallback", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceListUserModelsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListUserModelsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceWechatCallbackResult{}
-	var retval *LoginResponse
-	if retval, err2 = p.handler.WechatCallback(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WechatCallback: "+err2.Error())
-		oprot.WriteMessageBegin("WechatCallback", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServiceListUserModelsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserModels_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("WechatCallback", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceListUserModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceListUserModelsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServiceListUserModelsResult(%+v)", *p)
+
 }
 
-type userServiceProcessorCheckUserModelConfigured struct {
-	handler UserService
+type UserServiceGetUserModelArgs struct {
+	Request *IDRequest `thrift:"request,1"`
 }
 
-func (p *userServiceProcessorCheckUserModelConfigured) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceCheckUserModelConfiguredArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserServiceGetUserModelArgs() *UserServiceGetUserModelArgs {
+	return &UserServiceGetUserModelArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceCheckUserModelConfiguredResult{}
-	var retval *CheckUserModelConfiguredResponse
-	if retval, err2 = p.handler.CheckUserModelConfigured(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CheckUserModelConfigured: "+err2.Error())
-		oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CheckUserModelConfigured", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UserServiceGetUserModelArgs) InitDefault() {
+}
+
+var UserServiceGetUserModelArgs_Request_DEFAULT *IDRequest
+
+func (p *UserServiceGetUserModelArgs) GetRequest() (v *IDRequest) {
+	if !p.IsSetRequest() {
+		return UserServiceGetUserModelArgs_Request_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Request
+}
+
+var fieldIDToName_UserServiceGetUserModelArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserServiceGetUserModelArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserServiceGetUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServiceProcessorForgotPassword struct {
-	handler UserService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorForgotPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceForgotPasswordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ForgotPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceGetUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIDRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Request = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceForgotPasswordResult{}
-	var retval *EmptyResponse
-	if retval, err2 = p.handler.ForgotPassword(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ForgotPassword: "+err2.Error())
-		oprot.WriteMessageBegin("ForgotPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServiceGetUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserModel_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ForgotPassword", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type userServiceProcessorResetPassword struct {
-	handler UserService
+func (p *UserServiceGetUserModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserModelArgs(%+v)", *p)
+
 }
 
-func (p *userServiceProcessorResetPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceResetPasswordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ResetPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type UserServiceGetUserModelResult struct {
+	Success *GetUserModelResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceResetPasswordResult{}
-	var retval *BaseResponse
-	if retval, err2 = p.handler.ResetPassword(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetPassword: "+err2.Error())
-		oprot.WriteMessageBegin("ResetPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ResetPassword", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func NewUserServiceGetUserModelResult() *UserServiceGetUserModelResult {
+	return &UserServiceGetUserModelResult{}
+}
+
+func (p *UserServiceGetUserModelResult) InitDefault() {
+}
+
+var UserServiceGetUserModelResult_Success_DEFAULT *GetUserModelResponse
+
+func (p *UserServiceGetUserModelResult) GetSuccess() (v *GetUserModelResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserModelResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_UserServiceGetUserModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceGetUserModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServiceProcessorLogout struct {
-	handler UserService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorLogout) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceLogoutArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Logout", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceGetUserModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceLogoutResult{}
-	var retval *BaseResponse
-	if retval, err2 = p.handler.Logout(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Logout: "+err2.Error())
-		oprot.WriteMessageBegin("Logout", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServiceGetUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserModel_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("Logout", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceGetUserModelResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServiceGetUserModelResult(%+v)", *p)
+
 }
 
-type UserServiceCreateUserModelArgs struct {
-	Request *CreateUserModelRequest `thrift:"request,1"`
+type UserServiceUpdateUserModelArgs struct {
+	Request *UpdateUserModelRequest `thrift:"request,1"`
 }
 
-func NewUserServiceCreateUserModelArgs() *UserServiceCreateUserModelArgs {
-	return &UserServiceCreateUserModelArgs{}
+func NewUserServiceUpdateUserModelArgs() *UserServiceUpdateUserModelArgs {
+	return &UserServiceUpdateUserModelArgs{}
 }
 
-func (p *UserServiceCreateUserModelArgs) InitDefault() {
+func (p *UserServiceUpdateUserModelArgs) InitDefault() {
 }
 
-var UserServiceCreateUserModelArgs_Request_DEFAULT *CreateUserModelRequest
+var UserServiceUpdateUserModelArgs_Request_DEFAULT *UpdateUserModelRequest
 
-func (p *UserServiceCreateUserModelArgs) GetRequest() (v *CreateUserModelRequest) {
+func (p *UserServiceUpdateUserModelArgs) GetRequest() (v *UpdateUserModelRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceCreateUserModelArgs_Request_DEFAULT
+		return UserServiceUpdateUserModelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceCreateUserModelArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdateUserModelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceCreateUserModelArgs) IsSetRequest() bool {
+func (p *UserServiceUpdateUserModelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceCreateUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8436,7 +12887,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserModelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8446,8 +12897,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateUserModelRequest()
+func (p *UserServiceUpdateUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserModelRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8455,9 +12906,9 @@ func (p *UserServiceCreateUserModelArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceCreateUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateUserModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserModel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8483,7 +12934,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8500,43 +12951,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelArgs) String() string {
+func (p *UserServiceUpdateUserModelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCreateUserModelArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateUserModelArgs(%+v)", *p)
 
 }
 
-type UserServiceCreateUserModelResult struct {
-	Success *CreateUserModelResponse `thrift:"success,0,optional"`
+type UserServiceUpdateUserModelResult struct {
+	Success *UpdateUserModelResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceCreateUserModelResult() *UserServiceCreateUserModelResult {
-	return &UserServiceCreateUserModelResult{}
+func NewUserServiceUpdateUserModelResult() *UserServiceUpdateUserModelResult {
+	return &UserServiceUpdateUserModelResult{}
 }
 
-func (p *UserServiceCreateUserModelResult) InitDefault() {
+func (p *UserServiceUpdateUserModelResult) InitDefault() {
 }
 
-var UserServiceCreateUserModelResult_Success_DEFAULT *CreateUserModelResponse
+var UserServiceUpdateUserModelResult_Success_DEFAULT *UpdateUserModelResponse
 
-func (p *UserServiceCreateUserModelResult) GetSuccess() (v *CreateUserModelResponse) {
+func (p *UserServiceUpdateUserModelResult) GetSuccess() (v *UpdateUserModelResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceCreateUserModelResult_Success_DEFAULT
+		return UserServiceUpdateUserModelResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceCreateUserModelResult = map[int16]string{
+var fieldIDToName_UserServiceUpdateUserModelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceCreateUserModelResult) IsSetSuccess() bool {
+func (p *UserServiceUpdateUserModelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceCreateUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8582,7 +13033,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserModelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8592,8 +13043,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateUserModelResponse()
+func (p *UserServiceUpdateUserModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserModelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8601,9 +13052,9 @@ func (p *UserServiceCreateUserModelResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserServiceCreateUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateUserModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserModel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8629,7 +13080,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8648,43 +13099,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceCreateUserModelResult) String() string {
+func (p *UserServiceUpdateUserModelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCreateUserModelResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateUserModelResult(%+v)", *p)
 
 }
 
-type UserServiceListUserModelsArgs struct {
-	Request *ListUserModelsRequest `thrift:"request,1"`
+type UserServiceDeleteUserModelArgs struct {
+	Request *IDRequest `thrift:"request,1"`
 }
 
-func NewUserServiceListUserModelsArgs() *UserServiceListUserModelsArgs {
-	return &UserServiceListUserModelsArgs{}
+func NewUserServiceDeleteUserModelArgs() *UserServiceDeleteUserModelArgs {
+	return &UserServiceDeleteUserModelArgs{}
 }
 
-func (p *UserServiceListUserModelsArgs) InitDefault() {
+func (p *UserServiceDeleteUserModelArgs) InitDefault() {
 }
 
-var UserServiceListUserModelsArgs_Request_DEFAULT *ListUserModelsRequest
+var UserServiceDeleteUserModelArgs_Request_DEFAULT *IDRequest
 
-func (p *UserServiceListUserModelsArgs) GetRequest() (v *ListUserModelsRequest) {
+func (p *UserServiceDeleteUserModelArgs) GetRequest() (v *IDRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceListUserModelsArgs_Request_DEFAULT
+		return UserServiceDeleteUserModelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceListUserModelsArgs = map[int16]string{
+var fieldIDToName_UserServiceDeleteUserModelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceListUserModelsArgs) IsSetRequest() bool {
+func (p *UserServiceDeleteUserModelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceListUserModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8730,7 +13181,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListUserModelsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserModelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8740,8 +13191,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListUserModelsRequest()
+func (p *UserServiceDeleteUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIDRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8749,9 +13200,9 @@ func (p *UserServiceListUserModelsArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceListUserModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListUserModels_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteUserModel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8777,7 +13228,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8794,43 +13245,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsArgs) String() string {
+func (p *UserServiceDeleteUserModelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceListUserModelsArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceDeleteUserModelArgs(%+v)", *p)
 
 }
 
-type UserServiceListUserModelsResult struct {
-	Success *ListUserModelsResponse `thrift:"success,0,optional"`
+type UserServiceDeleteUserModelResult struct {
+	Success *DeleteUserModelResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceListUserModelsResult() *UserServiceListUserModelsResult {
-	return &UserServiceListUserModelsResult{}
+func NewUserServiceDeleteUserModelResult() *UserServiceDeleteUserModelResult {
+	return &UserServiceDeleteUserModelResult{}
 }
 
-func (p *UserServiceListUserModelsResult) InitDefault() {
+func (p *UserServiceDeleteUserModelResult) InitDefault() {
 }
 
-var UserServiceListUserModelsResult_Success_DEFAULT *ListUserModelsResponse
+var UserServiceDeleteUserModelResult_Success_DEFAULT *DeleteUserModelResponse
 
-func (p *UserServiceListUserModelsResult) GetSuccess() (v *ListUserModelsResponse) {
+func (p *UserServiceDeleteUserModelResult) GetSuccess() (v *DeleteUserModelResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceListUserModelsResult_Success_DEFAULT
+		return UserServiceDeleteUserModelResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceListUserModelsResult = map[int16]string{
+var fieldIDToName_UserServiceDeleteUserModelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceListUserModelsResult) IsSetSuccess() bool {
+func (p *UserServiceDeleteUserModelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceListUserModelsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8876,7 +13327,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListUserModelsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserModelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8886,8 +13337,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListUserModelsResponse()
+func (p *UserServiceDeleteUserModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteUserModelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8895,9 +13346,9 @@ func (p *UserServiceListUserModelsResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *UserServiceListUserModelsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListUserModels_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteUserModel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8923,7 +13374,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceDeleteUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8942,43 +13393,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceListUserModelsResult) String() string {
+func (p *UserServiceDeleteUserModelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceListUserModelsResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceDeleteUserModelResult(%+v)", *p)
 
 }
 
-type UserServiceGetUserModelArgs struct {
-	Request *IDRequest `thrift:"request,1"`
+type UserServiceRegisterArgs struct {
+	Request *RegisterRequest `thrift:"request,1"`
 }
 
-func NewUserServiceGetUserModelArgs() *UserServiceGetUserModelArgs {
-	return &UserServiceGetUserModelArgs{}
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
 }
 
-func (p *UserServiceGetUserModelArgs) InitDefault() {
+func (p *UserServiceRegisterArgs) InitDefault() {
 }
 
-var UserServiceGetUserModelArgs_Request_DEFAULT *IDRequest
+var UserServiceRegisterArgs_Request_DEFAULT *RegisterRequest
 
-func (p *UserServiceGetUserModelArgs) GetRequest() (v *IDRequest) {
+func (p *UserServiceRegisterArgs) GetRequest() (v *RegisterRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceGetUserModelArgs_Request_DEFAULT
+		return UserServiceRegisterArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceGetUserModelArgs = map[int16]string{
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceGetUserModelArgs) IsSetRequest() bool {
+func (p *UserServiceRegisterArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceGetUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9024,7 +13475,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9034,8 +13485,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewIDRequest()
+func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRegisterRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9043,9 +13494,9 @@ func (p *UserServiceGetUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("Register_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9071,7 +13522,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9088,43 +13539,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelArgs) String() string {
+func (p *UserServiceRegisterArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserModelArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
 
 }
 
-type UserServiceGetUserModelResult struct {
-	Success *GetUserModelResponse `thrift:"success,0,optional"`
+type UserServiceRegisterResult struct {
+	Success *LoginResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetUserModelResult() *UserServiceGetUserModelResult {
-	return &UserServiceGetUserModelResult{}
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
 }
 
-func (p *UserServiceGetUserModelResult) InitDefault() {
+func (p *UserServiceRegisterResult) InitDefault() {
 }
 
-var UserServiceGetUserModelResult_Success_DEFAULT *GetUserModelResponse
+var UserServiceRegisterResult_Success_DEFAULT *LoginResponse
 
-func (p *UserServiceGetUserModelResult) GetSuccess() (v *GetUserModelResponse) {
+func (p *UserServiceRegisterResult) GetSuccess() (v *LoginResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserModelResult_Success_DEFAULT
+		return UserServiceRegisterResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetUserModelResult = map[int16]string{
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetUserModelResult) IsSetSuccess() bool {
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9170,7 +13621,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9180,8 +13631,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserModelResponse()
+func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9189,9 +13640,9 @@ func (p *UserServiceGetUserModelResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("Register_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9217,7 +13668,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9236,43 +13687,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserModelResult) String() string {
+func (p *UserServiceRegisterResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserModelResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
 
 }
 
-type UserServiceUpdateUserModelArgs struct {
-	Request *UpdateUserModelRequest `thrift:"request,1"`
+type UserServiceLoginArgs struct {
+	Request *LoginRequest `thrift:"request,1"`
 }
 
-func NewUserServiceUpdateUserModelArgs() *UserServiceUpdateUserModelArgs {
-	return &UserServiceUpdateUserModelArgs{}
+func NewUserServiceLoginArgs() *UserServiceLoginArgs {
+	return &UserServiceLoginArgs{}
 }
 
-func (p *UserServiceUpdateUserModelArgs) InitDefault() {
+func (p *UserServiceLoginArgs) InitDefault() {
 }
 
-var UserServiceUpdateUserModelArgs_Request_DEFAULT *UpdateUserModelRequest
+var UserServiceLoginArgs_Request_DEFAULT *LoginRequest
 
-func (p *UserServiceUpdateUserModelArgs) GetRequest() (v *UpdateUserModelRequest) {
+func (p *UserServiceLoginArgs) GetRequest() (v *LoginRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceUpdateUserModelArgs_Request_DEFAULT
+		return UserServiceLoginArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceUpdateUserModelArgs = map[int16]string{
+var fieldIDToName_UserServiceLoginArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceUpdateUserModelArgs) IsSetRequest() bool {
+func (p *UserServiceLoginArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceUpdateUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9318,7 +13769,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9328,8 +13779,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserModelRequest()
+func (p *UserServiceLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewLoginRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9337,9 +13788,9 @@ func (p *UserServiceUpdateUserModelArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceUpdateUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("Login_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9365,7 +13816,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9382,43 +13833,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelArgs) String() string {
+func (p *UserServiceLoginArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateUserModelArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdateUserModelResult struct {
-	Success *UpdateUserModelResponse `thrift:"success,0,optional"`
+type UserServiceLoginResult struct {
+	Success *LoginResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdateUserModelResult() *UserServiceUpdateUserModelResult {
-	return &UserServiceUpdateUserModelResult{}
+func NewUserServiceLoginResult() *UserServiceLoginResult {
+	return &UserServiceLoginResult{}
 }
 
-func (p *UserServiceUpdateUserModelResult) InitDefault() {
+func (p *UserServiceLoginResult) InitDefault() {
 }
 
-var UserServiceUpdateUserModelResult_Success_DEFAULT *UpdateUserModelResponse
+var UserServiceLoginResult_Success_DEFAULT *LoginResponse
 
-func (p *UserServiceUpdateUserModelResult) GetSuccess() (v *UpdateUserModelResponse) {
+func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateUserModelResult_Success_DEFAULT
+		return UserServiceLoginResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdateUserModelResult = map[int16]string{
+var fieldIDToName_UserServiceLoginResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdateUserModelResult) IsSetSuccess() bool {
+func (p *UserServiceLoginResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9464,7 +13915,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9474,8 +13925,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserModelResponse()
+func (p *UserServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9483,9 +13934,9 @@ func (p *UserServiceUpdateUserModelResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserServiceUpdateUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("Login_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9511,7 +13962,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9530,43 +13981,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateUserModelResult) String() string {
+func (p *UserServiceLoginResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateUserModelResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
 
 }
 
-type UserServiceDeleteUserModelArgs struct {
-	Request *IDRequest `thrift:"request,1"`
+type UserServiceGetProfileArgs struct {
+	Request *EmptyRequest `thrift:"request,1"`
 }
 
-func NewUserServiceDeleteUserModelArgs() *UserServiceDeleteUserModelArgs {
-	return &UserServiceDeleteUserModelArgs{}
+func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
+	return &UserServiceGetProfileArgs{}
 }
 
-func (p *UserServiceDeleteUserModelArgs) InitDefault() {
+func (p *UserServiceGetProfileArgs) InitDefault() {
 }
 
-var UserServiceDeleteUserModelArgs_Request_DEFAULT *IDRequest
+var UserServiceGetProfileArgs_Request_DEFAULT *EmptyRequest
 
-func (p *UserServiceDeleteUserModelArgs) GetRequest() (v *IDRequest) {
+func (p *UserServiceGetProfileArgs) GetRequest() (v *EmptyRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceDeleteUserModelArgs_Request_DEFAULT
+		return UserServiceGetProfileArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceDeleteUserModelArgs = map[int16]string{
+var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceDeleteUserModelArgs) IsSetRequest() bool {
+func (p *UserServiceGetProfileArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceDeleteUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9612,7 +14063,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9622,8 +14073,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewIDRequest()
+func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmptyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9631,9 +14082,9 @@ func (p *UserServiceDeleteUserModelArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceDeleteUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteUserModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetProfile_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9659,7 +14110,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9676,43 +14127,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelArgs) String() string {
+func (p *UserServiceGetProfileArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceDeleteUserModelArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
 
 }
 
-type UserServiceDeleteUserModelResult struct {
-	Success *DeleteUserModelResponse `thrift:"success,0,optional"`
+type UserServiceGetProfileResult struct {
+	Success *GetProfileResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceDeleteUserModelResult() *UserServiceDeleteUserModelResult {
-	return &UserServiceDeleteUserModelResult{}
+func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
+	return &UserServiceGetProfileResult{}
 }
 
-func (p *UserServiceDeleteUserModelResult) InitDefault() {
+func (p *UserServiceGetProfileResult) InitDefault() {
 }
 
-var UserServiceDeleteUserModelResult_Success_DEFAULT *DeleteUserModelResponse
+var UserServiceGetProfileResult_Success_DEFAULT *GetProfileResponse
 
-func (p *UserServiceDeleteUserModelResult) GetSuccess() (v *DeleteUserModelResponse) {
+func (p *UserServiceGetProfileResult) GetSuccess() (v *GetProfileResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceDeleteUserModelResult_Success_DEFAULT
+		return UserServiceGetProfileResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceDeleteUserModelResult = map[int16]string{
+var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceDeleteUserModelResult) IsSetSuccess() bool {
+func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceDeleteUserModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9758,7 +14209,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9768,8 +14219,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteUserModelResponse()
+func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetProfileResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9777,9 +14228,9 @@ func (p *UserServiceDeleteUserModelResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserServiceDeleteUserModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteUserModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetProfile_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9805,7 +14256,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9824,43 +14275,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceDeleteUserModelResult) String() string {
+func (p *UserServiceGetProfileResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceDeleteUserModelResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
 
 }
 
-type UserServiceRegisterArgs struct {
-	Request *RegisterRequest `thrift:"request,1"`
+type UserServiceUpdateProfileArgs struct {
+	Request *UpdateProfileRequest `thrift:"request,1"`
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
+	return &UserServiceUpdateProfileArgs{}
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+func (p *UserServiceUpdateProfileArgs) InitDefault() {
 }
 
-var UserServiceRegisterArgs_Request_DEFAULT *RegisterRequest
+var UserServiceUpdateProfileArgs_Request_DEFAULT *UpdateProfileRequest
 
-func (p *UserServiceRegisterArgs) GetRequest() (v *RegisterRequest) {
+func (p *UserServiceUpdateProfileArgs) GetRequest() (v *UpdateProfileRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceRegisterArgs_Request_DEFAULT
+		return UserServiceUpdateProfileArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceRegisterArgs) IsSetRequest() bool {
+func (p *UserServiceUpdateProfileArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9906,7 +14357,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9916,8 +14367,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRegisterRequest()
+func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateProfileRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9925,9 +14376,9 @@ func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Register_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateProfile_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9953,7 +14404,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9970,43 +14421,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *UserServiceUpdateProfileArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
 
 }
 
-type UserServiceRegisterResult struct {
-	Success *LoginResponse `thrift:"success,0,optional"`
+type UserServiceUpdateProfileResult struct {
+	Success *UpdateProfileResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
+	return &UserServiceUpdateProfileResult{}
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func (p *UserServiceUpdateProfileResult) InitDefault() {
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *LoginResponse
+var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResponse
 
-func (p *UserServiceRegisterResult) GetSuccess() (v *LoginResponse) {
+func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
+		return UserServiceUpdateProfileResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10052,7 +14503,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10062,8 +14513,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginResponse()
+func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateProfileResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10071,9 +14522,9 @@ func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Register_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateProfile_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10099,7 +14550,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10118,43 +14569,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *UserServiceUpdateProfileResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
 
 }
 
-type UserServiceLoginArgs struct {
-	Request *LoginRequest `thrift:"request,1"`
+type UserServiceWechatLoginArgs struct {
+	Request *EmptyRequest `thrift:"request,1"`
 }
 
-func NewUserServiceLoginArgs() *UserServiceLoginArgs {
-	return &UserServiceLoginArgs{}
+func NewUserServiceWechatLoginArgs() *UserServiceWechatLoginArgs {
+	return &UserServiceWechatLoginArgs{}
 }
 
-func (p *UserServiceLoginArgs) InitDefault() {
+func (p *UserServiceWechatLoginArgs) InitDefault() {
 }
 
-var UserServiceLoginArgs_Request_DEFAULT *LoginRequest
+var UserServiceWechatLoginArgs_Request_DEFAULT *EmptyRequest
 
-func (p *UserServiceLoginArgs) GetRequest() (v *LoginRequest) {
+func (p *UserServiceWechatLoginArgs) GetRequest() (v *EmptyRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceLoginArgs_Request_DEFAULT
+		return UserServiceWechatLoginArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+var fieldIDToName_UserServiceWechatLoginArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceLoginArgs) IsSetRequest() bool {
+func (p *UserServiceWechatLoginArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10200,7 +14651,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatLoginArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10210,8 +14661,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewLoginRequest()
+func (p *UserServiceWechatLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmptyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10219,9 +14670,9 @@ func (p *UserServiceLoginArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Login_args"); err != nil {
+	if err = oprot.WriteStructBegin("WechatLogin_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10247,7 +14698,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10264,43 +14715,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceLoginArgs) String() string {
+func (p *UserServiceWechatLoginArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceWechatLoginArgs(%+v)", *p)
 
 }
 
-type UserServiceLoginResult struct {
-	Success *LoginResponse `thrift:"success,0,optional"`
+type UserServiceWechatLoginResult struct {
+	Success *WechatLoginQRResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceLoginResult() *UserServiceLoginResult {
-	return &UserServiceLoginResult{}
+func NewUserServiceWechatLoginResult() *UserServiceWechatLoginResult {
+	return &UserServiceWechatLoginResult{}
 }
 
-func (p *UserServiceLoginResult) InitDefault() {
+func (p *UserServiceWechatLoginResult) InitDefault() {
 }
 
-var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+var UserServiceWechatLoginResult_Success_DEFAULT *WechatLoginQRResponse
 
-func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+func (p *UserServiceWechatLoginResult) GetSuccess() (v *WechatLoginQRResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceLoginResult_Success_DEFAULT
+		return UserServiceWechatLoginResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceLoginResult = map[int16]string{
+var fieldIDToName_UserServiceWechatLoginResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceLoginResult) IsSetSuccess() bool {
+func (p *UserServiceWechatLoginResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10346,7 +14797,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatLoginResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10356,8 +14807,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginResponse()
+func (p *UserServiceWechatLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewWechatLoginQRResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10365,9 +14816,9 @@ func (p *UserServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Login_result"); err != nil {
+	if err = oprot.WriteStructBegin("WechatLogin_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10393,7 +14844,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10412,43 +14863,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceLoginResult) String() string {
+func (p *UserServiceWechatLoginResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceWechatLoginResult(%+v)", *p)
 
 }
 
-type UserServiceGetProfileArgs struct {
-	Request *EmptyRequest `thrift:"request,1"`
+type UserServiceWechatCallbackArgs struct {
+	Request *WechatCallbackRequest `thrift:"request,1"`
 }
 
-func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
-	return &UserServiceGetProfileArgs{}
+func NewUserServiceWechatCallbackArgs() *UserServiceWechatCallbackArgs {
+	return &UserServiceWechatCallbackArgs{}
 }
 
-func (p *UserServiceGetProfileArgs) InitDefault() {
+func (p *UserServiceWechatCallbackArgs) InitDefault() {
 }
 
-var UserServiceGetProfileArgs_Request_DEFAULT *EmptyRequest
+var UserServiceWechatCallbackArgs_Request_DEFAULT *WechatCallbackRequest
 
-func (p *UserServiceGetProfileArgs) GetRequest() (v *EmptyRequest) {
+func (p *UserServiceWechatCallbackArgs) GetRequest() (v *WechatCallbackRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceGetProfileArgs_Request_DEFAULT
+		return UserServiceWechatCallbackArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceWechatCallbackArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceGetProfileArgs) IsSetRequest() bool {
+func (p *UserServiceWechatCallbackArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceGetProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10494,7 +14945,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatCallbackArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10504,8 +14955,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEmptyRequest()
+func (p *UserServiceWechatCallbackArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewWechatCallbackRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10513,9 +14964,9 @@ func (p *UserServiceGetProfileArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetProfile_args"); err != nil {
+	if err = oprot.WriteStructBegin("WechatCallback_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10541,7 +14992,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10558,43 +15009,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileArgs) String() string {
+func (p *UserServiceWechatCallbackArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceWechatCallbackArgs(%+v)", *p)
 
 }
 
-type UserServiceGetProfileResult struct {
-	Success *GetProfileResponse `thrift:"success,0,optional"`
+type UserServiceWechatCallbackResult struct {
+	Success *LoginResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
-	return &UserServiceGetProfileResult{}
+func NewUserServiceWechatCallbackResult() *UserServiceWechatCallbackResult {
+	return &UserServiceWechatCallbackResult{}
 }
 
-func (p *UserServiceGetProfileResult) InitDefault() {
+func (p *UserServiceWechatCallbackResult) InitDefault() {
 }
 
-var UserServiceGetProfileResult_Success_DEFAULT *GetProfileResponse
+var UserServiceWechatCallbackResult_Success_DEFAULT *LoginResponse
 
-func (p *UserServiceGetProfileResult) GetSuccess() (v *GetProfileResponse) {
+func (p *UserServiceWechatCallbackResult) GetSuccess() (v *LoginResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetProfileResult_Success_DEFAULT
+		return UserServiceWechatCallbackResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
+var fieldIDToName_UserServiceWechatCallbackResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
+func (p *UserServiceWechatCallbackResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetProfileResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10640,7 +15091,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetProfileResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatCallbackResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10650,8 +15101,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetProfileResponse()
+func (p *UserServiceWechatCallbackResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10659,9 +15110,9 @@ func (p *UserServiceGetProfileResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetProfileResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetProfile_result"); err != nil {
+	if err = oprot.WriteStructBegin("WechatCallback_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10687,7 +15138,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceWechatCallbackResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10706,43 +15157,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetProfileResult) String() string {
+func (p *UserServiceWechatCallbackResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceWechatCallbackResult(%+v)", *p)
 
 }
 
-type UserServiceUpdateProfileArgs struct {
-	Request *UpdateProfileRequest `thrift:"request,1"`
+type UserServiceCheckUserModelConfiguredArgs struct {
+	Request *EmptyRequest `thrift:"request,1"`
 }
 
-func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
-	return &UserServiceUpdateProfileArgs{}
+func NewUserServiceCheckUserModelConfiguredArgs() *UserServiceCheckUserModelConfiguredArgs {
+	return &UserServiceCheckUserModelConfiguredArgs{}
 }
 
-func (p *UserServiceUpdateProfileArgs) InitDefault() {
+func (p *UserServiceCheckUserModelConfiguredArgs) InitDefault() {
 }
 
-var UserServiceUpdateProfileArgs_Request_DEFAULT *UpdateProfileRequest
+var UserServiceCheckUserModelConfiguredArgs_Request_DEFAULT *EmptyRequest
 
-func (p *UserServiceUpdateProfileArgs) GetRequest() (v *UpdateProfileRequest) {
+func (p *UserServiceCheckUserModelConfiguredArgs) GetRequest() (v *EmptyRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceUpdateProfileArgs_Request_DEFAULT
+		return UserServiceCheckUserModelConfiguredArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceCheckUserModelConfiguredArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceUpdateProfileArgs) IsSetRequest() bool {
+func (p *UserServiceCheckUserModelConfiguredArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceUpdateProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10788,7 +15239,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserModelConfiguredArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10798,8 +15249,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateProfileRequest()
+func (p *UserServiceCheckUserModelConfiguredArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewEmptyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10807,9 +15258,9 @@ func (p *UserServiceUpdateProfileArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUpdateProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateProfile_args"); err != nil {
+	if err = oprot.WriteStructBegin("CheckUserModelConfigured_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10835,7 +15286,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10852,43 +15303,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileArgs) String() string {
+func (p *UserServiceCheckUserModelConfiguredArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceCheckUserModelConfiguredArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdateProfileResult struct {
-	Success *UpdateProfileResponse `thrift:"success,0,optional"`
+type UserServiceCheckUserModelConfiguredResult struct {
+	Success *CheckUserModelConfiguredResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
-	return &UserServiceUpdateProfileResult{}
+func NewUserServiceCheckUserModelConfiguredResult() *UserServiceCheckUserModelConfiguredResult {
+	return &UserServiceCheckUserModelConfiguredResult{}
 }
 
-func (p *UserServiceUpdateProfileResult) InitDefault() {
+func (p *UserServiceCheckUserModelConfiguredResult) InitDefault() {
 }
 
-var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResponse
+var UserServiceCheckUserModelConfiguredResult_Success_DEFAULT *CheckUserModelConfiguredResponse
 
-func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResponse) {
+func (p *UserServiceCheckUserModelConfiguredResult) GetSuccess() (v *CheckUserModelConfiguredResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateProfileResult_Success_DEFAULT
+		return UserServiceCheckUserModelConfiguredResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+var fieldIDToName_UserServiceCheckUserModelConfiguredResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+func (p *UserServiceCheckUserModelConfiguredResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateProfileResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10934,7 +15385,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserModelConfiguredResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10944,8 +15395,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateProfileResponse()
+func (p *UserServiceCheckUserModelConfiguredResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCheckUserModelConfiguredResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10953,9 +15404,9 @@ func (p *UserServiceUpdateProfileResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceUpdateProfileResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateProfile_result"); err != nil {
+	if err = oprot.WriteStructBegin("CheckUserModelConfigured_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10981,7 +15432,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceCheckUserModelConfiguredResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11000,43 +15451,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateProfileResult) String() string {
+func (p *UserServiceCheckUserModelConfiguredResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceCheckUserModelConfiguredResult(%+v)", *p)
 
 }
 
-type UserServiceWechatLoginArgs struct {
-	Request *EmptyRequest `thrift:"request,1"`
+type UserServiceForgotPasswordArgs struct {
+	Request *ForgotPasswordRequest `thrift:"request,1"`
 }
 
-func NewUserServiceWechatLoginArgs() *UserServiceWechatLoginArgs {
-	return &UserServiceWechatLoginArgs{}
+func NewUserServiceForgotPasswordArgs() *UserServiceForgotPasswordArgs {
+	return &UserServiceForgotPasswordArgs{}
 }
 
-func (p *UserServiceWechatLoginArgs) InitDefault() {
+func (p *UserServiceForgotPasswordArgs) InitDefault() {
 }
 
-var UserServiceWechatLoginArgs_Request_DEFAULT *EmptyRequest
+var UserServiceForgotPasswordArgs_Request_DEFAULT *ForgotPasswordRequest
 
-func (p *UserServiceWechatLoginArgs) GetRequest() (v *EmptyRequest) {
+func (p *UserServiceForgotPasswordArgs) GetRequest() (v *ForgotPasswordRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceWechatLoginArgs_Request_DEFAULT
+		return UserServiceForgotPasswordArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceWechatLoginArgs = map[int16]string{
+var fieldIDToName_UserServiceForgotPasswordArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceWechatLoginArgs) IsSetRequest() bool {
+func (p *UserServiceForgotPasswordArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceWechatLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11082,7 +15533,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11092,8 +15543,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEmptyRequest()
+func (p *UserServiceForgotPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewForgotPasswordRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11101,9 +15552,9 @@ func (p *UserServiceWechatLoginArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceWechatLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WechatLogin_args"); err != nil {
+	if err = oprot.WriteStructBegin("ForgotPassword_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11129,7 +15580,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11146,43 +15597,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginArgs) String() string {
+func (p *UserServiceForgotPasswordArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceWechatLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceForgotPasswordArgs(%+v)", *p)
 
 }
 
-type UserServiceWechatLoginResult struct {
-	Success *WechatLoginQRResponse `thrift:"success,0,optional"`
+type UserServiceForgotPasswordResult struct {
+	Success *EmptyResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceWechatLoginResult() *UserServiceWechatLoginResult {
-	return &UserServiceWechatLoginResult{}
+func NewUserServiceForgotPasswordResult() *UserServiceForgotPasswordResult {
+	return &UserServiceForgotPasswordResult{}
 }
 
-func (p *UserServiceWechatLoginResult) InitDefault() {
+func (p *UserServiceForgotPasswordResult) InitDefault() {
 }
 
-var UserServiceWechatLoginResult_Success_DEFAULT *WechatLoginQRResponse
+var UserServiceForgotPasswordResult_Success_DEFAULT *EmptyResponse
 
-func (p *UserServiceWechatLoginResult) GetSuccess() (v *WechatLoginQRResponse) {
+func (p *UserServiceForgotPasswordResult) GetSuccess() (v *EmptyResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceWechatLoginResult_Success_DEFAULT
+		return UserServiceForgotPasswordResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceWechatLoginResult = map[int16]string{
+var fieldIDToName_UserServiceForgotPasswordResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceWechatLoginResult) IsSetSuccess() bool {
+func (p *UserServiceForgotPasswordResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceWechatLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11228,7 +15679,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11238,8 +15689,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewWechatLoginQRResponse()
+func (p *UserServiceForgotPasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewEmptyResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11247,9 +15698,9 @@ func (p *UserServiceWechatLoginResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceWechatLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WechatLogin_result"); err != nil {
+	if err = oprot.WriteStructBegin("ForgotPassword_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11275,7 +15726,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceForgotPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11294,43 +15745,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceWechatLoginResult) String() string {
+func (p *UserServiceForgotPasswordResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceWechatLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceForgotPasswordResult(%+v)", *p)
 
 }
 
-type UserServiceWechatCallbackArgs struct {
-	Request *WechatCallbackRequest `thrift:"request,1"`
+type UserServiceResetPasswordArgs struct {
+	Request *ResetPasswordRequest `thrift:"request,1"`
 }
 
-func NewUserServiceWechatCallbackArgs() *UserServiceWechatCallbackArgs {
-	return &UserServiceWechatCallbackArgs{}
+func NewUserServiceResetPasswordArgs() *UserServiceResetPasswordArgs {
+	return &UserServiceResetPasswordArgs{}
 }
 
-func (p *UserServiceWechatCallbackArgs) InitDefault() {
+func (p *UserServiceResetPasswordArgs) InitDefault() {
 }
 
-var UserServiceWechatCallbackArgs_Request_DEFAULT *WechatCallbackRequest
+var UserServiceResetPasswordArgs_Request_DEFAULT *ResetPasswordRequest
 
-func (p *UserServiceWechatCallbackArgs) GetRequest() (v *WechatCallbackRequest) {
+func (p *UserServiceResetPasswordArgs) GetRequest() (v *ResetPasswordRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceWechatCallbackArgs_Request_DEFAULT
+		return UserServiceResetPasswordArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceWechatCallbackArgs = map[int16]string{
+var fieldIDToName_UserServiceResetPasswordArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceWechatCallbackArgs) IsSetRequest() bool {
+func (p *UserServiceResetPasswordArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceWechatCallbackArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11376,7 +15827,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatCallbackArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11386,8 +15837,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewWechatCallbackRequest()
+func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResetPasswordRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11395,9 +15846,9 @@ func (p *UserServiceWechatCallbackArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceWechatCallbackArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WechatCallback_args"); err != nil {
+	if err = oprot.WriteStructBegin("ResetPassword_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11423,7 +15874,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11440,43 +15891,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackArgs) String() string {
+func (p *UserServiceResetPasswordArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceWechatCallbackArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceResetPasswordArgs(%+v)", *p)
 
 }
 
-type UserServiceWechatCallbackResult struct {
-	Success *LoginResponse `thrift:"success,0,optional"`
+type UserServiceResetPasswordResult struct {
+	Success *BaseResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceWechatCallbackResult() *UserServiceWechatCallbackResult {
-	return &UserServiceWechatCallbackResult{}
+func NewUserServiceResetPasswordResult() *UserServiceResetPasswordResult {
+	return &UserServiceResetPasswordResult{}
 }
 
-func (p *UserServiceWechatCallbackResult) InitDefault() {
+func (p *UserServiceResetPasswordResult) InitDefault() {
 }
 
-var UserServiceWechatCallbackResult_Success_DEFAULT *LoginResponse
+var UserServiceResetPasswordResult_Success_DEFAULT *BaseResponse
 
-func (p *UserServiceWechatCallbackResult) GetSuccess() (v *LoginResponse) {
+func (p *UserServiceResetPasswordResult) GetSuccess() (v *BaseResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceWechatCallbackResult_Success_DEFAULT
+		return UserServiceResetPasswordResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceWechatCallbackResult = map[int16]string{
+var fieldIDToName_UserServiceResetPasswordResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceWechatCallbackResult) IsSetSuccess() bool {
+func (p *UserServiceResetPasswordResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceWechatCallbackResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11522,7 +15973,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceWechatCallbackResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11532,8 +15983,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginResponse()
+func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBaseResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11541,9 +15992,9 @@ func (p *UserServiceWechatCallbackResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *UserServiceWechatCallbackResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("WechatCallback_result"); err != nil {
+	if err = oprot.WriteStructBegin("ResetPassword_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11569,7 +16020,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11588,43 +16039,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceWechatCallbackResult) String() string {
+func (p *UserServiceResetPasswordResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceWechatCallbackResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceResetPasswordResult(%+v)", *p)
 
 }
 
-type UserServiceCheckUserModelConfiguredArgs struct {
+type UserServiceLogoutArgs struct {
 	Request *EmptyRequest `thrift:"request,1"`
 }
 
-func NewUserServiceCheckUserModelConfiguredArgs() *UserServiceCheckUserModelConfiguredArgs {
-	return &UserServiceCheckUserModelConfiguredArgs{}
+func NewUserServiceLogoutArgs() *UserServiceLogoutArgs {
+	return &UserServiceLogoutArgs{}
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) InitDefault() {
+func (p *UserServiceLogoutArgs) InitDefault() {
 }
 
-var UserServiceCheckUserModelConfiguredArgs_Request_DEFAULT *EmptyRequest
+var UserServiceLogoutArgs_Request_DEFAULT *EmptyRequest
 
-func (p *UserServiceCheckUserModelConfiguredArgs) GetRequest() (v *EmptyRequest) {
+func (p *UserServiceLogoutArgs) GetRequest() (v *EmptyRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceCheckUserModelConfiguredArgs_Request_DEFAULT
+		return UserServiceLogoutArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceCheckUserModelConfiguredArgs = map[int16]string{
+var fieldIDToName_UserServiceLogoutArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) IsSetRequest() bool {
+func (p *UserServiceLogoutArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11670,7 +16121,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserModelConfiguredArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLogoutArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11680,7 +16131,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) ReadField1(iprot thrift.TProtocol) error {
+func (p *UserServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
 	_field := NewEmptyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -11689,9 +16140,9 @@ func (p *UserServiceCheckUserModelConfiguredArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckUserModelConfigured_args"); err != nil {
+	if err = oprot.WriteStructBegin("Logout_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11717,7 +16168,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11734,43 +16185,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredArgs) String() string {
+func (p *UserServiceLogoutArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCheckUserModelConfiguredArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceLogoutArgs(%+v)", *p)
 
 }
 
-type UserServiceCheckUserModelConfiguredResult struct {
-	Success *CheckUserModelConfiguredResponse `thrift:"success,0,optional"`
+type UserServiceLogoutResult struct {
+	Success *BaseResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceCheckUserModelConfiguredResult() *UserServiceCheckUserModelConfiguredResult {
-	return &UserServiceCheckUserModelConfiguredResult{}
+func NewUserServiceLogoutResult() *UserServiceLogoutResult {
+	return &UserServiceLogoutResult{}
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) InitDefault() {
+func (p *UserServiceLogoutResult) InitDefault() {
 }
 
-var UserServiceCheckUserModelConfiguredResult_Success_DEFAULT *CheckUserModelConfiguredResponse
+var UserServiceLogoutResult_Success_DEFAULT *BaseResponse
 
-func (p *UserServiceCheckUserModelConfiguredResult) GetSuccess() (v *CheckUserModelConfiguredResponse) {
+func (p *UserServiceLogoutResult) GetSuccess() (v *BaseResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceCheckUserModelConfiguredResult_Success_DEFAULT
+		return UserServiceLogoutResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceCheckUserModelConfiguredResult = map[int16]string{
+var fieldIDToName_UserServiceLogoutResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) IsSetSuccess() bool {
+func (p *UserServiceLogoutResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11816,7 +16267,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserModelConfiguredResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLogoutResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11826,8 +16277,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCheckUserModelConfiguredResponse()
+func (p *UserServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBaseResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11835,9 +16286,9 @@ func (p *UserServiceCheckUserModelConfiguredResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckUserModelConfigured_result"); err != nil {
+	if err = oprot.WriteStructBegin("Logout_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11863,7 +16314,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceLogoutResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11882,43 +16333,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceCheckUserModelConfiguredResult) String() string {
+func (p *UserServiceLogoutResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceCheckUserModelConfiguredResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceLogoutResult(%+v)", *p)
 
 }
 
-type UserServiceForgotPasswordArgs struct {
-	Request *ForgotPasswordRequest `thrift:"request,1"`
+type UserServiceTestUserModelArgs struct {
+	Request *IDRequest `thrift:"request,1"`
 }
 
-func NewUserServiceForgotPasswordArgs() *UserServiceForgotPasswordArgs {
-	return &UserServiceForgotPasswordArgs{}
+func NewUserServiceTestUserModelArgs() *UserServiceTestUserModelArgs {
+	return &UserServiceTestUserModelArgs{}
 }
 
-func (p *UserServiceForgotPasswordArgs) InitDefault() {
+func (p *UserServiceTestUserModelArgs) InitDefault() {
 }
 
-var UserServiceForgotPasswordArgs_Request_DEFAULT *ForgotPasswordRequest
+var UserServiceTestUserModelArgs_Request_DEFAULT *IDRequest
 
-func (p *UserServiceForgotPasswordArgs) GetRequest() (v *ForgotPasswordRequest) {
+func (p *UserServiceTestUserModelArgs) GetRequest() (v *IDRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceForgotPasswordArgs_Request_DEFAULT
+		return UserServiceTestUserModelArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceForgotPasswordArgs = map[int16]string{
+var fieldIDToName_UserServiceTestUserModelArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceForgotPasswordArgs) IsSetRequest() bool {
+func (p *UserServiceTestUserModelArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceForgotPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11964,7 +16415,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceTestUserModelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11974,8 +16425,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewForgotPasswordRequest()
+func (p *UserServiceTestUserModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIDRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11983,9 +16434,9 @@ func (p *UserServiceForgotPasswordArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceForgotPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ForgotPassword_args"); err != nil {
+	if err = oprot.WriteStructBegin("TestUserModel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12011,7 +16462,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12028,43 +16479,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordArgs) String() string {
+func (p *UserServiceTestUserModelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceForgotPasswordArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceTestUserModelArgs(%+v)", *p)
 
 }
 
-type UserServiceForgotPasswordResult struct {
-	Success *EmptyResponse `thrift:"success,0,optional"`
+type UserServiceTestUserModelResult struct {
+	Success *TestUserModelResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceForgotPasswordResult() *UserServiceForgotPasswordResult {
-	return &UserServiceForgotPasswordResult{}
+func NewUserServiceTestUserModelResult() *UserServiceTestUserModelResult {
+	return &UserServiceTestUserModelResult{}
 }
 
-func (p *UserServiceForgotPasswordResult) InitDefault() {
+func (p *UserServiceTestUserModelResult) InitDefault() {
 }
 
-var UserServiceForgotPasswordResult_Success_DEFAULT *EmptyResponse
+var UserServiceTestUserModelResult_Success_DEFAULT *TestUserModelResponse
 
-func (p *UserServiceForgotPasswordResult) GetSuccess() (v *EmptyResponse) {
+func (p *UserServiceTestUserModelResult) GetSuccess() (v *TestUserModelResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceForgotPasswordResult_Success_DEFAULT
+		return UserServiceTestUserModelResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceForgotPasswordResult = map[int16]string{
+var fieldIDToName_UserServiceTestUserModelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceForgotPasswordResult) IsSetSuccess() bool {
+func (p *UserServiceTestUserModelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceForgotPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12110,7 +16561,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceTestUserModelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12120,8 +16571,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewEmptyResponse()
+func (p *UserServiceTestUserModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTestUserModelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12129,9 +16580,9 @@ func (p *UserServiceForgotPasswordResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *UserServiceForgotPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ForgotPassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("TestUserModel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12157,7 +16608,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12176,43 +16627,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceForgotPasswordResult) String() string {
+func (p *UserServiceTestUserModelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceForgotPasswordResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceTestUserModelResult(%+v)", *p)
 
 }
 
-type UserServiceResetPasswordArgs struct {
-	Request *ResetPasswordRequest `thrift:"request,1"`
+type UserServiceTestUserModelParamsArgs struct {
+	Request *TestUserModelParamsRequest `thrift:"request,1"`
 }
 
-func NewUserServiceResetPasswordArgs() *UserServiceResetPasswordArgs {
-	return &UserServiceResetPasswordArgs{}
+func NewUserServiceTestUserModelParamsArgs() *UserServiceTestUserModelParamsArgs {
+	return &UserServiceTestUserModelParamsArgs{}
 }
 
-func (p *UserServiceResetPasswordArgs) InitDefault() {
+func (p *UserServiceTestUserModelParamsArgs) InitDefault() {
 }
 
-var UserServiceResetPasswordArgs_Request_DEFAULT *ResetPasswordRequest
+var UserServiceTestUserModelParamsArgs_Request_DEFAULT *TestUserModelParamsRequest
 
-func (p *UserServiceResetPasswordArgs) GetRequest() (v *ResetPasswordRequest) {
+func (p *UserServiceTestUserModelParamsArgs) GetRequest() (v *TestUserModelParamsRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceResetPasswordArgs_Request_DEFAULT
+		return UserServiceTestUserModelParamsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceResetPasswordArgs = map[int16]string{
+var fieldIDToName_UserServiceTestUserModelParamsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceResetPasswordArgs) IsSetRequest() bool {
+func (p *UserServiceTestUserModelParamsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12258,7 +16709,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceTestUserModelParamsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12268,8 +16719,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewResetPasswordRequest()
+func (p *UserServiceTestUserModelParamsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTestUserModelParamsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12277,9 +16728,9 @@ func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ResetPassword_args"); err != nil {
+	if err = oprot.WriteStructBegin("TestUserModelParams_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12305,7 +16756,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12322,43 +16773,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) String() string {
+func (p *UserServiceTestUserModelParamsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceResetPasswordArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceTestUserModelParamsArgs(%+v)", *p)
 
 }
 
-type UserServiceResetPasswordResult struct {
-	Success *BaseResponse `thrift:"success,0,optional"`
+type UserServiceTestUserModelParamsResult struct {
+	Success *TestUserModelResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceResetPasswordResult() *UserServiceResetPasswordResult {
-	return &UserServiceResetPasswordResult{}
+func NewUserServiceTestUserModelParamsResult() *UserServiceTestUserModelParamsResult {
+	return &UserServiceTestUserModelParamsResult{}
 }
 
-func (p *UserServiceResetPasswordResult) InitDefault() {
+func (p *UserServiceTestUserModelParamsResult) InitDefault() {
 }
 
-var UserServiceResetPasswordResult_Success_DEFAULT *BaseResponse
+var UserServiceTestUserModelParamsResult_Success_DEFAULT *TestUserModelResponse
 
-func (p *UserServiceResetPasswordResult) GetSuccess() (v *BaseResponse) {
+func (p *UserServiceTestUserModelParamsResult) GetSuccess() (v *TestUserModelResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceResetPasswordResult_Success_DEFAULT
+		return UserServiceTestUserModelParamsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceResetPasswordResult = map[int16]string{
+var fieldIDToName_UserServiceTestUserModelParamsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceResetPasswordResult) IsSetSuccess() bool {
+func (p *UserServiceTestUserModelParamsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12404,7 +16855,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceTestUserModelParamsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12414,8 +16865,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBaseResponse()
+func (p *UserServiceTestUserModelParamsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTestUserModelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12423,9 +16874,9 @@ func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ResetPassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("TestUserModelParams_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12451,7 +16902,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceTestUserModelParamsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12470,43 +16921,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordResult) String() string {
+func (p *UserServiceTestUserModelParamsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceResetPasswordResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceTestUserModelParamsResult(%+v)", *p)
 
 }
 
-type UserServiceLogoutArgs struct {
-	Request *EmptyRequest `thrift:"request,1"`
+type UserServiceGetUsageArgs struct {
+	Request *GetUsageRequest `thrift:"request,1"`
 }
 
-func NewUserServiceLogoutArgs() *UserServiceLogoutArgs {
-	return &UserServiceLogoutArgs{}
+func NewUserServiceGetUsageArgs() *UserServiceGetUsageArgs {
+	return &UserServiceGetUsageArgs{}
 }
 
-func (p *UserServiceLogoutArgs) InitDefault() {
+func (p *UserServiceGetUsageArgs) InitDefault() {
 }
 
-var UserServiceLogoutArgs_Request_DEFAULT *EmptyRequest
+var UserServiceGetUsageArgs_Request_DEFAULT *GetUsageRequest
 
-func (p *UserServiceLogoutArgs) GetRequest() (v *EmptyRequest) {
+func (p *UserServiceGetUsageArgs) GetRequest() (v *GetUsageRequest) {
 	if !p.IsSetRequest() {
-		return UserServiceLogoutArgs_Request_DEFAULT
+		return UserServiceGetUsageArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceLogoutArgs = map[int16]string{
+var fieldIDToName_UserServiceGetUsageArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceLogoutArgs) IsSetRequest() bool {
+func (p *UserServiceGetUsageArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceLogoutArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12552,7 +17003,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLogoutArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUsageArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12562,8 +17013,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewEmptyRequest()
+func (p *UserServiceGetUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUsageRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12571,9 +17022,9 @@ func (p *UserServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLogoutArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Logout_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUsage_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12599,7 +17050,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLogoutArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12616,43 +17067,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceLogoutArgs) String() string {
+func (p *UserServiceGetUsageArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLogoutArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUsageArgs(%+v)", *p)
 
 }
 
-type UserServiceLogoutResult struct {
-	Success *BaseResponse `thrift:"success,0,optional"`
+type UserServiceGetUsageResult struct {
+	Success *GetUsageResponse `thrift:"success,0,optional"`
 }
 
-func NewUserServiceLogoutResult() *UserServiceLogoutResult {
-	return &UserServiceLogoutResult{}
+func NewUserServiceGetUsageResult() *UserServiceGetUsageResult {
+	return &UserServiceGetUsageResult{}
 }
 
-func (p *UserServiceLogoutResult) InitDefault() {
+func (p *UserServiceGetUsageResult) InitDefault() {
 }
 
-var UserServiceLogoutResult_Success_DEFAULT *BaseResponse
+var UserServiceGetUsageResult_Success_DEFAULT *GetUsageResponse
 
-func (p *UserServiceLogoutResult) GetSuccess() (v *BaseResponse) {
+func (p *UserServiceGetUsageResult) GetSuccess() (v *GetUsageResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceLogoutResult_Success_DEFAULT
+		return UserServiceGetUsageResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceLogoutResult = map[int16]string{
+var fieldIDToName_UserServiceGetUsageResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceLogoutResult) IsSetSuccess() bool {
+func (p *UserServiceGetUsageResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLogoutResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12698,7 +17149,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLogoutResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUsageResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12708,8 +17159,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBaseResponse()
+func (p *UserServiceGetUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUsageResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12717,9 +17168,9 @@ func (p *UserServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLogoutResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Logout_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUsage_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12745,7 +17196,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLogoutResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12764,10 +17215,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceLogoutResult) String() string {
+func (p *UserServiceGetUsageResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLogoutResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUsageResult(%+v)", *p)
 
 }
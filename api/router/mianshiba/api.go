@@ -21,9 +21,57 @@ func Register(r *server.Hertz) {
 		_api := root.Group("/api", _apiMw()...)
 		{
 			_interview := _api.Group("/interview", _interviewMw()...)
+			{
+				_jd := _interview.Group("/jd", _jdMw()...)
+				_jd.POST("/create", append(_createjobdescriptionMw(), mianshiba.CreateJobDescription)...)
+				_jd.GET("/list", append(_getjobdescriptionlistMw(), mianshiba.GetJobDescriptionList)...)
+				{
+					_id1 := _jd.Group("/:id", _id1Mw()...)
+					_id1.GET("/match", append(_getjobmatchreportMw(), mianshiba.GetJobMatchReport)...)
+					_id1.POST("/reparse", append(_reparsejobdescriptionMw(), mianshiba.ReparseJobDescription)...)
+				}
+				{
+					_delete2 := _jd.Group("/delete", _delete2Mw()...)
+					_delete2.DELETE("/:id", append(_deletejobdescriptionMw(), mianshiba.DeleteJobDescription)...)
+				}
+				{
+					_details1 := _jd.Group("/details", _details1Mw()...)
+					_details1.GET("/:id", append(_getjobdescriptiondetailMw(), mianshiba.GetJobDescriptionDetail)...)
+				}
+				{
+					_upload0 := _jd.Group("/upload", _upload0Mw()...)
+					_upload0.GET("/url", append(_getjobdescriptionuploadurlMw(), mianshiba.GetJobDescriptionUploadUrl)...)
+				}
+			}
+			{
+				_panel := _interview.Group("/panel", _panelMw()...)
+				_panel.GET("/personas", append(_getinterviewpersonalistMw(), mianshiba.GetInterviewPersonaList)...)
+			}
+			{
+				_plan := _interview.Group("/plan", _planMw()...)
+				_plan.POST("/create", append(_createinterviewplanMw(), mianshiba.CreateInterviewPlan)...)
+				_plan.GET("/list", append(_getinterviewplanlistMw(), mianshiba.GetInterviewPlanList)...)
+				{
+					_delete3 := _plan.Group("/delete", _delete3Mw()...)
+					_delete3.DELETE("/:id", append(_deleteinterviewplanMw(), mianshiba.DeleteInterviewPlan)...)
+				}
+				{
+					_details2 := _plan.Group("/details", _details2Mw()...)
+					_details2.GET("/:id", append(_getinterviewplandetailMw(), mianshiba.GetInterviewPlanDetail)...)
+				}
+				{
+					_update1 := _plan.Group("/update", _update1Mw()...)
+					_update1.PUT("/:id", append(_updateinterviewplanMw(), mianshiba.UpdateInterviewPlan)...)
+				}
+			}
 			{
 				_resume := _interview.Group("/resume", _resumeMw()...)
 				_resume.GET("/list", append(_getresumelistMw(), mianshiba.GetResumeList)...)
+				_resume.PUT("/profile", append(_updateresumeprofileMw(), mianshiba.UpdateResumeProfile)...)
+				{
+					_file_key := _resume.Group("/:file_key", _file_keyMw()...)
+					_file_key.POST("/reparse", append(_reparseresumeMw(), mianshiba.ReparseResume)...)
+				}
 				{
 					_delete := _resume.Group("/delete", _deleteMw()...)
 					_delete.POST("/record", append(_recordresumedeleteinfoMw(), mianshiba.RecordResumeDeleteInfo)...)
@@ -31,7 +79,7 @@ func Register(r *server.Hertz) {
 				}
 				{
 					_detail := _resume.Group("/detail", _detailMw()...)
-					_detail.GET("/:file_key", append(_getresumedetailMw(), mianshiba.GetResumeDetail)...)
+					_detail.GET("/*file_key", append(_getresumedetailMw(), mianshiba.GetResumeDetail)...)
 				}
 				{
 					_download := _resume.Group("/download", _downloadMw()...)
@@ -41,11 +89,48 @@ func Register(r *server.Hertz) {
 					_meta := _resume.Group("/meta", _metaMw()...)
 					_meta.POST("/save", append(_saveresumemetainfoMw(), mianshiba.SaveResumeMetaInfo)...)
 				}
+				{
+					_profile := _resume.Group("/profile", _profileMw()...)
+					_profile.GET("/versions", append(_getresumeprofileversionsMw(), mianshiba.GetResumeProfileVersions)...)
+				}
 				{
 					_upload := _resume.Group("/upload", _uploadMw()...)
 					_upload.GET("/url", append(_getresumeuploadurlMw(), mianshiba.GetResumeUploadUrl)...)
 				}
 			}
+			{
+				_session := _interview.Group("/session", _sessionMw()...)
+				_session.POST("/start", append(_startinterviewsessionMw(), mianshiba.StartInterviewSession)...)
+				{
+					_id := _session.Group("/:id", _idMw()...)
+					_id.POST("/answer", append(_submitinterviewanswerMw(), mianshiba.SubmitInterviewAnswer)...)
+					_id.POST("/end", append(_endinterviewsessionMw(), mianshiba.EndInterviewSession)...)
+					_id.GET("/evaluation", append(_getinterviewevaluationMw(), mianshiba.GetInterviewEvaluation)...)
+					_id.GET("/next", append(_getnextinterviewquestionMw(), mianshiba.GetNextInterviewQuestion)...)
+					_id.GET("/rounds", append(_getinterviewsessionroundsMw(), mianshiba.GetInterviewSessionRounds)...)
+					_id.GET("/stream", append(_streaminterviewquestionMw(), mianshiba.StreamInterviewQuestion)...)
+				}
+			}
+		}
+		{
+			_question := _api.Group("/question", _questionMw()...)
+			_question.POST("/create", append(_createquestionMw(), mianshiba.CreateQuestion)...)
+			_question.GET("/export", append(_exportquestionsMw(), mianshiba.ExportQuestions)...)
+			_question.POST("/import", append(_importquestionsMw(), mianshiba.ImportQuestions)...)
+			_question.GET("/list", append(_listquestionsMw(), mianshiba.ListQuestions)...)
+			_question.GET("/tags", append(_listquestiontagsMw(), mianshiba.ListQuestionTags)...)
+			{
+				_delete1 := _question.Group("/delete", _delete1Mw()...)
+				_delete1.DELETE("/:id", append(_deletequestionMw(), mianshiba.DeleteQuestion)...)
+			}
+			{
+				_details0 := _question.Group("/details", _details0Mw()...)
+				_details0.GET("/:id", append(_getquestionMw(), mianshiba.GetQuestion)...)
+			}
+			{
+				_update0 := _question.Group("/update", _update0Mw()...)
+				_update0.PUT("/:id", append(_updatequestionMw(), mianshiba.UpdateQuestion)...)
+			}
 		}
 		{
 			_user := _api.Group("/user", _userMw()...)
@@ -54,11 +139,17 @@ func Register(r *server.Hertz) {
 			_user.GET("/profile", append(_getprofileMw(), mianshiba.GetProfile)...)
 			_user.PUT("/profile", append(_updateprofileMw(), mianshiba.UpdateProfile)...)
 			_user.POST("/register", append(_registerMw(), mianshiba.Register)...)
+			_user.GET("/usage", append(_getusageMw(), mianshiba.GetUsage)...)
 			{
 				_model := _user.Group("/model", _modelMw()...)
 				_model.GET("/check", append(_checkusermodelconfiguredMw(), mianshiba.CheckUserModelConfigured)...)
 				_model.POST("/create", append(_createusermodelMw(), mianshiba.CreateUserModel)...)
 				_model.GET("/list", append(_listusermodelsMw(), mianshiba.ListUserModels)...)
+				_model.POST("/test", append(_testusermodelparamsMw(), mianshiba.TestUserModelParams)...)
+				{
+					_id0 := _model.Group("/:id", _id0Mw()...)
+					_id0.POST("/test", append(_testusermodelMw(), mianshiba.TestUserModel)...)
+				}
 				{
 					_delete0 := _model.Group("/delete", _delete0Mw()...)
 					_delete0.DELETE("/:id", append(_deleteusermodelMw(), mianshiba.DeleteUserModel)...)
@@ -200,3 +200,268 @@ func _delete0Mw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _sessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _startinterviewsessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _submitinterviewanswerMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _endinterviewsessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnextinterviewquestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _streaminterviewquestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinterviewevaluationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _testusermodelparamsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _testusermodelMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateresumeprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _profileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getresumeprofileversionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _file_keyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reparseresumeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getusageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _questionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createquestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportquestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _importquestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listquestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listquestiontagsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _delete1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletequestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _details0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getquestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _update0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatequestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _jdMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getjobdescriptionuploadurlMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createjobdescriptionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getjobdescriptionlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _id1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getjobmatchreportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reparsejobdescriptionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _delete2Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletejobdescriptionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _details1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getjobdescriptiondetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _upload0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _planMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createinterviewplanMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinterviewplanlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _delete3Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteinterviewplanMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _details2Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinterviewplandetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _update1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateinterviewplanMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinterviewsessionroundsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _panelMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinterviewpersonalistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	agentService "mianshiba/domain/agent/service"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/event"
+	cmq "mianshiba/infra/contract/mq"
+	"mianshiba/pkg/logs"
+	"time"
+)
+
+var EvaluationHandlerSVC = &EvaluationEventHandler{}
+
+type EvaluationEventHandler struct {
+	EvaluatorAgentDomainSVC agentService.EvaluatorAgent
+}
+
+func (h *EvaluationEventHandler) HandleInterviewEvaluateEvent(ctx context.Context, event *event.InterviewEvaluateEvent) error {
+	logs.Infof("Handling InterviewEvaluateEvent: userID=%d, sessionID=%d", event.UserID, event.SessionID)
+
+	err := h.EvaluatorAgentDomainSVC.EvaluateAndSave(ctx, &agentService.EvaluateInterviewRequest{
+		SessionID: event.SessionID,
+		UserID:    event.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	logs.Infof("Successfully handled InterviewEvaluateEvent for session %d", event.SessionID)
+
+	return nil
+}
+
+func ConvertToInterviewEvaluateDomainEvent(msg *cmq.KafkaMessage) (*event.InterviewEvaluateEvent, error) {
+	var evaluationMsg entity.EvaluationMsg
+	if err := json.Unmarshal(msg.Value, &evaluationMsg); err != nil {
+		logs.Errorf("Failed to unmarshal evaluation msg: %v", err)
+		return nil, err
+	}
+
+	// 创建领域事件
+	domainEvent := &event.InterviewEvaluateEvent{
+		SessionID: evaluationMsg.SessionID,
+		UserID:    evaluationMsg.UserID,
+		CreatedAt: time.Now(),
+	}
+
+	return domainEvent, nil
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"mianshiba/conf"
+	cmq "mianshiba/infra/contract/mq"
+	"mianshiba/pkg/logs"
+)
+
+// HandleMessage 按主题分发收到的Kafka消息
+func HandleMessage(ctx context.Context, msg *cmq.KafkaMessage) error {
+	var err error
+	switch msg.Topic {
+	case conf.Global.Kafka.EvaluationTopic:
+		err = handleEvaluationMessage(ctx, msg)
+	default:
+		err = handleResumeMessage(ctx, msg)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 记录处理完成日志
+	logs.Infof("Processed message: topic=%s, partition=%d, offset=%d, key=%s, value=%s",
+		msg.Topic, msg.Partition, msg.Offset, string(msg.Key), string(msg.Value))
+	return nil
+}
+
+// handleResumeMessage 处理简历解析消息
+func handleResumeMessage(ctx context.Context, msg *cmq.KafkaMessage) error {
+	// 1. 将Kafka消息转换为领域事件
+	domainEvent, err := ConvertToResumeParseDomainEvent(msg)
+	if err != nil {
+		logs.Errorf("Failed to convert message to domain event: %v", err)
+		// 无法解析的消息重试也不会成功，直接投递到死信主题
+		return cmq.Permanent(err)
+	}
+
+	// 2. 调用事件处理器处理领域事件
+	if err := ResumeHandlerSVC.HandleResumeParseEvent(ctx, domainEvent); err != nil {
+		logs.Errorf("Failed to handle ResumeParseEvent: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// handleEvaluationMessage 处理面试评估消息
+func handleEvaluationMessage(ctx context.Context, msg *cmq.KafkaMessage) error {
+	domainEvent, err := ConvertToInterviewEvaluateDomainEvent(msg)
+	if err != nil {
+		logs.Errorf("Failed to convert message to domain event: %v", err)
+		// 无法解析的消息重试也不会成功，直接投递到死信主题
+		return cmq.Permanent(err)
+	}
+
+	if err := EvaluationHandlerSVC.HandleInterviewEvaluateEvent(ctx, domainEvent); err != nil {
+		logs.Errorf("Failed to handle InterviewEvaluateEvent: %v", err)
+		return err
+	}
+
+	return nil
+}
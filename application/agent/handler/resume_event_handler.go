@@ -3,9 +3,12 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"mianshiba/application/interview"
+	"fmt"
 	agentService "mianshiba/domain/agent/service"
+	"mianshiba/domain/interview/entity"
 	"mianshiba/domain/interview/event"
+	interviewService "mianshiba/domain/interview/service"
+	"mianshiba/infra/contract/idempotency"
 	cmq "mianshiba/infra/contract/mq"
 	"mianshiba/pkg/logs"
 	"time"
@@ -13,30 +16,128 @@ import (
 
 var ResumeHandlerSVC = &ResumeEventHandler{}
 
+const (
+	maxParseRetries   = 2               // 临时错误（模型超时、5xx）的自动重试次数
+	parseRetryBackoff = 5 * time.Second // 首次重试等待时间，之后每次翻倍
+
+	parseLease       = interviewService.ResumeParseTimeout // 处理权租期，与解析中的超时时间一致
+	parseIdempotency = 7 * 24 * time.Hour                  // 解析完成的消息在此期间重复投递时直接跳过
+)
+
 type ResumeEventHandler struct {
 	ResumeAgentDomainSVC agentService.ResumeAgent
+	ResumeDomainSVC      interviewService.Resume
+	Idempotency          idempotency.Store
+}
+
+// parseIdempotencyKey 同一简历的同一次投递使用相同的幂等键，重新解析会生成新的投递次数
+func parseIdempotencyKey(fileID int64, attempt int32) string {
+	return fmt.Sprintf("resume_parse:%d:%d", fileID, attempt)
 }
 
 func (h *ResumeEventHandler) HandleResumeParseEvent(ctx context.Context, event *event.ResumeParseEvent) error {
-	logs.Infof("Handling ResumeParseEvent: userID=%d, fileID=%d, fileKey=%s, filename=%s",
-		event.UserID, event.FileID, event.FileKey, event.Filename)
+	logs.Infof("Handling ResumeParseEvent: userID=%d, fileID=%d, fileKey=%s, filename=%s, attempt=%d",
+		event.UserID, event.FileID, event.FileKey, event.Filename, event.Attempt)
+
+	// 重复投递的消息：已处理完成的直接跳过；处理权被其他消费者持有时不返回错误重试（重试耗尽进入死信后简历会一直停留在解析中），
+	// 由简历的解析状态决定：解析中未超时的跳过，超时视为持有方已中途退出，由本次投递接管
+	key := parseIdempotencyKey(event.FileID, event.Attempt)
+	state, err := h.Idempotency.Acquire(ctx, key, parseLease)
+	if err != nil {
+		return err
+	}
+	if state == idempotency.StateDone {
+		logs.Infof("Skip duplicate ResumeParseEvent, resume %d attempt %d already handled", event.FileID, event.Attempt)
+		return nil
+	}
+	owned := state == idempotency.StateAcquired
+
+	parsed, err := h.handleResumeParse(ctx, event, owned)
+	if err != nil {
+		if owned {
+			if releaseErr := h.Idempotency.Release(ctx, key); releaseErr != nil {
+				logs.Errorf("Failed to release idempotency key %s: %v", key, releaseErr)
+			}
+		}
+		return err
+	}
+
+	// 处理权由其他消费者持有时由持有方标记完成
+	if owned {
+		if err := h.Idempotency.Complete(ctx, key, parseIdempotency); err != nil {
+			// 解析结果已落库，重复投递时由解析状态判断跳过
+			logs.Errorf("Failed to complete idempotency key %s: %v", key, err)
+		}
+	}
+
+	if parsed {
+		logs.Infof("Successfully handled ResumeParseEvent for file %s", event.FileKey)
+	}
 
-	h.ResumeAgentDomainSVC.ParseResumeAndSave(ctx, &agentService.ParseResumeRequest{
+	return nil
+}
+
+// handleResumeParse 解析简历并记录结果，返回是否解析成功；无需处理的消息和已记录的解析失败均不返回错误
+// owned 表示是否持有该次投递的处理权
+func (h *ResumeEventHandler) handleResumeParse(ctx context.Context, event *event.ResumeParseEvent, owned bool) (bool, error) {
+	// 已解析完成的简历只有用户重新解析（生成新的投递次数）时才会再次解析
+	started, err := h.ResumeDomainSVC.StartParse(ctx, event.FileID, event.Attempt, owned)
+	if err != nil {
+		return false, err
+	}
+	if !started {
+		logs.Infof("Skip ResumeParseEvent, resume %d is not waiting for parse attempt %d", event.FileID, event.Attempt)
+		return false, nil
+	}
+
+	err = h.parseWithRetry(ctx, &agentService.ParseResumeRequest{
 		FileID:   event.FileID,
 		UserID:   event.UserID,
 		FileKey:  event.FileKey,
 		Filename: event.Filename,
 		Filetype: event.Filetype,
 		Filesize: event.Filesize,
+		Attempt:  event.Attempt,
 	})
+	if err != nil {
+		// 失败原因记录成功即视为处理完成，由用户重新解析；记录失败时返回错误由消费端重试或投递死信
+		reason := agentService.ParseFailReasonOf(err)
+		if failErr := h.ResumeDomainSVC.FailParse(ctx, event.FileID, reason, err.Error()); failErr != nil {
+			logs.Errorf("Failed to record resume parse failure, fileID: %d, reason: %s, err: %v", event.FileID, reason, failErr)
+			return false, err
+		}
+		logs.Errorf("Resume %d parse failed, reason: %s, err: %v", event.FileID, reason, err)
+		return false, nil
+	}
 
-	logs.Infof("Successfully handled ResumeCreatedEvent for file %s", event.FileKey)
+	return true, nil
+}
 
-	return nil
+// parseWithRetry 解析简历，遇到临时错误时按指数退避自动重试，简历在重试期间保持解析中
+func (h *ResumeEventHandler) parseWithRetry(ctx context.Context, req *agentService.ParseResumeRequest) error {
+	backoff := parseRetryBackoff
+	for {
+		err := h.ResumeAgentDomainSVC.ParseResumeAndSave(ctx, req)
+		if err == nil || !agentService.IsTransientParseError(err) || req.Retry >= maxParseRetries {
+			return err
+		}
+
+		logs.Errorf("Transient error parsing resume %d (retry %d/%d), retry after %s: %v",
+			req.FileID, req.Retry+1, maxParseRetries, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		req.Retry++
+		backoff *= 2
+	}
 }
 
 func ConvertToResumeParseDomainEvent(msg *cmq.KafkaMessage) (*event.ResumeParseEvent, error) {
-	var resumeMsg interview.ResumeMsg
+	var resumeMsg entity.ResumeMsg
 	if err := json.Unmarshal(msg.Value, &resumeMsg); err != nil {
 		logs.Errorf("Failed to unmarshal resume msg: %v", err)
 		return nil, err
@@ -50,6 +151,7 @@ func ConvertToResumeParseDomainEvent(msg *cmq.KafkaMessage) (*event.ResumeParseE
 		Filename:  resumeMsg.Filename,
 		Filetype:  resumeMsg.Filetype,
 		Filesize:  resumeMsg.Filesize,
+		Attempt:   max(resumeMsg.Attempt, 1), // 兼容未携带投递次数的旧消息
 		CreatedAt: time.Now(),
 	}
 
@@ -3,17 +3,47 @@ package agent
 import (
 	"context"
 	"mianshiba/application/agent/handler"
+	"mianshiba/conf"
 	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/interview/repository"
+	interviewService "mianshiba/domain/interview/service"
+	userRepository "mianshiba/domain/user/repository"
+	userService "mianshiba/domain/user/service"
+	"mianshiba/infra/contract/cache"
+	cdocument "mianshiba/infra/contract/document"
+	"mianshiba/infra/contract/ocr"
 	"mianshiba/infra/contract/storage"
+	"mianshiba/infra/impl/document"
+	"mianshiba/infra/impl/idempotency"
 
 	"gorm.io/gorm"
 )
 
-func InitHandler(ctx context.Context, db *gorm.DB, minioClient storage.Storage) *handler.ResumeEventHandler {
+func InitHandler(ctx context.Context, db *gorm.DB, cacheCli cache.Cmdable, minioClient storage.Storage, ocrEngine ocr.OCR, resumeDomainSVC interviewService.Resume, tokenUsageSVC userService.TokenUsage) *handler.ResumeEventHandler {
+	// 消费端的解析和评估共用各模型协议的并发上限
+	modelResolver := agentService.NewProviderLimitedResolver(
+		agentService.NewChatModelResolver(userRepository.NewUserModelRepo(db), tokenUsageSVC),
+		conf.Global.Kafka.Consumer.ProviderConcurrency,
+	)
+
+	// 扫描件 PDF 需要 OCR
+	extractors := document.NewFactory(map[cdocument.FileType]cdocument.Extractor{
+		cdocument.FileTypePDF: document.NewPDFExtractor(ocrEngine),
+	})
+
 	handler.ResumeHandlerSVC.ResumeAgentDomainSVC = agentService.NewResumeAgent(&agentService.ResumeAgentComponents{
-		OSSClient:  minioClient,
-		ResumeRepo: repository.NewResumeRepo(db),
+		OSSClient:     minioClient,
+		Extractors:    extractors,
+		ResumeRepo:    repository.NewResumeRepo(db),
+		ModelResolver: modelResolver,
+	})
+	handler.ResumeHandlerSVC.ResumeDomainSVC = resumeDomainSVC
+	handler.ResumeHandlerSVC.Idempotency = idempotency.New(cacheCli)
+
+	handler.EvaluationHandlerSVC.EvaluatorAgentDomainSVC = agentService.NewEvaluatorAgent(&agentService.EvaluatorAgentComponents{
+		SessionRepo:    repository.NewInterviewSessionRepo(db),
+		EvaluationRepo: repository.NewInterviewEvaluationRepo(db),
+		ModelResolver:  modelResolver,
 	})
 
 	return handler.ResumeHandlerSVC
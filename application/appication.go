@@ -7,13 +7,23 @@ import (
 	"mianshiba/application/agent/handler"
 	"mianshiba/application/base/appinfra"
 	"mianshiba/application/interview"
+	"mianshiba/application/question"
 	"mianshiba/application/user"
+	"mianshiba/conf"
+	mq "mianshiba/infra/impl/mq"
+	"mianshiba/pkg/logs"
+)
+
+var (
+	services          *basicServices
+	inProcessConsumer *consumerHandle
 )
 
 type basicServices struct {
 	infra        *appinfra.AppDependencies
 	userSVC      *user.UserApplicationService
 	interviewSVC *interview.InterviewApplicationService
+	questionSVC  *question.QuestionApplicationService
 	agentHandler *handler.ResumeEventHandler
 }
 
@@ -23,7 +33,7 @@ func Init(ctx context.Context) (err error) {
 		return err
 	}
 
-	_, err = initBasicServices(ctx, infra)
+	services, err = initBasicServices(ctx, infra)
 	if err != nil {
 		return fmt.Errorf("Init - initBasicServices failed, err: %v", err)
 	}
@@ -31,16 +41,90 @@ func Init(ctx context.Context) (err error) {
 	return nil
 }
 
+// StartOutboxRelay 后台投递事务消息，直到 ctx 取消；与 HTTP 服务一同运行，多实例部署时各实例领取不同的消息
+func StartOutboxRelay(ctx context.Context) {
+	go interview.InterviewApplicationSVC.OutboxRelay.Run(ctx)
+}
+
+// StartQuestionIndexSync 后台构建题库向量索引并定时与数据库对账，直到 ctx 取消；未配置向量模型时不启动
+func StartQuestionIndexSync(ctx context.Context) {
+	if question.QuestionApplicationSVC.QuestionIndexDomainSVC == nil {
+		return
+	}
+	go question.QuestionApplicationSVC.QuestionIndexDomainSVC.Run(ctx)
+}
+
+// RunConsumer 消费简历解析和面试评估消息，直到 ctx 取消或消费出错
+func RunConsumer(ctx context.Context) error {
+	consumer, err := mq.NewConsumer(ctx, services.infra.DB)
+	if err != nil {
+		return fmt.Errorf("create consumer failed, err: %w", err)
+	}
+	defer consumer.Close()
+
+	topics := []string{conf.Global.Kafka.ResumeTopic, conf.Global.Kafka.EvaluationTopic}
+	logs.Infof("Consumer started, backend: %s, consuming topics: %v", mq.Backend(), topics)
+
+	return consumer.Consume(ctx, topics, handler.HandleMessage)
+}
+
+// StartConsumer 配置 kafka.in_process_consumer 时在当前进程内后台消费消息，与 HTTP 服务一同运行，由 StopConsumer 停止
+func StartConsumer(ctx context.Context) {
+	if !conf.Global.Kafka.InProcessConsumer {
+		if mq.Backend() == mq.BackendMemory {
+			logs.Warnf("Memory mq backend without in_process_consumer, messages will not be consumed")
+		}
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	inProcessConsumer = &consumerHandle{cancel: cancel, done: done}
+
+	go func() {
+		defer close(done)
+		if err := RunConsumer(ctx); err != nil && ctx.Err() == nil {
+			logs.Errorf("In-process consumer stopped: %v", err)
+		}
+	}()
+}
+
+// StopConsumer 停止进程内消费者：不再读取新消息，等待处理中的消息完成并提交偏移量，最多等到 ctx 结束
+func StopConsumer(ctx context.Context) {
+	if inProcessConsumer == nil {
+		return
+	}
+
+	inProcessConsumer.cancel()
+	select {
+	case <-inProcessConsumer.done:
+		logs.Infof("In-process consumer stopped")
+	case <-ctx.Done():
+		logs.Errorf("In-process consumer did not stop in time: %v", ctx.Err())
+	}
+}
+
+// consumerHandle 进程内消费者的停止句柄
+type consumerHandle struct {
+	cancel context.CancelFunc
+	done   <-chan struct{}
+}
+
 // initBasicServices init basic services that only depends on infra.
 func initBasicServices(ctx context.Context, infra *appinfra.AppDependencies) (*basicServices, error) {
 	userSVC := user.InitService(ctx, infra.DB, infra.CacheCli, infra.IDGenSVC)
-	interviewSVC := interview.InitService(ctx, infra.DB, infra.IDGenSVC, infra.MinIOClient, infra.KafkaProducer)
-	agentHandler := agent.InitHandler(ctx, infra.DB, infra.MinIOClient)
+	questionSVC, err := question.InitService(ctx, infra.DB, infra.IDGenSVC)
+	if err != nil {
+		return nil, fmt.Errorf("init question service failed: %w", err)
+	}
+	interviewSVC := interview.InitService(ctx, infra.DB, infra.IDGenSVC, infra.MinIOClient, infra.KafkaProducer, userSVC.TokenUsageDomainSVC, questionSVC.QuestionIndexDomainSVC)
+	agentHandler := agent.InitHandler(ctx, infra.DB, infra.CacheCli, infra.MinIOClient, infra.OCR, interviewSVC.ResumeDomainSVC, userSVC.TokenUsageDomainSVC)
 
 	return &basicServices{
 		infra:        infra,
 		userSVC:      userSVC,
 		interviewSVC: interviewSVC,
+		questionSVC:  questionSVC,
 		agentHandler: agentHandler,
 	}, nil
 }
@@ -3,13 +3,16 @@ package appinfra
 import (
 	"context"
 	"fmt"
+	"mianshiba/conf"
 	"mianshiba/infra/contract/cache"
 	cmq "mianshiba/infra/contract/mq"
+	"mianshiba/infra/contract/ocr"
 	"mianshiba/infra/contract/storage"
 	"mianshiba/infra/impl/cache/redis"
 	"mianshiba/infra/impl/idgen"
 	mq "mianshiba/infra/impl/mq"
 	"mianshiba/infra/impl/mysql"
+	"mianshiba/infra/impl/ocr/tesseract"
 	"mianshiba/infra/impl/storage/minio"
 
 	"gorm.io/gorm"
@@ -21,6 +24,7 @@ type AppDependencies struct {
 	IDGenSVC      idgen.IDGenerator
 	MinIOClient   storage.Storage
 	KafkaProducer cmq.KafkaProducer
+	OCR           ocr.OCR // 未开启时为空，扫描件简历无法识别
 }
 
 func Init(ctx context.Context) (*AppDependencies, error) {
@@ -44,10 +48,17 @@ func Init(ctx context.Context) (*AppDependencies, error) {
 		return nil, fmt.Errorf("init minio client failed, err=%w", err)
 	}
 
-	deps.KafkaProducer, err = mq.NewProducer(ctx)
+	deps.KafkaProducer, err = mq.NewProducer(ctx, deps.DB)
 	if err != nil {
 		return nil, fmt.Errorf("init kafka producer failed, err=%w", err)
 	}
 
+	if conf.Global.OCR.Enabled {
+		deps.OCR, err = tesseract.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("init ocr failed, err=%w", err)
+		}
+	}
+
 	return deps, nil
 }
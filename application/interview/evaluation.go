@@ -0,0 +1,84 @@
+package interview
+
+import (
+	"context"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+)
+
+// GetInterviewEvaluation 获取面试评估报告；会话已结束但尚未发起评估、评估失败或评估超时时会补充发起
+func (i *InterviewApplicationService) GetInterviewEvaluation(ctx context.Context, req *interviewAPI.InterviewSessionIDRequest) (resp *interviewAPI.InterviewEvaluationResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	session, err := i.SessionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = i.requestEvaluation(ctx, session); err != nil {
+		return nil, err
+	}
+
+	evaluation, err := i.EvaluationDomainSVC.Get(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.InterviewEvaluationResponse{
+		Data: evaluationDo2To(evaluation),
+		Code: 0,
+	}, nil
+}
+
+// onSessionEnded 面试结束后发起评估；失败只记录日志，获取评估报告时会补充发起
+func (i *InterviewApplicationService) onSessionEnded(ctx context.Context, session *entity.InterviewSession) {
+	if _, err := i.requestEvaluation(ctx, session); err != nil {
+		logs.Errorf("Failed to request interview evaluation, sessionID: %d, err: %v", session.ID, err)
+	}
+}
+
+// requestEvaluation 创建待评估记录或重新发起失败、超时的评估；评估消息经事务消息表投递，由消费者调用评估智能体完成评估
+func (i *InterviewApplicationService) requestEvaluation(ctx context.Context, session *entity.InterviewSession) (*entity.InterviewEvaluation, error) {
+	evaluation, _, err := i.EvaluationDomainSVC.Prepare(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluation, nil
+}
+
+func evaluationDo2To(evaluationDo *entity.InterviewEvaluation) *interviewAPI.InterviewEvaluationInfo {
+	scores := make([]*interviewAPI.InterviewAnswerScore, 0, len(evaluationDo.AnswerScores))
+	for _, score := range evaluationDo.AnswerScores {
+		scores = append(scores, &interviewAPI.InterviewAnswerScore{
+			TurnID:        score.TurnID,
+			TurnNo:        score.TurnNo,
+			Correctness:   score.Correctness,
+			Depth:         score.Depth,
+			Communication: score.Communication,
+			Relevance:     score.Relevance,
+			Comment:       score.Comment,
+		})
+	}
+
+	return &interviewAPI.InterviewEvaluationInfo{
+		SessionID:    evaluationDo.SessionID,
+		Status:       evaluationDo.Status,
+		OverallScore: evaluationDo.OverallScore,
+		Summary:      evaluationDo.Summary,
+		Strengths:    evaluationDo.Strengths,
+		Gaps:         evaluationDo.Gaps,
+		Suggestions:  evaluationDo.Suggestions,
+		AnswerScores: scores,
+		ErrorMsg:     evaluationDo.ErrorMsg,
+		EvaluatedAt:  evaluationDo.EvaluatedAt,
+	}
+}
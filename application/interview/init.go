@@ -2,23 +2,86 @@ package interview
 
 import (
 	"context"
+	"mianshiba/conf"
+	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/interview/repository"
 	"mianshiba/domain/interview/service"
+	userRepository "mianshiba/domain/user/repository"
+	userService "mianshiba/domain/user/service"
 	"mianshiba/infra/contract/idgen"
 	cmq "mianshiba/infra/contract/mq"
 	"mianshiba/infra/contract/storage"
+	"mianshiba/infra/impl/document"
 
+	"github.com/cloudwego/eino/components/retriever"
 	"gorm.io/gorm"
 )
 
-func InitService(ctx context.Context, db *gorm.DB, idgen idgen.IDGenerator, minioClient storage.Storage, kafkaProducer cmq.KafkaProducer) *InterviewApplicationService {
+func InitService(ctx context.Context, db *gorm.DB, idgen idgen.IDGenerator, minioClient storage.Storage, kafkaProducer cmq.KafkaProducer, tokenUsageSVC userService.TokenUsage, questionRetriever retriever.Retriever) *InterviewApplicationService {
 	InterviewApplicationSVC.ResumeDomainSVC = service.NewResumeDomain(ctx, &service.ResumeComponents{
 		OSSClient:  minioClient,
+		Extractors: document.DocumentDefaultFactory,
 		IDGen:      idgen,
 		ResumeRepo: repository.NewResumeRepo(db),
+		ParseTopic: conf.Global.Kafka.ResumeTopic,
 	})
 
-	InterviewApplicationSVC.KafkaProducer = kafkaProducer
+	InterviewApplicationSVC.OutboxRelay = service.NewOutboxRelay(ctx, &service.OutboxRelayComponents{
+		OutboxRepo:    repository.NewOutboxRepo(db),
+		KafkaProducer: kafkaProducer,
+	})
+
+	InterviewApplicationSVC.SessionDomainSVC = service.NewInterviewSessionDomain(ctx, &service.InterviewSessionComponents{
+		IDGen:       idgen,
+		SessionRepo: repository.NewInterviewSessionRepo(db),
+	})
+
+	InterviewApplicationSVC.EvaluationDomainSVC = service.NewInterviewEvaluationDomain(ctx, &service.InterviewEvaluationComponents{
+		IDGen:           idgen,
+		EvaluationRepo:  repository.NewInterviewEvaluationRepo(db),
+		EvaluationTopic: conf.Global.Kafka.EvaluationTopic,
+	})
+
+	modelResolver := agentService.NewChatModelResolver(userRepository.NewUserModelRepo(db), tokenUsageSVC)
+
+	InterviewApplicationSVC.InterviewerAgentSVC = agentService.NewInterviewerAgent(&agentService.InterviewerAgentComponents{
+		ModelResolver:     modelResolver,
+		QuestionRetriever: questionRetriever,
+		QuestionTopK:      conf.Global.Embedding.TopK,
+		Personas:          interviewPersonas(conf.Global.Interview.Personas),
+	})
+
+	jdRepo := repository.NewJobDescriptionRepo(db)
+	InterviewApplicationSVC.JobDescriptionDomainSVC = service.NewJobDescriptionDomain(ctx, &service.JobDescriptionComponents{
+		OSSClient:  minioClient,
+		Extractors: document.DocumentDefaultFactory,
+		IDGen:      idgen,
+		JDRepo:     jdRepo,
+	})
+
+	InterviewApplicationSVC.JobDescriptionAgentSVC = agentService.NewJobDescriptionAgent(&agentService.JobDescriptionAgentComponents{
+		JDRepo:        jdRepo,
+		ModelResolver: modelResolver,
+	})
+
+	InterviewApplicationSVC.InterviewPlanDomainSVC = service.NewInterviewPlanDomain(ctx, &service.InterviewPlanComponents{
+		IDGen:    idgen,
+		PlanRepo: repository.NewInterviewPlanRepo(db),
+	})
 
 	return InterviewApplicationSVC
 }
+
+func interviewPersonas(configs []conf.PersonaConfig) []*agentService.InterviewPersona {
+	personas := make([]*agentService.InterviewPersona, 0, len(configs))
+	for _, c := range configs {
+		personas = append(personas, &agentService.InterviewPersona{
+			Key:         c.Key,
+			Name:        c.Name,
+			Instruction: c.Instruction,
+			FocusAreas:  c.FocusAreas,
+			Tone:        c.Tone,
+		})
+	}
+	return personas
+}
@@ -1,13 +1,21 @@
 package interview
 
 import (
+	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/interview/service"
-	mq "mianshiba/infra/contract/mq"
 )
 
 var InterviewApplicationSVC = &InterviewApplicationService{}
 
 type InterviewApplicationService struct {
-	ResumeDomainSVC service.Resume
-	KafkaProducer   mq.KafkaProducer
+	ResumeDomainSVC     service.Resume
+	SessionDomainSVC    service.InterviewSession
+	EvaluationDomainSVC service.InterviewEvaluation
+	InterviewerAgentSVC agentService.InterviewerAgent
+	OutboxRelay         service.OutboxRelay
+
+	JobDescriptionDomainSVC service.JobDescription
+	JobDescriptionAgentSVC  agentService.JobDescriptionAgent
+
+	InterviewPlanDomainSVC service.InterviewPlan
 }
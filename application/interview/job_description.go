@@ -0,0 +1,270 @@
+package interview
+
+import (
+	"context"
+	"encoding/json"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+)
+
+func (i *InterviewApplicationService) GetJobDescriptionUploadUrl(ctx context.Context, req *interviewAPI.JobDescriptionUploadUrlRequest) (resp *interviewAPI.JobDescriptionUploadUrlResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	fileKey, url, err := i.JobDescriptionDomainSVC.GetUploadURL(ctx, *userID, req.Filename, req.Filetype)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.JobDescriptionUploadUrlResponse{
+		UploadURL: url,
+		FileKey:   fileKey,
+		Code:      0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) CreateJobDescription(ctx context.Context, req *interviewAPI.CreateJobDescriptionRequest) (resp *interviewAPI.JobDescriptionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if req.GetContent() == "" && req.GetFileKey() == "" {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Either content or file_key is required"))
+	}
+
+	jd, err := i.JobDescriptionDomainSVC.Create(ctx, &service.CreateJobDescriptionRequest{
+		UserID:   *userID,
+		Title:    req.GetTitle(),
+		Company:  req.GetCompany(),
+		Content:  req.GetContent(),
+		FileKey:  req.GetFileKey(),
+		Filename: req.GetFilename(),
+		Filetype: req.GetFiletype(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.parseJobDescription(ctx, jd)
+
+	return &interviewAPI.JobDescriptionResponse{
+		Data: jobDescriptionDo2To(jd),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) GetJobDescriptionList(ctx context.Context, req *interviewAPI.JobDescriptionListRequest) (resp *interviewAPI.JobDescriptionListResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	page := int32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+
+	size := int32(20)
+	if req.Size != nil {
+		size = *req.Size
+	}
+
+	jds, total, err := i.JobDescriptionDomainSVC.List(ctx, &service.ListJobDescriptionsRequest{
+		UserID:  *userID,
+		Keyword: req.GetKeyword(),
+		Page:    int(page),
+		Size:    int(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*interviewAPI.JobDescriptionInfo, 0, len(jds))
+	for _, jd := range jds {
+		list = append(list, jobDescriptionDo2To(jd))
+	}
+
+	return &interviewAPI.JobDescriptionListResponse{
+		List:  list,
+		Total: total,
+		Page:  page,
+		Size:  size,
+		Code:  0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) GetJobDescriptionDetail(ctx context.Context, req *interviewAPI.JobDescriptionIDRequest) (resp *interviewAPI.JobDescriptionDetailResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	jd, err := i.JobDescriptionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &interviewAPI.JobDescriptionDetailResponse{
+		Data:    jobDescriptionDo2To(jd),
+		Content: jd.Content,
+		Code:    0,
+	}
+
+	if parseResult, ok := jobDescriptionParseResult(jd); ok {
+		resp.Profile = &interviewAPI.JobDescriptionProfile{
+			Title:            parseResult.Title,
+			Company:          parseResult.Company,
+			Seniority:        parseResult.Seniority,
+			MinWorkYears:     parseResult.MinWorkYears,
+			RequiredSkills:   parseResult.RequiredSkills,
+			PreferredSkills:  parseResult.PreferredSkills,
+			Responsibilities: parseResult.Responsibilities,
+			Keywords:         parseResult.Keywords,
+		}
+	}
+
+	return resp, nil
+}
+
+func (i *InterviewApplicationService) DeleteJobDescription(ctx context.Context, req *interviewAPI.JobDescriptionIDRequest) (resp *interviewAPI.BaseResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if err = i.JobDescriptionDomainSVC.Delete(ctx, *userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.BaseResponse{Code: 0}, nil
+}
+
+func (i *InterviewApplicationService) ReparseJobDescription(ctx context.Context, req *interviewAPI.JobDescriptionIDRequest) (resp *interviewAPI.JobDescriptionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	jd, err := i.JobDescriptionDomainSVC.StartParse(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	i.parseJobDescription(ctx, jd)
+
+	return &interviewAPI.JobDescriptionResponse{
+		Data: jobDescriptionDo2To(jd),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) GetJobMatchReport(ctx context.Context, req *interviewAPI.JobMatchRequest) (resp *interviewAPI.JobMatchResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	jdResult, err := i.parsedJobDescription(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := i.ResumeDomainSVC.GetByID(ctx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resume.UserID != *userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this resume"))
+	}
+
+	resumeResult, err := resumeParseResult(resume)
+	if err != nil {
+		return nil, err
+	}
+
+	report := agentService.MatchJobDescription(jdResult, resumeResult)
+
+	return &interviewAPI.JobMatchResponse{
+		Data: &interviewAPI.JobMatchReport{
+			JobDescriptionID:       req.ID,
+			ResumeID:               resume.ID,
+			FitScore:               report.FitScore,
+			CoveredSkills:          report.CoveredSkills,
+			MissingSkills:          report.MissingSkills,
+			MissingPreferredSkills: report.MissingPreferredSkills,
+			KeywordGaps:            report.KeywordGaps,
+			Seniority:              jdResult.Seniority,
+			CandidateWorkYears:     resumeResult.BasicInfo.WorkYears,
+		},
+		Code: 0,
+	}, nil
+}
+
+// parseJobDescription 后台解析岗位描述，不阻塞请求；失败原因由解析智能体记录
+func (i *InterviewApplicationService) parseJobDescription(ctx context.Context, jd *entity.JobDescription) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		err := i.JobDescriptionAgentSVC.ParseAndSave(ctx, &agentService.ParseJobDescriptionRequest{
+			ID:     jd.ID,
+			UserID: jd.UserID,
+		})
+		if err != nil {
+			logs.Errorf("Failed to parse job description, id: %d, err: %v", jd.ID, err)
+		}
+	}()
+}
+
+// parsedJobDescription 获取当前用户已解析完成的岗位描述的解析结果
+func (i *InterviewApplicationService) parsedJobDescription(ctx context.Context, userID int64, id int64) (*agentService.JobDescriptionParseResult, error) {
+	jd, err := i.JobDescriptionDomainSVC.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	parseResult, ok := jobDescriptionParseResult(jd)
+	if !ok {
+		return nil, errorx.New(errno.ErrInterviewJobDescriptionNotParsedCode, errorx.KV("msg", "Job description has not been parsed yet"))
+	}
+
+	return parseResult, nil
+}
+
+func jobDescriptionParseResult(jd *entity.JobDescription) (*agentService.JobDescriptionParseResult, bool) {
+	if jd.ParseStatus != dal.JobDescriptionParsed || jd.ParsedContent == "" {
+		return nil, false
+	}
+
+	var parseResult agentService.JobDescriptionParseResult
+	if err := json.Unmarshal([]byte(jd.ParsedContent), &parseResult); err != nil {
+		logs.Errorf("Invalid job description parse result, id: %d, err: %v", jd.ID, err)
+		return nil, false
+	}
+
+	return &parseResult, true
+}
+
+func jobDescriptionDo2To(jd *entity.JobDescription) *interviewAPI.JobDescriptionInfo {
+	return &interviewAPI.JobDescriptionInfo{
+		ID:          jd.ID,
+		Title:       jd.Title,
+		Company:     jd.Company,
+		Source:      jd.Source,
+		Filename:    jd.Filename,
+		ParseStatus: jd.ParseStatus,
+		ParseError:  parseErrorOrNil(jd.ParseError),
+		CreatedAt:   jd.CreatedAt,
+		UserID:      jd.UserID,
+	}
+}
@@ -0,0 +1,68 @@
+package interview
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+)
+
+const maxPanelPersonas = 6 // 面试官小组的最大人数
+
+func (i *InterviewApplicationService) GetInterviewPersonaList(ctx context.Context, req *interviewAPI.EmptyRequest) (resp *interviewAPI.InterviewPersonaListResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	personas := i.InterviewerAgentSVC.ListPersonas(ctx)
+	resp = &interviewAPI.InterviewPersonaListResponse{
+		List: make([]*interviewAPI.InterviewPersonaInfo, 0, len(personas)),
+		Code: 0,
+	}
+	for _, p := range personas {
+		resp.List = append(resp.List, personaDo2To(p))
+	}
+
+	return resp, nil
+}
+
+// parsePanelPersonas 解析逗号分隔的面试官小组成员，去除重复并校验角色是否存在
+func (i *InterviewApplicationService) parsePanelPersonas(ctx context.Context, raw string) ([]string, error) {
+	keys := make([]string, 0)
+	for _, key := range strings.Split(raw, ",") {
+		key = strings.TrimSpace(key)
+		if key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) > maxPanelPersonas {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", fmt.Sprintf("At most %d panel personas", maxPanelPersonas)))
+	}
+
+	personas := i.InterviewerAgentSVC.ListPersonas(ctx)
+	for _, key := range keys {
+		if !slices.ContainsFunc(personas, func(p *agentService.InterviewPersona) bool { return p.Key == key }) {
+			return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", fmt.Sprintf("Unknown interviewer persona: %s", key)))
+		}
+	}
+
+	return keys, nil
+}
+
+func personaDo2To(p *agentService.InterviewPersona) *interviewAPI.InterviewPersonaInfo {
+	return &interviewAPI.InterviewPersonaInfo{
+		Key:         p.Key,
+		Name:        p.Name,
+		Instruction: p.Instruction,
+		FocusAreas:  p.FocusAreas,
+		Tone:        p.Tone,
+	}
+}
@@ -0,0 +1,212 @@
+package interview
+
+import (
+	"context"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+)
+
+func (i *InterviewApplicationService) GetInterviewPlanList(ctx context.Context, req *interviewAPI.EmptyRequest) (resp *interviewAPI.InterviewPlanListResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	plans, err := i.InterviewPlanDomainSVC.List(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &interviewAPI.InterviewPlanListResponse{
+		List: make([]*interviewAPI.InterviewPlanInfo, 0, len(plans)),
+		Code: 0,
+	}
+	for _, plan := range plans {
+		resp.List = append(resp.List, planDo2To(plan))
+	}
+
+	return resp, nil
+}
+
+func (i *InterviewApplicationService) GetInterviewPlanDetail(ctx context.Context, req *interviewAPI.InterviewPlanIDRequest) (resp *interviewAPI.InterviewPlanResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	plan, err := i.InterviewPlanDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.InterviewPlanResponse{
+		Data: planDo2To(plan),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) CreateInterviewPlan(ctx context.Context, req *interviewAPI.CreateInterviewPlanRequest) (resp *interviewAPI.InterviewPlanResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	plan, err := i.InterviewPlanDomainSVC.Create(ctx, *userID, &service.SavePlanRequest{
+		Name:        req.Name,
+		Description: req.GetDescription(),
+		Rounds:      planRoundsTo2Do(req.Rounds),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.InterviewPlanResponse{
+		Data: planDo2To(plan),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) UpdateInterviewPlan(ctx context.Context, req *interviewAPI.UpdateInterviewPlanRequest) (resp *interviewAPI.InterviewPlanResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	plan, err := i.InterviewPlanDomainSVC.Update(ctx, *userID, req.ID, &service.SavePlanRequest{
+		Name:        req.Name,
+		Description: req.GetDescription(),
+		Rounds:      planRoundsTo2Do(req.Rounds),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.InterviewPlanResponse{
+		Data: planDo2To(plan),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) DeleteInterviewPlan(ctx context.Context, req *interviewAPI.InterviewPlanIDRequest) (resp *interviewAPI.BaseResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if err = i.InterviewPlanDomainSVC.Delete(ctx, *userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.BaseResponse{Code: 0}, nil
+}
+
+// GetInterviewSessionRounds 获取面试会话各环节的进度，提问数和回答数按轮次所属环节统计
+func (i *InterviewApplicationService) GetInterviewSessionRounds(ctx context.Context, req *interviewAPI.InterviewSessionIDRequest) (resp *interviewAPI.InterviewSessionRoundsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	session, err := i.SessionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds, err := i.SessionDomainSVC.ListRounds(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	turns, err := i.SessionDomainSVC.ListTurns(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	asked := make(map[int32]int32, len(rounds))
+	answered := make(map[int32]int32, len(rounds))
+	for _, turn := range turns {
+		asked[turn.RoundNo]++
+		if turn.IsAnswered() {
+			answered[turn.RoundNo]++
+		}
+	}
+
+	resp = &interviewAPI.InterviewSessionRoundsResponse{
+		List:         make([]*interviewAPI.InterviewRoundInfo, 0, len(rounds)),
+		CurrentRound: session.CurrentRound,
+		Code:         0,
+	}
+	for _, round := range rounds {
+		info := roundDo2To(round)
+		info.AskedCount = asked[round.RoundNo]
+		info.AnsweredCount = answered[round.RoundNo]
+		resp.List = append(resp.List, info)
+	}
+
+	return resp, nil
+}
+
+func planRoundsTo2Do(rounds []*interviewAPI.InterviewPlanRound) []*entity.PlanRound {
+	result := make([]*entity.PlanRound, 0, len(rounds))
+	for _, round := range rounds {
+		if round == nil {
+			continue
+		}
+		result = append(result, &entity.PlanRound{
+			RoundNo:         round.RoundNo,
+			Type:            round.Type,
+			Name:            round.Name,
+			DurationMinutes: round.DurationMinutes,
+			QuestionCount:   round.QuestionCount,
+			Difficulty:      round.Difficulty,
+			Persona:         round.Persona,
+		})
+	}
+	return result
+}
+
+func planDo2To(plan *entity.InterviewPlan) *interviewAPI.InterviewPlanInfo {
+	rounds := make([]*interviewAPI.InterviewPlanRound, 0, len(plan.Rounds))
+	for _, round := range plan.Rounds {
+		rounds = append(rounds, &interviewAPI.InterviewPlanRound{
+			RoundNo:         round.RoundNo,
+			Type:            round.Type,
+			Name:            round.Name,
+			DurationMinutes: round.DurationMinutes,
+			QuestionCount:   round.QuestionCount,
+			Difficulty:      round.Difficulty,
+			Persona:         round.Persona,
+		})
+	}
+
+	return &interviewAPI.InterviewPlanInfo{
+		ID:             plan.ID,
+		Name:           plan.Name,
+		Description:    plan.Description,
+		Builtin:        plan.Builtin,
+		Rounds:         rounds,
+		TotalQuestions: plan.TotalQuestions(),
+		CreatedAt:      plan.CreatedAt,
+		UpdatedAt:      plan.UpdatedAt,
+	}
+}
+
+func roundDo2To(round *entity.InterviewSessionRound) *interviewAPI.InterviewRoundInfo {
+	return &interviewAPI.InterviewRoundInfo{
+		RoundNo:         round.RoundNo,
+		Type:            round.Type,
+		Name:            round.Name,
+		DurationMinutes: round.DurationMinutes,
+		QuestionCount:   round.QuestionCount,
+		Difficulty:      round.Difficulty,
+		Persona:         round.Persona,
+		Status:          round.Status,
+		StartedAt:       round.StartedAt,
+		EndedAt:         round.EndedAt,
+	}
+}
@@ -2,12 +2,11 @@ package interview
 
 import (
 	"context"
-	"encoding/json"
-	"time"
+	"net/url"
+	"unicode/utf8"
 
 	interviewAPI "mianshiba/api/model/interview"
 	"mianshiba/application/base/ctxutil"
-	"mianshiba/conf"
 	"mianshiba/domain/interview/entity"
 	"mianshiba/domain/interview/service"
 	"mianshiba/pkg/errorx"
@@ -15,15 +14,10 @@ import (
 	"mianshiba/types/errno"
 )
 
-// ResumeMsg Kafka消息结构
-type ResumeMsg struct {
-	FileKey  string `json:"file_key"`
-	FileID   int64  `json:"file_id"`
-	Filename string `json:"filename"`
-	Filetype string `json:"filetype"`
-	Filesize int64  `json:"filesize"`
-	UserID   int64  `json:"user_id"` // 添加用户ID
-}
+const (
+	resumeDeleteRecordPath = "/api/interview/resume/delete/record"
+	maxDeleteReasonLength  = 512 // 与 delete_reason 列长度一致
+)
 
 func (i *InterviewApplicationService) GetResumeUploadUrl(ctx context.Context, fileName string, fileType string) (res *interviewAPI.ResumeUploadUrlResponse, err error) {
 	userID := ctxutil.GetUIDFromCtx(ctx)
@@ -62,53 +56,176 @@ func (i *InterviewApplicationService) CreateResume(ctx context.Context, req *int
 		return nil, err
 	}
 
-	// 异步发送Kafka消息，避免影响主流程性能
-	go func() {
-		// 构建消息结构
-		msg := ResumeMsg{
-			FileKey:  req.FileKey,
-			FileID:   req.FileID,
-			Filename: req.Filename,
-			Filetype: req.Filetype,
-			Filesize: req.Filesize,
-			UserID:   *userID,
+	return &interviewAPI.ResumeMetaInfoResponse{
+		Data: resumeDo2UserTo(resumeEntity),
+		Code: 0,
+	}, nil
+}
+
+// ReparseResume 重新解析已解析成功或失败的简历，使用用户当前配置的模型；解析完成后生成新的结构化信息版本
+func (i *InterviewApplicationService) ReparseResume(ctx context.Context, req *interviewAPI.ReparseResumeRequest) (resp *interviewAPI.ReparseResumeResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	resume, err := i.ResumeDomainSVC.GetByFileKey(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析消息与状态在同一事务中写入，由投递任务发送
+	resume, err = i.ResumeDomainSVC.MarkParseQueued(ctx, resume.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof("Resume reparse requested, userID: %d, fileKey: %s, attempt: %d", *userID, resume.FileKey, resume.ParseAttempts)
+
+	return &interviewAPI.ReparseResumeResponse{
+		Data: resumeDo2UserTo(resume),
+		Code: 0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) GetResumeList(ctx context.Context, req *interviewAPI.ResumeListRequest) (resp *interviewAPI.ResumeListResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	page := int32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+
+	size := int32(20)
+	if req.Size != nil {
+		size = *req.Size
+	}
+
+	var status *int32
+	if req.GetStatus() != "" {
+		v, ok := service.ParseResumeStatus(req.GetStatus())
+		if !ok {
+			return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Invalid resume status"))
 		}
+		status = &v
+	}
+
+	resumes, total, err := i.ResumeDomainSVC.List(ctx, &service.ListResumesRequest{
+		UserID:  *userID,
+		Status:  status,
+		Keyword: req.GetKeyword(),
+		Page:    int(page),
+		Size:    int(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*interviewAPI.ResumeInfo, 0, len(resumes))
+	for _, resume := range resumes {
+		list = append(list, resumeDo2UserTo(resume))
+	}
+
+	return &interviewAPI.ResumeListResponse{
+		List:  list,
+		Total: total,
+		Page:  page,
+		Size:  size,
+		Code:  0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) GetResumeDetail(ctx context.Context, req *interviewAPI.ResumeDetailRequest) (resp *interviewAPI.ResumeDetailResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	resume, err := i.ResumeDomainSVC.GetByFileKey(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
 
-		// 序列化消息
-		msgJSON, err := json.Marshal(msg)
+	resp = &interviewAPI.ResumeDetailResponse{
+		Data: resumeDo2UserTo(resume),
+		Code: 0,
+	}
+	if resume.LlmParseContent != "" {
+		parseResult, err := parseResumeProfile(resume.LlmParseContent)
 		if err != nil {
-			logs.Errorf("Failed to marshal resume msg, userID: %d, fileID: %d, err: %v", *userID, req.FileID, err)
-			return
+			logs.Errorf("Failed to unmarshal resume profile, fileKey: %s, err: %v", resume.FileKey, err)
+		} else {
+			resp.Profile = profileDo2To(parseResult)
 		}
+	}
 
-		// 发送消息，最多重试3次
-		maxRetries := 3
-		var sendErr error
-
-		for retry := 0; retry < maxRetries; retry++ {
-			sendErr = i.KafkaProducer.SendMessage(ctx, conf.Global.Kafka.ResumeTopic, []byte(req.FileKey), msgJSON)
-			if sendErr == nil {
-				logs.Infof("Successfully sent resume msg to Kafka, userID: %d, fileID: %d, fileKey: %s", *userID, req.FileID, req.FileKey)
-				return
-			}
-
-			// 记录重试日志
-			logs.Errorf("Failed to send resume msg to Kafka (attempt %d/%d), userID: %d, fileID: %d, err: %v",
-				retry+1, maxRetries, *userID, req.FileID, sendErr)
-
-			// 指数退避重试
-			if retry < maxRetries-1 {
-				time.Sleep(time.Duration(1<<uint(retry)) * 500 * time.Millisecond)
-			}
-		}
+	attempts, err := i.ResumeDomainSVC.ListParseAttempts(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+	resp.ParseAttempts = make([]*interviewAPI.ResumeParseAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		resp.ParseAttempts = append(resp.ParseAttempts, parseAttemptDo2To(attempt))
+	}
 
-		// 所有重试都失败，记录最终错误
-		logs.Errorf("All attempts to send resume msg to Kafka failed, userID: %d, fileID: %d, final err: %v",
-			*userID, req.FileID, sendErr)
-	}()
+	return resp, nil
+}
 
-	return &interviewAPI.ResumeMetaInfoResponse{
-		Data: resumeDo2UserTo(resumeEntity),
+func (i *InterviewApplicationService) GetResumeDownloadUrl(ctx context.Context, req *interviewAPI.ResumeDownloadUrlRequest) (resp *interviewAPI.ResumeDownloadUrlResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	downloadURL, err := i.ResumeDomainSVC.GetDownloadURL(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.ResumeDownloadUrlResponse{
+		DownloadURL: downloadURL,
+		Code:        0,
+	}, nil
+}
+
+// GetResumeDeleteUrl 校验简历归属后返回删除接口地址；文件由服务端在记录删除信息时一并删除
+func (i *InterviewApplicationService) GetResumeDeleteUrl(ctx context.Context, req *interviewAPI.ResumeDeleteUrlRequest) (resp *interviewAPI.ResumeDeleteUrlResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	resume, err := i.ResumeDomainSVC.GetByFileKey(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interviewAPI.ResumeDeleteUrlResponse{
+		DeleteURL: resumeDeleteRecordPath + "?file_key=" + url.QueryEscape(resume.FileKey),
+		Code:      0,
+	}, nil
+}
+
+func (i *InterviewApplicationService) RecordResumeDeleteInfo(ctx context.Context, req *interviewAPI.ResumeDeleteInfoRequest) (resp *interviewAPI.ResumeDeleteInfoResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if utf8.RuneCountInString(req.GetReason()) > maxDeleteReasonLength {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Delete reason is too long"))
+	}
+
+	if err = i.ResumeDomainSVC.Delete(ctx, *userID, req.FileKey, req.GetReason()); err != nil {
+		return nil, err
+	}
+
+	logs.Infof("Resume deleted, userID: %d, fileKey: %s, reason: %s", *userID, req.FileKey, req.GetReason())
+
+	return &interviewAPI.ResumeDeleteInfoResponse{
 		Code: 0,
 	}, nil
 }
@@ -123,5 +240,32 @@ func resumeDo2UserTo(resumeDo *entity.Resume) *interviewAPI.ResumeInfo {
 		Filesize: resumeDo.Filesize,
 		Status:   resumeDo.Status,
 		UploadAt: resumeDo.UploadAt,
+
+		ParseStatus:     resumeDo.ParseStatus,
+		ParseError:      parseErrorOrNil(resumeDo.ParseError),
+		ParseFailReason: parseErrorOrNil(string(entity.ParseFailReasonOf(resumeDo.ParseError))),
+
+		ProfileVersion: resumeDo.ProfileVersion,
+		ParseAttempts:  resumeDo.ParseAttempts,
+	}
+}
+
+func parseAttemptDo2To(attemptDo *entity.ResumeParseAttempt) *interviewAPI.ResumeParseAttempt {
+	return &interviewAPI.ResumeParseAttempt{
+		Attempt:    attemptDo.Attempt,
+		Retry:      attemptDo.Retry,
+		Model:      attemptDo.Model,
+		DurationMs: attemptDo.DurationMs,
+		Success:    attemptDo.Success,
+		FailReason: parseErrorOrNil(string(attemptDo.FailReason)),
+		Error:      parseErrorOrNil(attemptDo.Error),
+		CreatedAt:  attemptDo.CreatedAt,
+	}
+}
+
+func parseErrorOrNil(parseError string) *string {
+	if parseError == "" {
+		return nil
 	}
+	return &parseError
 }
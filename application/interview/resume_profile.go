@@ -0,0 +1,210 @@
+package interview
+
+import (
+	"context"
+	"encoding/json"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+)
+
+// recommendedDifficulties 允许的推荐面试难度，与简历解析提示词保持一致
+var recommendedDifficulties = map[string]bool{
+	"初级": true,
+	"中级": true,
+	"高级": true,
+}
+
+// UpdateResumeProfile 保存用户修正后的结构化简历信息，之后开始的面试使用修正后的内容
+func (i *InterviewApplicationService) UpdateResumeProfile(ctx context.Context, req *interviewAPI.UpdateResumeProfileRequest) (resp *interviewAPI.UpdateResumeProfileResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if req.Profile == nil {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Profile is required"))
+	}
+
+	difficulty := req.Profile.RecommendedDifficulty
+	if difficulty != "" && !recommendedDifficulties[difficulty] {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Invalid recommended difficulty"))
+	}
+
+	parseResult := profileTo2Do(req.Profile)
+	content, err := json.Marshal(parseResult)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := i.ResumeDomainSVC.UpdateProfile(ctx, *userID, req.FileKey, string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof("Resume profile updated, userID: %d, fileKey: %s, version: %d", *userID, req.FileKey, resume.ProfileVersion)
+
+	return &interviewAPI.UpdateResumeProfileResponse{
+		Data:    resumeDo2UserTo(resume),
+		Profile: profileDo2To(parseResult),
+		Code:    0,
+	}, nil
+}
+
+// GetResumeProfileVersions 获取结构化简历信息的全部版本，用于对比模型解析结果与用户修正
+func (i *InterviewApplicationService) GetResumeProfileVersions(ctx context.Context, req *interviewAPI.ResumeProfileVersionsRequest) (resp *interviewAPI.ResumeProfileVersionsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	versions, err := i.ResumeDomainSVC.ListProfileVersions(ctx, *userID, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*interviewAPI.ResumeProfileVersion, 0, len(versions))
+	for _, version := range versions {
+		parseResult, err := parseResumeProfile(version.Content)
+		if err != nil {
+			logs.Errorf("Failed to unmarshal resume profile, resumeID: %d, version: %d, err: %v", version.ResumeID, version.Version, err)
+			continue
+		}
+
+		list = append(list, &interviewAPI.ResumeProfileVersion{
+			Version:   version.Version,
+			Source:    version.Source,
+			Profile:   profileDo2To(parseResult),
+			CreatedAt: version.CreatedAt,
+		})
+	}
+
+	return &interviewAPI.ResumeProfileVersionsResponse{
+		List: list,
+		Code: 0,
+	}, nil
+}
+
+func parseResumeProfile(content string) (*agentService.ResumeParseResult, error) {
+	var parseResult agentService.ResumeParseResult
+	if err := json.Unmarshal([]byte(content), &parseResult); err != nil {
+		return nil, err
+	}
+	return &parseResult, nil
+}
+
+func profileDo2To(profileDo *agentService.ResumeParseResult) *interviewAPI.ResumeProfile {
+	profile := &interviewAPI.ResumeProfile{
+		BasicInfo: &interviewAPI.ResumeBasicInfo{
+			Name:      profileDo.BasicInfo.Name,
+			WorkYears: profileDo.BasicInfo.WorkYears,
+			Contact:   profileDo.BasicInfo.Contact,
+		},
+		Education:                    make([]*interviewAPI.ResumeEducation, 0, len(profileDo.Education)),
+		WorkExperience:               make([]*interviewAPI.ResumeWorkExperience, 0, len(profileDo.WorkExperience)),
+		TechStack:                    profileDo.TechStack,
+		Projects:                     make([]*interviewAPI.ResumeProject, 0, len(profileDo.Projects)),
+		Skills:                       profileDo.Skills,
+		Certifications:               profileDo.Certifications,
+		Strengths:                    profileDo.Strengths,
+		PotentialWeaknesses:          profileDo.PotentialWeaknesses,
+		RecommendedDifficulty:        profileDo.RecommendedDifficulty,
+		InterviewFocusAreas:          profileDo.InterviewFocusAreas,
+		SuggestedQuestionsDirections: profileDo.SuggestedQuestionDirections,
+	}
+
+	for _, education := range profileDo.Education {
+		profile.Education = append(profile.Education, &interviewAPI.ResumeEducation{
+			School:         education.School,
+			Major:          education.Major,
+			Degree:         education.Degree,
+			GraduationYear: education.GraduationYear,
+		})
+	}
+
+	for _, work := range profileDo.WorkExperience {
+		profile.WorkExperience = append(profile.WorkExperience, &interviewAPI.ResumeWorkExperience{
+			Company:          work.Company,
+			Position:         work.Position,
+			Duration:         work.Duration,
+			Responsibilities: work.Responsibilities,
+		})
+	}
+
+	for _, project := range profileDo.Projects {
+		profile.Projects = append(profile.Projects, &interviewAPI.ResumeProject{
+			Name:         project.Name,
+			Description:  project.Description,
+			TechStack:    project.TechStack,
+			Contribution: project.Contribution,
+		})
+	}
+
+	return profile
+}
+
+func profileTo2Do(profileTo *interviewAPI.ResumeProfile) *agentService.ResumeParseResult {
+	profile := &agentService.ResumeParseResult{
+		Education:                   make([]agentService.ResumeEducation, 0, len(profileTo.Education)),
+		WorkExperience:              make([]agentService.ResumeWorkExperience, 0, len(profileTo.WorkExperience)),
+		TechStack:                   profileTo.TechStack,
+		Projects:                    make([]agentService.ResumeProject, 0, len(profileTo.Projects)),
+		Skills:                      profileTo.Skills,
+		Certifications:              profileTo.Certifications,
+		Strengths:                   profileTo.Strengths,
+		PotentialWeaknesses:         profileTo.PotentialWeaknesses,
+		RecommendedDifficulty:       profileTo.RecommendedDifficulty,
+		InterviewFocusAreas:         profileTo.InterviewFocusAreas,
+		SuggestedQuestionDirections: profileTo.SuggestedQuestionsDirections,
+	}
+
+	if profileTo.BasicInfo != nil {
+		profile.BasicInfo = agentService.ResumeBasicInfo{
+			Name:      profileTo.BasicInfo.Name,
+			WorkYears: profileTo.BasicInfo.WorkYears,
+			Contact:   profileTo.BasicInfo.Contact,
+		}
+	}
+
+	for _, education := range profileTo.Education {
+		if education == nil {
+			continue
+		}
+		profile.Education = append(profile.Education, agentService.ResumeEducation{
+			School:         education.School,
+			Major:          education.Major,
+			Degree:         education.Degree,
+			GraduationYear: education.GraduationYear,
+		})
+	}
+
+	for _, work := range profileTo.WorkExperience {
+		if work == nil {
+			continue
+		}
+		profile.WorkExperience = append(profile.WorkExperience, agentService.ResumeWorkExperience{
+			Company:          work.Company,
+			Position:         work.Position,
+			Duration:         work.Duration,
+			Responsibilities: work.Responsibilities,
+		})
+	}
+
+	for _, project := range profileTo.Projects {
+		if project == nil {
+			continue
+		}
+		profile.Projects = append(profile.Projects, agentService.ResumeProject{
+			Name:         project.Name,
+			Description:  project.Description,
+			TechStack:    project.TechStack,
+			Contribution: project.Contribution,
+		})
+	}
+
+	return profile
+}
@@ -0,0 +1,545 @@
+package interview
+
+import (
+	"context"
+	"strings"
+
+	interviewAPI "mianshiba/api/model/interview"
+	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+)
+
+func (i *InterviewApplicationService) StartInterviewSession(ctx context.Context, req *interviewAPI.StartInterviewSessionRequest) (resp *interviewAPI.StartInterviewSessionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	resume, err := i.ResumeDomainSVC.GetByID(ctx, req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resume.UserID != *userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this resume"))
+	}
+
+	parseResult, err := resumeParseResult(resume)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxTurns int32
+	if req.MaxTurns != nil {
+		maxTurns = *req.MaxTurns
+	}
+
+	difficulty := parseResult.RecommendedDifficulty
+	if req.GetJobDescriptionID() != 0 {
+		jdResult, err := i.parsedJobDescription(ctx, *userID, req.GetJobDescriptionID())
+		if err != nil {
+			return nil, err
+		}
+		// 针对岗位的面试按岗位职级出题
+		if level := seniorityDifficulty(jdResult.Seniority); level != "" {
+			difficulty = level
+		}
+	}
+
+	if req.GetPlanID() != 0 && req.GetPanelPersonas() != "" {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "plan_id and panel_personas cannot be used together"))
+	}
+
+	// 使用面试官小组时由主持人决定每个问题由哪位面试官提出
+	panelPersonas, err := i.parsePanelPersonas(ctx, req.GetPanelPersonas())
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用面试计划时按计划的环节提问
+	var rounds []*entity.PlanRound
+	if req.GetPlanID() != 0 {
+		plan, err := i.InterviewPlanDomainSVC.Get(ctx, *userID, req.GetPlanID())
+		if err != nil {
+			return nil, err
+		}
+		rounds = plan.Rounds
+	}
+
+	session, err := i.SessionDomainSVC.Start(ctx, &service.StartSessionRequest{
+		UserID:             *userID,
+		ResumeID:           resume.ID,
+		JobDescriptionID:   req.GetJobDescriptionID(),
+		PlanID:             req.GetPlanID(),
+		PanelPersonas:      panelPersonas,
+		Difficulty:         difficulty,
+		FocusAreas:         parseResult.InterviewFocusAreas,
+		QuestionDirections: parseResult.SuggestedQuestionDirections,
+		TechStack:          parseResult.TechStack,
+		MaxTurns:           maxTurns,
+		Rounds:             rounds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &interviewAPI.StartInterviewSessionResponse{
+		Session: sessionDo2To(session),
+		Code:    0,
+	}
+
+	// 流式模式下第一个问题由流式接口生成
+	if req.GetStream() {
+		return resp, nil
+	}
+
+	// 生成第一个问题；失败时会话已保存，可通过获取下一个问题接口继续
+	turn, err := i.askNextQuestion(ctx, session, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if turn != nil {
+		resp.Turn = turnDo2To(turn)
+	}
+	return resp, nil
+}
+
+func (i *InterviewApplicationService) SubmitInterviewAnswer(ctx context.Context, req *interviewAPI.SubmitInterviewAnswerRequest) (resp *interviewAPI.SubmitInterviewAnswerResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if req.Answer == "" {
+		return nil, errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Answer is required"))
+	}
+
+	session, err := i.SessionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsEnded() {
+		return nil, errorx.New(errno.ErrInterviewSessionEndedCode, errorx.KV("msg", "Interview session has ended"))
+	}
+
+	turns, err := i.SessionDomainSVC.ListTurns(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := pendingTurn(turns)
+	if pending == nil {
+		return nil, errorx.New(errno.ErrInterviewQuestionNotReadyCode, errorx.KV("msg", "No question is waiting for an answer"))
+	}
+
+	if err = i.SessionDomainSVC.SubmitAnswer(ctx, pending, req.Answer); err != nil {
+		return nil, err
+	}
+	pending.Answer = req.Answer
+	session.TurnCount++
+
+	// 达到最大轮次后自动结束面试
+	if session.TurnCount >= session.MaxTurns {
+		session, err = i.SessionDomainSVC.End(ctx, *userID, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		i.onSessionEnded(ctx, session)
+
+		return &interviewAPI.SubmitInterviewAnswerResponse{
+			Session:  sessionDo2To(session),
+			Finished: true,
+			Code:     0,
+		}, nil
+	}
+
+	resp = &interviewAPI.SubmitInterviewAnswerResponse{
+		Session:  sessionDo2To(session),
+		Finished: false,
+		Code:     0,
+	}
+
+	// 流式模式下下一个问题由流式接口生成
+	if req.GetStream() {
+		return resp, nil
+	}
+
+	nextTurn, err := i.askNextQuestion(ctx, session, turns, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 面试计划的所有环节均已结束（如环节超时），提前结束面试
+	if nextTurn == nil {
+		session, err = i.SessionDomainSVC.End(ctx, *userID, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		i.onSessionEnded(ctx, session)
+
+		resp.Session = sessionDo2To(session)
+		resp.Finished = true
+		return resp, nil
+	}
+
+	resp.NextTurn = turnDo2To(nextTurn)
+	return resp, nil
+}
+
+// GetNextInterviewQuestion 获取当前待回答的问题；服务重启等原因导致问题缺失时会重新生成
+func (i *InterviewApplicationService) GetNextInterviewQuestion(ctx context.Context, req *interviewAPI.InterviewSessionIDRequest) (resp *interviewAPI.NextInterviewQuestionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	session, err := i.SessionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	turns, err := i.SessionDomainSVC.ListTurns(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &interviewAPI.NextInterviewQuestionResponse{
+		History: make([]*interviewAPI.InterviewTurnInfo, 0, len(turns)),
+		Code:    0,
+	}
+	for _, turn := range turns {
+		if turn.IsAnswered() {
+			resp.History = append(resp.History, turnDo2To(turn))
+		}
+	}
+
+	if !session.IsEnded() {
+		turn := pendingTurn(turns)
+		switch {
+		case turn != nil:
+			resp.Turn = turnDo2To(turn)
+		case session.TurnCount >= session.MaxTurns:
+			// 已达到最大轮次但未来得及结束的会话，补充结束
+			session, err = i.SessionDomainSVC.End(ctx, *userID, session.ID)
+			if err != nil {
+				return nil, err
+			}
+			i.onSessionEnded(ctx, session)
+		default:
+			turn, err = i.askNextQuestion(ctx, session, turns, nil)
+			if err != nil {
+				return nil, err
+			}
+			if turn != nil {
+				resp.Turn = turnDo2To(turn)
+				break
+			}
+			// 面试计划的所有环节均已结束
+			session, err = i.SessionDomainSVC.End(ctx, *userID, session.ID)
+			if err != nil {
+				return nil, err
+			}
+			i.onSessionEnded(ctx, session)
+		}
+	}
+
+	resp.Session = sessionDo2To(session)
+	return resp, nil
+}
+
+func (i *InterviewApplicationService) EndInterviewSession(ctx context.Context, req *interviewAPI.InterviewSessionIDRequest) (resp *interviewAPI.EndInterviewSessionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	session, err := i.SessionDomainSVC.End(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	i.onSessionEnded(ctx, session)
+
+	return &interviewAPI.EndInterviewSessionResponse{
+		Session: sessionDo2To(session),
+		Code:    0,
+	}, nil
+}
+
+// StreamInterviewQuestion 流式生成下一个问题，onDelta 接收增量内容；已有待回答问题时直接整段推送
+func (i *InterviewApplicationService) StreamInterviewQuestion(ctx context.Context, req *interviewAPI.InterviewSessionIDRequest, onDelta func(delta string) error) (turn *interviewAPI.InterviewTurnInfo, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	session, err := i.SessionDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsEnded() || session.TurnCount >= session.MaxTurns {
+		return nil, errorx.New(errno.ErrInterviewSessionEndedCode, errorx.KV("msg", "Interview session has ended"))
+	}
+
+	turns, err := i.SessionDomainSVC.ListTurns(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending := pendingTurn(turns); pending != nil {
+		if err = onDelta(pending.Question); err != nil {
+			return nil, err
+		}
+		return turnDo2To(pending), nil
+	}
+
+	nextTurn, err := i.askNextQuestion(ctx, session, turns, onDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	// 面试计划的所有环节均已结束
+	if nextTurn == nil {
+		session, err = i.SessionDomainSVC.End(ctx, *userID, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		i.onSessionEnded(ctx, session)
+		return nil, errorx.New(errno.ErrInterviewSessionEndedCode, errorx.KV("msg", "Interview session has ended"))
+	}
+
+	return turnDo2To(nextTurn), nil
+}
+
+// askNextQuestion 基于历史问答让面试官生成下一个问题并持久化；onDelta 不为空时以流式方式生成
+// 使用面试计划且所有环节均已结束时不生成问题，返回 nil
+func (i *InterviewApplicationService) askNextQuestion(ctx context.Context, session *entity.InterviewSession, turns []*entity.InterviewTurn, onDelta func(delta string) error) (*entity.InterviewTurn, error) {
+	rounds, err := i.sessionRounds(ctx, session, turns)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*agentService.InterviewQA, 0, len(turns))
+	for _, turn := range turns {
+		history = append(history, &agentService.InterviewQA{
+			Question: turn.Question,
+			Answer:   turn.Answer,
+			Persona:  turn.Persona,
+		})
+	}
+
+	resume := i.sessionResume(ctx, session.ResumeID)
+	askReq := &agentService.AskQuestionRequest{
+		UserID:             session.UserID,
+		Difficulty:         session.Difficulty,
+		FocusAreas:         session.FocusAreas,
+		QuestionDirections: session.QuestionDirections,
+		TechStack:          session.TechStack,
+		Projects:           projectSummaries(resume),
+		TargetJob:          i.targetJob(ctx, session, resume),
+		MaxTurns:           session.MaxTurns,
+		Rounds:             rounds,
+		Panel:              session.PanelPersonas,
+		History:            history,
+	}
+
+	var question *agentService.InterviewQuestion
+	if onDelta != nil {
+		question, err = i.InterviewerAgentSVC.StreamQuestion(ctx, askReq, onDelta)
+	} else {
+		question, err = i.InterviewerAgentSVC.AskQuestion(ctx, askReq)
+	}
+	if err != nil {
+		logs.Errorf("Failed to ask interview question, sessionID: %d, err: %v", session.ID, err)
+		return nil, err
+	}
+
+	if question.Finished {
+		return nil, nil
+	}
+
+	// 进入新的环节
+	if question.RoundNo != 0 && question.RoundNo != session.CurrentRound {
+		if err = i.SessionDomainSVC.StartRound(ctx, session, question.RoundNo); err != nil {
+			return nil, err
+		}
+	}
+
+	return i.SessionDomainSVC.AddQuestion(ctx, session, int32(len(turns)+1), question.Content, question.Persona)
+}
+
+// sessionRounds 面试计划各环节的进度，不使用面试计划时为空
+func (i *InterviewApplicationService) sessionRounds(ctx context.Context, session *entity.InterviewSession, turns []*entity.InterviewTurn) ([]*agentService.InterviewRound, error) {
+	if session.PlanID == 0 {
+		return nil, nil
+	}
+
+	rounds, err := i.SessionDomainSVC.ListRounds(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	asked := make(map[int32]int32, len(rounds))
+	for _, turn := range turns {
+		asked[turn.RoundNo]++
+	}
+
+	result := make([]*agentService.InterviewRound, 0, len(rounds))
+	for _, round := range rounds {
+		result = append(result, &agentService.InterviewRound{
+			RoundNo:         round.RoundNo,
+			Type:            round.Type,
+			Name:            round.Name,
+			Persona:         round.Persona,
+			Difficulty:      round.Difficulty,
+			QuestionCount:   round.QuestionCount,
+			DurationMinutes: round.DurationMinutes,
+			AskedCount:      asked[round.RoundNo],
+			StartedAt:       round.StartedAt,
+			Finished:        round.IsFinished(),
+		})
+	}
+
+	return result, nil
+}
+
+// sessionResume 面试所用简历的解析结果；读取失败时返回 nil，不影响提问
+func (i *InterviewApplicationService) sessionResume(ctx context.Context, resumeID int64) *agentService.ResumeParseResult {
+	resume, err := i.ResumeDomainSVC.GetByID(ctx, resumeID)
+	if err != nil {
+		logs.Errorf("Failed to get resume for interview, resumeID: %d, err: %v", resumeID, err)
+		return nil
+	}
+
+	parseResult, err := resumeParseResult(resume)
+	if err != nil {
+		return nil
+	}
+
+	return parseResult
+}
+
+// targetJob 针对岗位的面试的目标岗位及简历中未体现的必备技能；岗位描述已删除等原因读取失败时按普通面试提问
+func (i *InterviewApplicationService) targetJob(ctx context.Context, session *entity.InterviewSession, resume *agentService.ResumeParseResult) *agentService.InterviewTargetJob {
+	if session.JobDescriptionID == 0 {
+		return nil
+	}
+
+	jdResult, err := i.parsedJobDescription(ctx, session.UserID, session.JobDescriptionID)
+	if err != nil {
+		logs.Errorf("Failed to get job description for interview, sessionID: %d, jobDescriptionID: %d, err: %v", session.ID, session.JobDescriptionID, err)
+		return nil
+	}
+
+	target := &agentService.InterviewTargetJob{
+		Title:            jdResult.Title,
+		Seniority:        jdResult.Seniority,
+		RequiredSkills:   jdResult.RequiredSkills,
+		PreferredSkills:  jdResult.PreferredSkills,
+		Responsibilities: jdResult.Responsibilities,
+	}
+	if resume != nil {
+		target.MissingSkills = agentService.MatchJobDescription(jdResult, resume).MissingSkills
+	}
+
+	return target
+}
+
+// projectSummaries 简历中的项目经历摘要，供面试官检索题库
+func projectSummaries(parseResult *agentService.ResumeParseResult) []string {
+	if parseResult == nil {
+		return nil
+	}
+
+	projects := make([]string, 0, len(parseResult.Projects))
+	for _, project := range parseResult.Projects {
+		summary := strings.TrimSpace(project.Name + " " + project.Description)
+		if len(project.TechStack) > 0 {
+			summary += "（" + strings.Join(project.TechStack, "、") + "）"
+		}
+		if summary != "" {
+			projects = append(projects, summary)
+		}
+	}
+
+	return projects
+}
+
+// resumeParseResult 解析简历的结构化信息，简历尚未解析或内容无效时返回错误
+func resumeParseResult(resume *entity.Resume) (*agentService.ResumeParseResult, error) {
+	if resume.LlmParseContent == "" {
+		return nil, errorx.New(errno.ErrInterviewResumeNotParsedCode, errorx.KV("msg", "Resume has not been parsed yet"))
+	}
+
+	parseResult, err := parseResumeProfile(resume.LlmParseContent)
+	if err != nil {
+		return nil, errorx.New(errno.ErrInterviewResumeNotParsedCode, errorx.KV("msg", "Resume parse result is invalid"))
+	}
+
+	return parseResult, nil
+}
+
+// seniorityDifficulty 岗位职级对应的面试难度，未知职级返回空
+func seniorityDifficulty(seniority string) string {
+	switch seniority {
+	case "初级", "中级", "高级":
+		return seniority
+	case "专家":
+		return "高级"
+	default:
+		return ""
+	}
+}
+
+// pendingTurn 返回最后一个待回答的轮次
+func pendingTurn(turns []*entity.InterviewTurn) *entity.InterviewTurn {
+	if len(turns) == 0 {
+		return nil
+	}
+
+	last := turns[len(turns)-1]
+	if last.IsAnswered() {
+		return nil
+	}
+
+	return last
+}
+
+func sessionDo2To(sessionDo *entity.InterviewSession) *interviewAPI.InterviewSessionInfo {
+	return &interviewAPI.InterviewSessionInfo{
+		ID:         sessionDo.ID,
+		UserID:     sessionDo.UserID,
+		ResumeID:   sessionDo.ResumeID,
+		Status:     sessionDo.Status,
+		Difficulty: sessionDo.Difficulty,
+		TurnCount:  sessionDo.TurnCount,
+		MaxTurns:   sessionDo.MaxTurns,
+		StartedAt:  sessionDo.StartedAt,
+		EndedAt:    sessionDo.EndedAt,
+
+		JobDescriptionID: sessionDo.JobDescriptionID,
+		PlanID:           sessionDo.PlanID,
+		CurrentRound:     sessionDo.CurrentRound,
+		PanelPersonas:    sessionDo.PanelPersonas,
+	}
+}
+
+func turnDo2To(turnDo *entity.InterviewTurn) *interviewAPI.InterviewTurnInfo {
+	return &interviewAPI.InterviewTurnInfo{
+		ID:        turnDo.ID,
+		TurnNo:    turnDo.TurnNo,
+		Question:  turnDo.Question,
+		Answer:    turnDo.Answer,
+		Status:    turnDo.Status,
+		CreatedAt: turnDo.CreatedAt,
+		RoundNo:   turnDo.RoundNo,
+		Persona:   turnDo.Persona,
+	}
+}
@@ -0,0 +1,91 @@
+package question
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/conf"
+	"mianshiba/domain/question/repository"
+	"mianshiba/domain/question/service"
+	cembedding "mianshiba/infra/contract/embedding"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/infra/impl/embedding"
+	"mianshiba/infra/impl/vectorstore"
+	"mianshiba/pkg/lang/ptr"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const defaultIndexPath = "./data/question_index.jsonl"
+
+func InitService(ctx context.Context, db *gorm.DB, idgen idgen.IDGenerator) (*QuestionApplicationService, error) {
+	questionRepo := repository.NewQuestionRepo(db)
+
+	index, err := initQuestionIndex(ctx, questionRepo)
+	if err != nil {
+		return nil, err
+	}
+	QuestionApplicationSVC.QuestionIndexDomainSVC = index
+
+	QuestionApplicationSVC.QuestionDomainSVC = service.NewQuestionDomain(ctx, &service.QuestionComponents{
+		IDGen:        idgen,
+		QuestionRepo: questionRepo,
+		Index:        index,
+	})
+
+	return QuestionApplicationSVC, nil
+}
+
+// initQuestionIndex 按配置创建题库向量索引，未配置向量模型时返回 nil
+func initQuestionIndex(ctx context.Context, questionRepo repository.QuestionRepository) (service.QuestionIndex, error) {
+	cfg := conf.Global.Embedding
+	if cfg.Protocol == "" {
+		return nil, nil
+	}
+
+	embedderConfig := &cembedding.Config{
+		BaseURL: cfg.BaseURL,
+		APIKey:  cfg.APIKey,
+		Model:   cfg.Model,
+	}
+	if cfg.Dimensions > 0 {
+		embedderConfig.Dimensions = ptr.Of(cfg.Dimensions)
+	}
+	if cfg.Timeout != "" {
+		timeout, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid embedding timeout %q: %w", cfg.Timeout, err)
+		}
+		embedderConfig.Timeout = timeout
+	}
+
+	var syncInterval time.Duration
+	if cfg.SyncInterval != "" {
+		var err error
+		if syncInterval, err = time.ParseDuration(cfg.SyncInterval); err != nil {
+			return nil, fmt.Errorf("invalid embedding sync_interval %q: %w", cfg.SyncInterval, err)
+		}
+	}
+
+	embedder, err := embedding.EmbeddingDefaultFactory.CreateEmbedder(ctx, cembedding.Protocol(cfg.Protocol), embedderConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create embedder failed: %w", err)
+	}
+
+	indexPath := cfg.IndexPath
+	if indexPath == "" {
+		indexPath = defaultIndexPath
+	}
+	store, err := vectorstore.NewLocalStore(indexPath)
+	if err != nil {
+		return nil, fmt.Errorf("open question index failed: %w", err)
+	}
+
+	return service.NewQuestionIndex(ctx, &service.QuestionIndexComponents{
+		Embedder:     embedder,
+		Store:        store,
+		QuestionRepo: questionRepo,
+		Model:        cfg.Protocol + "/" + cfg.Model,
+		SyncInterval: syncInterval,
+	}), nil
+}
@@ -0,0 +1,280 @@
+package question
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	questionAPI "mianshiba/api/model/question"
+	"mianshiba/application/base/ctxutil"
+	"mianshiba/domain/question/entity"
+	"mianshiba/domain/question/service"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+)
+
+const MaxImportFileSize = 5 << 20 // 导入文件最大 5MB
+
+var QuestionApplicationSVC = &QuestionApplicationService{}
+
+type QuestionApplicationService struct {
+	QuestionDomainSVC      service.Question
+	QuestionIndexDomainSVC service.QuestionIndex // 未配置向量模型时为空
+}
+
+func (q *QuestionApplicationService) CreateQuestion(ctx context.Context, req *questionAPI.CreateQuestionRequest) (resp *questionAPI.QuestionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	question, err := q.QuestionDomainSVC.Create(ctx, *userID, &service.CreateQuestionRequest{
+		Title:           req.Title,
+		Content:         req.GetContent(),
+		Type:            entity.QuestionType(req.Type),
+		Difficulty:      req.GetDifficulty(),
+		Tags:            req.Tags,
+		ReferenceAnswer: req.GetReferenceAnswer(),
+		Source:          req.GetSource(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &questionAPI.QuestionResponse{
+		Data: questionDo2To(question),
+		Code: 0,
+	}, nil
+}
+
+func (q *QuestionApplicationService) ListQuestions(ctx context.Context, req *questionAPI.ListQuestionsRequest) (resp *questionAPI.ListQuestionsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	page := int32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+
+	size := int32(20)
+	if req.Size != nil {
+		size = *req.Size
+	}
+
+	listReq := &service.ListQuestionsRequest{
+		Tags:       splitTags(req.GetTags()),
+		Type:       entity.QuestionType(req.GetType()),
+		Difficulty: req.GetDifficulty(),
+		Keyword:    req.GetKeyword(),
+		Page:       int(page),
+		Size:       int(size),
+	}
+	if req.GetMine() {
+		listReq.CreatorID = *userID
+	}
+
+	questions, total, err := q.QuestionDomainSVC.List(ctx, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*questionAPI.QuestionInfo, 0, len(questions))
+	for _, question := range questions {
+		list = append(list, questionDo2To(question))
+	}
+
+	return &questionAPI.ListQuestionsResponse{
+		List:  list,
+		Total: total,
+		Page:  page,
+		Size:  size,
+		Code:  0,
+	}, nil
+}
+
+func (q *QuestionApplicationService) GetQuestion(ctx context.Context, req *questionAPI.QuestionIDRequest) (resp *questionAPI.QuestionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	question, err := q.QuestionDomainSVC.Get(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &questionAPI.QuestionResponse{
+		Data: questionDo2To(question),
+		Code: 0,
+	}, nil
+}
+
+func (q *QuestionApplicationService) UpdateQuestion(ctx context.Context, req *questionAPI.UpdateQuestionRequest) (resp *questionAPI.QuestionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	updateReq := &service.UpdateQuestionRequest{
+		ID:              req.ID,
+		Title:           req.Title,
+		Content:         req.Content,
+		Difficulty:      req.Difficulty,
+		Tags:            req.Tags,
+		ReferenceAnswer: req.ReferenceAnswer,
+		Source:          req.Source,
+	}
+	if req.Type != nil {
+		questionType := entity.QuestionType(*req.Type)
+		updateReq.Type = &questionType
+	}
+
+	question, err := q.QuestionDomainSVC.Update(ctx, *userID, updateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &questionAPI.QuestionResponse{
+		Data: questionDo2To(question),
+		Code: 0,
+	}, nil
+}
+
+func (q *QuestionApplicationService) DeleteQuestion(ctx context.Context, req *questionAPI.QuestionIDRequest) (resp *questionAPI.DeleteQuestionResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if err = q.QuestionDomainSVC.Delete(ctx, *userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &questionAPI.DeleteQuestionResponse{Code: 0}, nil
+}
+
+func (q *QuestionApplicationService) ListQuestionTags(ctx context.Context, req *questionAPI.ListQuestionTagsRequest) (resp *questionAPI.ListQuestionTagsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	tags, err := q.QuestionDomainSVC.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*questionAPI.TagInfo, 0, len(tags))
+	for _, tag := range tags {
+		list = append(list, &questionAPI.TagInfo{Tag: tag.Tag, Count: tag.Count})
+	}
+
+	return &questionAPI.ListQuestionTagsResponse{
+		List: list,
+		Code: 0,
+	}, nil
+}
+
+// ImportQuestions 导入上传的题目文件，未指定格式时按文件扩展名判断
+func (q *QuestionApplicationService) ImportQuestions(ctx context.Context, req *questionAPI.ImportQuestionsRequest, filename string, data []byte) (resp *questionAPI.ImportQuestionsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	formatName := req.GetFormat()
+	if formatName == "" {
+		formatName = strings.TrimPrefix(path.Ext(filename), ".")
+	}
+	format, ok := service.ParseFormat(formatName)
+	if !ok {
+		return nil, errorx.New(errno.ErrQuestionInvalidParamCode, errorx.KV("msg", "Format must be json, csv or markdown"))
+	}
+
+	result, err := q.QuestionDomainSVC.Import(ctx, *userID, format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof("Questions imported, userID: %d, file: %s, total: %d, created: %d, skipped: %d, failed: %d",
+		*userID, filename, result.Total, result.Created, len(result.Skipped), len(result.Failed))
+
+	return &questionAPI.ImportQuestionsResponse{
+		Data: &questionAPI.ImportQuestionsResult{
+			Total:   int32(result.Total),
+			Created: int32(result.Created),
+			Skipped: importItemErrorsDo2To(result.Skipped),
+			Failed:  importItemErrorsDo2To(result.Failed),
+		},
+		Code: 0,
+	}, nil
+}
+
+// ExportQuestions 按筛选条件导出题目，返回文件内容、文件名和 MIME 类型
+func (q *QuestionApplicationService) ExportQuestions(ctx context.Context, req *questionAPI.ExportQuestionsRequest) (data []byte, filename string, contentType string, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, "", "", errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	format, ok := service.ParseFormat(req.Format)
+	if !ok {
+		return nil, "", "", errorx.New(errno.ErrQuestionInvalidParamCode, errorx.KV("msg", "Format must be json, csv or markdown"))
+	}
+
+	exportReq := &service.ListQuestionsRequest{
+		Tags:       splitTags(req.GetTags()),
+		Type:       entity.QuestionType(req.GetType()),
+		Difficulty: req.GetDifficulty(),
+		Keyword:    req.GetKeyword(),
+	}
+	if req.GetMine() {
+		exportReq.CreatorID = *userID
+	}
+
+	data, err = q.QuestionDomainSVC.Export(ctx, format, exportReq)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return data, "questions." + format.Ext(), format.ContentType(), nil
+}
+
+// splitTags 解析逗号分隔的标签
+func splitTags(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+	return strings.Split(tags, ",")
+}
+
+func questionDo2To(question *entity.Question) *questionAPI.QuestionInfo {
+	return &questionAPI.QuestionInfo{
+		ID:              question.ID,
+		CreatorID:       question.CreatorID,
+		Title:           question.Title,
+		Content:         question.Content,
+		Type:            string(question.Type),
+		Difficulty:      question.Difficulty,
+		Tags:            question.Tags,
+		ReferenceAnswer: question.ReferenceAnswer,
+		Source:          question.Source,
+		CreatedAt:       question.CreatedAt,
+		UpdatedAt:       question.UpdatedAt,
+	}
+}
+
+func importItemErrorsDo2To(items []*service.ImportItemError) []*questionAPI.ImportItemError {
+	list := make([]*questionAPI.ImportItemError, 0, len(items))
+	for _, item := range items {
+		list = append(list, &questionAPI.ImportItemError{
+			Index: int32(item.Index),
+			Title: item.Title,
+			Msg:   item.Msg,
+		})
+	}
+	return list
+}
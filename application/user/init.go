@@ -2,10 +2,13 @@ package user
 
 import (
 	"context"
+	"mianshiba/conf"
+	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/user/repository"
 	"mianshiba/domain/user/service"
 	"mianshiba/infra/contract/cache"
 	"mianshiba/infra/contract/idgen"
+	"mianshiba/infra/impl/chatmodel"
 
 	"gorm.io/gorm"
 )
@@ -21,6 +24,12 @@ func InitService(ctx context.Context, db *gorm.DB, cacheCli cache.Cmdable, idgen
 		IDGen:         idgen,
 		UserModelRepo: repository.NewUserModelRepo(db),
 	})
+	UserApplicationSVC.TokenUsageDomainSVC = service.NewTokenUsageDomain(ctx, &service.TokenUsageComponents{
+		TokenUsageRepo:     repository.NewTokenUsageRepo(db),
+		DailyTokens:        conf.Global.Usage.DailyTokens,
+		FeatureDailyTokens: conf.Global.Usage.FeatureDailyTokens,
+	})
+	UserApplicationSVC.ModelProberSVC = agentService.NewModelProber(chatmodel.ChatModelDefaultFactory, agentService.DefaultProbeTimeout)
 
 	return UserApplicationSVC
 }
@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	userAPI "mianshiba/api/model/user"
+	"mianshiba/application/base/ctxutil"
+	"mianshiba/conf"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"sort"
+)
+
+const (
+	defaultUsageDays = 7
+	maxUsageDays     = 90
+)
+
+// GetUsage 获取用户最近几天的模型用量及平台共享密钥的每日配额
+func (u *UserApplicationService) GetUsage(ctx context.Context, req *userAPI.GetUsageRequest) (resp *userAPI.GetUsageResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	days := defaultUsageDays
+	if req.Days != nil {
+		days = int(*req.Days)
+	}
+	if days <= 0 || days > maxUsageDays {
+		return nil, errorx.New(errno.ErrUserInvalidParamCode, errorx.KVf("msg", "days must be between 1 and %d", maxUsageDays))
+	}
+
+	daily, err := u.TokenUsageDomainSVC.ListDaily(ctx, *userID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*userAPI.DailyUsage, 0, len(daily))
+	for _, d := range daily {
+		items := make([]*userAPI.UsageItem, 0, len(d.Items))
+		for _, item := range d.Items {
+			items = append(items, &userAPI.UsageItem{
+				Feature:          string(item.Feature),
+				Model:            item.Model,
+				SharedKey:        item.SharedKey,
+				Requests:         item.Requests,
+				PromptTokens:     item.PromptTokens,
+				CompletionTokens: item.CompletionTokens,
+				TotalTokens:      item.TotalTokens,
+			})
+		}
+
+		data = append(data, &userAPI.DailyUsage{
+			Date:             d.Date,
+			Requests:         d.Requests,
+			PromptTokens:     d.PromptTokens,
+			CompletionTokens: d.CompletionTokens,
+			TotalTokens:      d.TotalTokens,
+			SharedKeyTokens:  d.SharedKeyTokens,
+			Items:            items,
+		})
+	}
+
+	return &userAPI.GetUsageResponse{
+		Data:  data,
+		Quota: usageQuota(),
+		Code:  0,
+	}, nil
+}
+
+func usageQuota() *userAPI.UsageQuota {
+	quota := &userAPI.UsageQuota{
+		DailyTokens: max(conf.Global.Usage.DailyTokens, 0),
+		Features:    make([]*userAPI.FeatureQuota, 0, len(conf.Global.Usage.FeatureDailyTokens)),
+	}
+	for feature, tokens := range conf.Global.Usage.FeatureDailyTokens {
+		if tokens > 0 {
+			quota.Features = append(quota.Features, &userAPI.FeatureQuota{Feature: feature, DailyTokens: tokens})
+		}
+	}
+	sort.Slice(quota.Features, func(i, j int) bool {
+		return quota.Features[i].Feature < quota.Features[j].Feature
+	})
+
+	return quota
+}
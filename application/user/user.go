@@ -5,6 +5,7 @@ import (
 	"mianshiba/api/model/user"
 	userAPI "mianshiba/api/model/user"
 	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/user/entity"
 	"mianshiba/domain/user/service"
 	userService "mianshiba/domain/user/service"
@@ -16,8 +17,10 @@ import (
 var UserApplicationSVC = &UserApplicationService{}
 
 type UserApplicationService struct {
-	UserDomainSVC      userService.User
-	UserModelDomainSVC userService.UserModel
+	UserDomainSVC       userService.User
+	UserModelDomainSVC  userService.UserModel
+	TokenUsageDomainSVC userService.TokenUsage
+	ModelProberSVC      agentService.ModelProber
 }
 
 func isValidEmail(email string) bool {
@@ -4,12 +4,15 @@ import (
 	"context"
 	userAPI "mianshiba/api/model/user"
 	"mianshiba/application/base/ctxutil"
+	agentService "mianshiba/domain/agent/service"
 	"mianshiba/domain/user/entity"
 	"mianshiba/domain/user/service"
 	"mianshiba/pkg/errorx"
 	"mianshiba/types/errno"
 )
 
+const defaultUserModelScope = 7 // 默认全部使用范围（智能体、应用、工作流）
+
 func (u *UserApplicationService) CreateUserModel(ctx context.Context, req *userAPI.CreateUserModelRequest) (resp *userAPI.CreateUserModelResponse, err error) {
 	// Get user ID from context
 	userID := ctxutil.GetUIDFromCtx(ctx)
@@ -32,7 +35,7 @@ func (u *UserApplicationService) CreateUserModel(ctx context.Context, req *userA
 		ConfigJSON = *req.ConfigJSON
 	}
 
-	var Scope int32
+	var Scope int32 = defaultUserModelScope
 	if req.Scope != nil {
 		Scope = *req.Scope
 	}
@@ -71,6 +74,196 @@ func (u *UserApplicationService) CreateUserModel(ctx context.Context, req *userA
 	}, nil
 }
 
+func (u *UserApplicationService) ListUserModels(ctx context.Context, req *userAPI.ListUserModelsRequest) (resp *userAPI.ListUserModelsResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	page := int32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+
+	size := int32(20)
+	if req.Size != nil {
+		size = *req.Size
+	}
+
+	userModels, total, err := u.UserModelDomainSVC.List(ctx, *userID, &service.ListUserModelsRequest{
+		Status:       req.Status,
+		Scope:        req.Scope,
+		Protocol:     req.GetProtocol(),
+		ProviderName: req.GetProviderName(),
+		Keyword:      req.GetKeyword(),
+		Page:         int(page),
+		Size:         int(size),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*userAPI.UserModelDetail, 0, len(userModels))
+	for _, userModel := range userModels {
+		list = append(list, userModelDo2To(userModel))
+	}
+
+	return &userAPI.ListUserModelsResponse{
+		List:  list,
+		Total: total,
+		Page:  page,
+		Size:  size,
+		Code:  0,
+	}, nil
+}
+
+func (u *UserApplicationService) GetUserModel(ctx context.Context, req *userAPI.IDRequest) (resp *userAPI.GetUserModelResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	userModel, err := u.UserModelDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userAPI.GetUserModelResponse{
+		Data: userModelDo2To(userModel),
+		Code: 0,
+	}, nil
+}
+
+func (u *UserApplicationService) UpdateUserModel(ctx context.Context, req *userAPI.UpdateUserModelRequest) (resp *userAPI.UpdateUserModelResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	userModel, err := u.UserModelDomainSVC.Update(ctx, *userID, &service.UpdateUserModelRequest{
+		ID:            req.ID,
+		Name:          req.Name,
+		ModelKey:      req.ModelKey,
+		Protocol:      req.Protocol,
+		BaseURL:       req.BaseURL,
+		APIKey:        req.APIKey,
+		ProviderName:  req.ProviderName,
+		MetaID:        req.MetaID,
+		DefaultParams: req.DefaultParams,
+		ConfigJSON:    req.ConfigJSON,
+		Scope:         req.Scope,
+		Status:        req.Status,
+		IsDefault:     req.IsDefault,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userAPI.UpdateUserModelResponse{
+		Data: userModelDo2To(userModel),
+		Code: 0,
+	}, nil
+}
+
+func (u *UserApplicationService) DeleteUserModel(ctx context.Context, req *userAPI.IDRequest) (resp *userAPI.DeleteUserModelResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	if err = u.UserModelDomainSVC.Delete(ctx, *userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &userAPI.DeleteUserModelResponse{
+		Code: 0,
+	}, nil
+}
+
+func (u *UserApplicationService) CheckUserModelConfigured(ctx context.Context, req *userAPI.EmptyRequest) (resp *userAPI.CheckUserModelConfiguredResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	configured, err := u.UserModelDomainSVC.CheckConfigured(ctx, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userAPI.CheckUserModelConfiguredResponse{
+		Configured: configured,
+		Code:       0,
+	}, nil
+}
+
+// TestUserModel 测试已保存的用户模型连通性
+func (u *UserApplicationService) TestUserModel(ctx context.Context, req *userAPI.IDRequest) (resp *userAPI.TestUserModelResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	userModel, err := u.UserModelDomainSVC.Get(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := u.UserModelDomainSVC.GetAPIKey(ctx, *userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.probeModel(ctx, &agentService.ProbeModelRequest{
+		Protocol:      userModel.Protocol,
+		ModelKey:      userModel.ModelKey,
+		BaseURL:       userModel.BaseURL,
+		APIKey:        apiKey,
+		ConfigJSON:    userModel.ConfigJSON,
+		DefaultParams: userModel.DefaultParams,
+	})
+}
+
+// TestUserModelParams 测试未保存的模型参数连通性，用于创建模型前校验配置
+func (u *UserApplicationService) TestUserModelParams(ctx context.Context, req *userAPI.TestUserModelParamsRequest) (resp *userAPI.TestUserModelResponse, err error) {
+	userID := ctxutil.GetUIDFromCtx(ctx)
+	if userID == nil {
+		return nil, errorx.New(errno.ErrUserInfoInvalidateCode, errorx.KV("msg", "User not authenticated"))
+	}
+
+	return u.probeModel(ctx, &agentService.ProbeModelRequest{
+		Protocol:      req.Protocol,
+		ModelKey:      req.ModelKey,
+		BaseURL:       req.BaseURL,
+		APIKey:        req.APIKey,
+		ConfigJSON:    req.GetConfigJSON(),
+		DefaultParams: req.GetDefaultParams(),
+	})
+}
+
+func (u *UserApplicationService) probeModel(ctx context.Context, req *agentService.ProbeModelRequest) (*userAPI.TestUserModelResponse, error) {
+	result, err := u.ModelProberSVC.Probe(ctx, req)
+	if err != nil {
+		return nil, errorx.New(errno.ErrUserInvalidParamCode, errorx.KV("msg", err.Error()))
+	}
+
+	data := &userAPI.TestUserModelResult{
+		Success:           result.Success,
+		LatencyMs:         result.LatencyMs,
+		SupportToolCall:   result.SupportToolCall,
+		SupportJSONFormat: result.SupportJSONFormat,
+	}
+	if result.ErrorType != "" {
+		data.ErrorType = &result.ErrorType
+		data.ErrorMsg = &result.ErrorMsg
+	}
+
+	return &userAPI.TestUserModelResponse{
+		Data: data,
+		Code: 0,
+	}, nil
+}
+
 func userModelDo2To(userModel *entity.UserModel) *userAPI.UserModelDetail {
 	return &userAPI.UserModelDetail{
 		ID:            userModel.ID,
@@ -84,6 +277,7 @@ func userModelDo2To(userModel *entity.UserModel) *userAPI.UserModelDetail {
 		DefaultParams: &userModel.DefaultParams,
 		Scope:         userModel.Scope,
 		Status:        userModel.Status,
+		HasSecret:     userModel.SecretHint != "",
 		SecretHint:    &userModel.SecretHint,
 		IsDefault:     userModel.IsDefault,
 		ProviderName:  userModel.ProviderName,
@@ -0,0 +1,146 @@
+// dlq 查看和重新投递死信主题中的消息
+//
+//	go run ./cmd/dlq list [-topic resume_parser] [-limit 20]
+//	go run ./cmd/dlq replay -partition 0 -offset 12
+//	go run ./cmd/dlq replay -all [-topic resume_parser]
+//
+// 重新投递会把原始消息发回原主题，死信主题中的记录不会删除，可按输出的分区和偏移量跳过已处理的记录
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"mianshiba/conf"
+	mq "mianshiba/infra/impl/mq"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	if err := conf.LoadConfig("./config.yaml"); err != nil {
+		fmt.Printf("Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	var err error
+	switch os.Args[1] {
+	case "list":
+		err = list(ctx, os.Args[2:])
+	case "replay":
+		err = replay(ctx, os.Args[2:])
+	default:
+		usage()
+	}
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Println("Usage:")
+	fmt.Println("  dlq list [-topic <original topic>] [-limit <n>]")
+	fmt.Println("  dlq replay (-partition <p> -offset <o> | -all) [-topic <original topic>]")
+	os.Exit(2)
+}
+
+// list 按死信主题中的顺序输出消息，limit 为最近的条数
+func list(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	topic := fs.String("topic", "", "只显示该原始主题的消息")
+	limit := fs.Int("limit", 20, "最多显示最近的条数，0 表示全部")
+	_ = fs.Parse(args)
+
+	records, err := readDeadLetters(ctx, *topic)
+	if err != nil {
+		return err
+	}
+
+	if *limit > 0 && len(records) > *limit {
+		records = records[len(records)-*limit:]
+	}
+
+	for _, r := range records {
+		fmt.Printf("[%d:%d] %s  %s[%d]@%d  attempts=%d permanent=%t group=%s\n",
+			r.Partition, r.Offset, time.UnixMilli(r.FailedAt).Format(time.DateTime),
+			r.Topic, r.DeadLetter.Partition, r.DeadLetter.Offset, r.Attempts, r.Permanent, r.GroupID)
+		fmt.Printf("    key:   %s\n", string(r.Key))
+		fmt.Printf("    value: %s\n", string(r.Value))
+		fmt.Printf("    error: %s\n", r.Error)
+	}
+	fmt.Printf("%d dead letters\n", len(records))
+
+	return nil
+}
+
+// replay 将死信消息的原始内容重新投递到原主题
+func replay(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	partition := fs.Int("partition", -1, "死信主题中的分区")
+	offset := fs.Int64("offset", -1, "死信主题中的偏移量")
+	all := fs.Bool("all", false, "重新投递全部消息")
+	topic := fs.String("topic", "", "只投递该原始主题的消息")
+	_ = fs.Parse(args)
+
+	if !*all && (*partition < 0 || *offset < 0) {
+		usage()
+	}
+
+	records, err := readDeadLetters(ctx, *topic)
+	if err != nil {
+		return err
+	}
+
+	var selected []*mq.DeadLetterRecord
+	for _, r := range records {
+		if *all || (r.Partition == int32(*partition) && r.Offset == *offset) {
+			selected = append(selected, r)
+		}
+	}
+	if len(selected) == 0 {
+		return fmt.Errorf("no dead letter matched")
+	}
+
+	producer, err := mq.NewProducer(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Close 会等待消息发送完成
+	defer producer.Close()
+
+	for _, r := range selected {
+		if err := producer.SendMessage(ctx, r.Topic, r.Key, r.Value); err != nil {
+			return fmt.Errorf("replay [%d:%d] failed: %w", r.Partition, r.Offset, err)
+		}
+		fmt.Printf("replayed [%d:%d] to %s\n", r.Partition, r.Offset, r.Topic)
+	}
+
+	return nil
+}
+
+func readDeadLetters(ctx context.Context, topic string) ([]*mq.DeadLetterRecord, error) {
+	records, err := mq.ReadDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if topic == "" {
+		return records, nil
+	}
+
+	filtered := make([]*mq.DeadLetterRecord, 0, len(records))
+	for _, r := range records {
+		if r.Topic == topic {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
@@ -24,6 +24,7 @@ import (
 	"mianshiba/api/router"
 	"mianshiba/application"
 	"mianshiba/conf"
+	mq "mianshiba/infra/impl/mq"
 	"mianshiba/pkg/logs"
 	"os"
 	"runtime/debug"
@@ -53,6 +54,10 @@ func main() {
 		panic("InitializeInfra failed, err=" + err.Error())
 	}
 
+	application.StartOutboxRelay(ctx)
+	application.StartQuestionIndexSync(ctx)
+	application.StartConsumer(ctx)
+
 	startHttpServer()
 }
 
@@ -62,9 +67,21 @@ func startHttpServer() {
 
 	opts := []config.Option{
 		server.WithHostPorts(addr),
+		// 按原始路径匹配路由，file_key 中的 / 编码为 %2F 后可作为路径参数
+		server.WithUseRawPath(true),
+	}
+
+	// 进程内消费者退出时需等待处理中的消息完成
+	if conf.Global.Kafka.InProcessConsumer {
+		timeout, err := mq.ShutdownTimeout()
+		if err != nil {
+			panic("invalid kafka consumer config, err=" + err.Error())
+		}
+		opts = append(opts, server.WithExitWaitTime(timeout))
 	}
 
 	s := server.Default(opts...)
+	s.OnShutdown = append(s.OnShutdown, application.StopConsumer)
 
 	// cors option
 	config := cors.DefaultConfig()
@@ -16,15 +16,19 @@ import (
 
 // Config 应用程序配置结构
 type Config struct {
-	Host     string         `yaml:"host"`
-	Port     int            `yaml:"port"`
-	Database DatabaseConfig `yaml:"database"`
-	Redis    RedisConfig    `yaml:"redis"`
-	Hertz    HertzConfig    `yaml:"hertz"`
-	Security SecurityConfig `yaml:"security"`
-	OpenAPI  OpenAPIConfig  `yaml:"openapi"`
-	MinIO    MinIOConfig    `yaml:"minio"`
-	Kafka    KafkaConfig    `yaml:"kafka"`
+	Host      string          `yaml:"host"`
+	Port      int             `yaml:"port"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Redis     RedisConfig     `yaml:"redis"`
+	Hertz     HertzConfig     `yaml:"hertz"`
+	Security  SecurityConfig  `yaml:"security"`
+	OpenAPI   OpenAPIConfig   `yaml:"openapi"`
+	MinIO     MinIOConfig     `yaml:"minio"`
+	Kafka     KafkaConfig     `yaml:"kafka"`
+	OCR       OCRConfig       `yaml:"ocr"`
+	Usage     UsageConfig     `yaml:"usage"`
+	Embedding EmbeddingConfig `yaml:"embedding"`
+	Interview InterviewConfig `yaml:"interview"`
 }
 
 // CORSConfig CORS配置
@@ -92,10 +96,87 @@ type MinIOConfig struct {
 
 // KafkaConfig Kafka配置
 type KafkaConfig struct {
-	Brokers     string `yaml:"brokers"`
-	ResumeTopic string `yaml:"resume_topic"`
-	GroupID     string `yaml:"group_id"`
-	Timeout     string `yaml:"timeout"`
+	Brokers         string `yaml:"brokers"`
+	ResumeTopic     string `yaml:"resume_topic"`
+	EvaluationTopic string `yaml:"evaluation_topic"`
+	GroupID         string `yaml:"group_id"`
+	Timeout         string `yaml:"timeout"`
+
+	DeadLetterTopic string              `yaml:"dead_letter_topic"` // 处理失败的消息投递到该主题，为空时只记录日志
+	Retry           KafkaRetryConfig    `yaml:"retry"`
+	Consumer        KafkaConsumerConfig `yaml:"consumer"`
+
+	Backend           string         `yaml:"backend"`             // 消息队列实现：kafka（默认）或 memory（进程内，用于本地开发和测试）
+	Memory            MemoryMQConfig `yaml:"memory"`              // memory 实现的配置
+	InProcessConsumer bool           `yaml:"in_process_consumer"` // 在 API 进程内运行消费者，无需单独启动 cmd/consumer；memory 实现必须开启
+}
+
+// KafkaRetryConfig 消费失败的重试策略，重试间隔按指数退避
+type KafkaRetryConfig struct {
+	MaxAttempts int    `yaml:"max_attempts"` // 最多处理次数（含首次），默认 3
+	Backoff     string `yaml:"backoff"`      // 首次重试间隔，默认 1s
+	MaxBackoff  string `yaml:"max_backoff"`  // 重试间隔上限，默认 30s
+}
+
+// KafkaConsumerConfig 消费端的并发和退出配置
+type KafkaConsumerConfig struct {
+	Workers         int    `yaml:"workers"`          // 并发处理消息的 worker 数，同一分区内 key 相同的消息按顺序处理，默认 4
+	ShutdownTimeout string `yaml:"shutdown_timeout"` // 退出时等待处理中消息完成的时长，超时后取消处理，默认 5m
+	LagInterval     string `yaml:"lag_interval"`     // 采集各分区积压消息数的间隔，默认 30s
+	MetricsAddr     string `yaml:"metrics_addr"`     // cmd/consumer 暴露 expvar 指标（/debug/vars）的地址，为空不暴露
+
+	// 各模型协议（openai、ark 等）同时进行的请求数上限，"*" 为未列出协议的上限，未配置或为 0 不限制
+	ProviderConcurrency map[string]int `yaml:"provider_concurrency"`
+}
+
+// MemoryMQConfig 进程内消息队列配置，生产者和消费者须在同一进程内
+type MemoryMQConfig struct {
+	Persist string `yaml:"persist"` // 持久化方式：为空不持久化，file 写入本地文件，mysql 写入数据库
+	Path    string `yaml:"path"`    // file 方式的文件路径，默认 ./data/mq.log
+}
+
+// OCRConfig 扫描件简历的文字识别配置
+type OCRConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	Binary    string `yaml:"binary"`    // tesseract 可执行文件，默认从 PATH 查找
+	Languages string `yaml:"languages"` // 语言包，默认 chi_sim+eng
+	Timeout   string `yaml:"timeout"`   // 单张图片的识别超时
+}
+
+// UsageConfig 模型用量配置；配额只限制使用平台共享密钥的调用，用户自有模型不受限
+type UsageConfig struct {
+	DailyTokens        int64            `yaml:"daily_tokens"`         // 每个用户每天可用的 token 数，不大于 0 时不限制
+	FeatureDailyTokens map[string]int64 `yaml:"feature_daily_tokens"` // 各功能（resume_parse、interview、evaluation、jd_parse）每天可用的 token 数，不大于 0 时不限制
+}
+
+// EmbeddingConfig 题库检索使用的向量模型和本地向量索引，protocol 为空时不启用题库检索
+type EmbeddingConfig struct {
+	Protocol   string `yaml:"protocol"` // openai、ark、ollama、qwen
+	BaseURL    string `yaml:"base_url"`
+	APIKey     string `yaml:"api_key"`
+	Model      string `yaml:"model"`
+	Dimensions int    `yaml:"dimensions"` // 输出向量维度，仅部分模型支持，0 为模型默认
+	Timeout    string `yaml:"timeout"`
+
+	IndexPath    string `yaml:"index_path"`    // 本地向量索引文件，默认 ./data/question_index.jsonl
+	SyncInterval string `yaml:"sync_interval"` // 索引与数据库全量对账的间隔，默认 10m
+	TopK         int    `yaml:"top_k"`         // 面试官每次检索的候选题目数，默认 5
+}
+
+// InterviewConfig 模拟面试配置
+type InterviewConfig struct {
+	// 面试官角色，按 key 覆盖内置角色（interviewer、tech_lead、architect、hr）的对应字段，其他 key 为新增角色；
+	// 新增角色只能用于面试官小组，面试计划的环节只能使用内置角色
+	Personas []PersonaConfig `yaml:"personas"`
+}
+
+// PersonaConfig 面试官角色配置
+type PersonaConfig struct {
+	Key         string   `yaml:"key"`         // 角色标识，只能包含小写字母、数字和下划线，最长 32 个字符
+	Name        string   `yaml:"name"`        // 角色名称，如技术负责人
+	Instruction string   `yaml:"instruction"` // 角色身份和考察重点
+	FocusAreas  []string `yaml:"focus_areas"` // 关注领域
+	Tone        string   `yaml:"tone"`        // 提问语气
 }
 
 func (c *Config) ExpandEnv() {
@@ -105,6 +186,7 @@ func (c *Config) ExpandEnv() {
 	c.OpenAPI.ModelAPIKey = expandEnvVar(c.OpenAPI.ModelAPIKey)
 	c.MinIO.AccessKey = expandEnvVar(c.MinIO.AccessKey)
 	c.MinIO.SecretKey = expandEnvVar(c.MinIO.SecretKey)
+	c.Embedding.APIKey = expandEnvVar(c.Embedding.APIKey)
 }
 
 // Global 全局配置实例
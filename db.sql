@@ -51,6 +51,31 @@ CREATE TABLE `user_model` (
   DEFAULT CHARSET=utf8mb4
   COLLATE=utf8mb4_unicode_ci;
 
+-- 用户模型用量，按天、功能、模型汇总
+CREATE TABLE user_token_usage (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    usage_date CHAR(10) NOT NULL COMMENT '日期（服务器时区，YYYY-MM-DD）',
+    feature VARCHAR(32) NOT NULL COMMENT '功能：resume_parse/interview/evaluation',
+    model VARCHAR(255) NOT NULL COMMENT '使用的模型（协议/模型）',
+    shared_key TINYINT NOT NULL DEFAULT 0 COMMENT '是否使用平台共享密钥（0=用户自有模型, 1=共享密钥）',
+
+    requests INT NOT NULL DEFAULT 0 COMMENT '请求次数',
+    prompt_tokens BIGINT NOT NULL DEFAULT 0 COMMENT '输入 token 数',
+    completion_tokens BIGINT NOT NULL DEFAULT 0 COMMENT '输出 token 数',
+    total_tokens BIGINT NOT NULL DEFAULT 0 COMMENT '总 token 数',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+        ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_user_date_feature_model (user_id, usage_date, feature, model, shared_key)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='用户模型用量表';
+
 
 -- 简历
 CREATE TABLE resume (
@@ -63,12 +88,18 @@ CREATE TABLE resume (
     filesize BIGINT COMMENT '文件大小（字节）',
 
     status TINYINT NOT NULL DEFAULT 1 COMMENT '简历状态：1已上传 2解析中 3已解析 4已删除 5失败',
-    parse_status TINYINT NOT NULL DEFAULT 0 COMMENT '解析状态：0未开始 1解析中 2成功 3失败',
-    parse_error VARCHAR(1024) COMMENT '解析失败原因摘要',
+    parse_status TINYINT NOT NULL DEFAULT 0 COMMENT '解析状态：0已上传 1已入队 2解析中 3成功 4失败',
+    parse_error VARCHAR(1024) COMMENT '解析失败原因，格式为 分类: 详情',
+    parse_attempts INT NOT NULL DEFAULT 0 COMMENT '解析任务投递次数（首次上传及每次重新解析各计一次）',
+    parse_started_at BIGINT NOT NULL DEFAULT 0 COMMENT '最近一次开始解析的时间（毫秒）',
 
-    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '上传时间',
+    upload_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '上传时间',
     deleted_at TIMESTAMP NULL COMMENT '软删除时间',
     deleted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '删除状态（0=未删除, 1=已删除）',
+    delete_reason VARCHAR(512) NOT NULL DEFAULT '' COMMENT '删除原因',
+
+    llm_parse_content LONGTEXT COMMENT '大模型解析结果（JSON），用户修改后为修改后的内容',
+    profile_version INT NOT NULL DEFAULT 0 COMMENT '当前结构化信息版本号，0表示尚未解析',
 
     PRIMARY KEY (id),
     UNIQUE KEY uk_file_key (file_key),
@@ -79,3 +110,348 @@ CREATE TABLE resume (
   DEFAULT CHARSET=utf8mb4
   COLLATE=utf8mb4_unicode_ci
   COMMENT='用户简历元信息表';
+
+-- 岗位描述（JD）
+CREATE TABLE job_description (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    title VARCHAR(255) NOT NULL DEFAULT '' COMMENT '岗位名称，未填写时取解析结果',
+    company VARCHAR(255) NOT NULL DEFAULT '' COMMENT '公司名称，未填写时取解析结果',
+    source TINYINT NOT NULL DEFAULT 1 COMMENT '来源：1粘贴文本 2上传文件',
+    file_key VARCHAR(512) NOT NULL DEFAULT '' COMMENT '上传文件在对象存储中的唯一标识',
+    filename VARCHAR(255) NOT NULL DEFAULT '' COMMENT '上传文件的原始文件名',
+    filetype VARCHAR(50) NOT NULL DEFAULT '' COMMENT '上传文件的类型，如 pdf/docx',
+    content MEDIUMTEXT NOT NULL COMMENT '岗位描述原文（上传文件时为提取的文本）',
+
+    parse_status TINYINT NOT NULL DEFAULT 1 COMMENT '解析状态：1解析中 2成功 3失败',
+    parse_error VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '解析失败原因',
+    parsed_content TEXT COMMENT '大模型解析结果（JSON）：技能要求、职级、职责、关键词',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    deleted_at TIMESTAMP NULL COMMENT '软删除时间',
+    deleted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '删除状态（0=未删除, 1=已删除）',
+
+    PRIMARY KEY (id),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='岗位描述表';
+
+-- 面试计划模板，内置计划定义在代码中，不写入此表
+CREATE TABLE interview_plan (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    name VARCHAR(128) NOT NULL COMMENT '计划名称',
+    description VARCHAR(512) NOT NULL DEFAULT '' COMMENT '计划说明',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    deleted_at TIMESTAMP NULL COMMENT '软删除时间',
+    deleted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '删除状态（0=未删除, 1=已删除）',
+
+    PRIMARY KEY (id),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='面试计划模板表';
+
+-- 面试计划的环节，按环节序号依次进行
+CREATE TABLE interview_plan_round (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    plan_id BIGINT NOT NULL COMMENT '面试计划ID',
+
+    round_no INT NOT NULL COMMENT '环节序号（从1开始）',
+    round_type VARCHAR(32) NOT NULL COMMENT '环节类型：basics/project/algorithm/system_design/behavioral',
+    name VARCHAR(64) NOT NULL COMMENT '环节名称',
+    duration_minutes INT NOT NULL DEFAULT 0 COMMENT '环节时长（分钟），0表示不限时',
+    question_count INT NOT NULL COMMENT '环节提问数',
+    difficulty VARCHAR(32) NOT NULL DEFAULT '' COMMENT '环节难度，为空时使用面试难度',
+    persona VARCHAR(32) NOT NULL DEFAULT '' COMMENT '面试官角色：interviewer/tech_lead/architect/hr',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_plan_round (plan_id, round_no)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='面试计划环节表';
+
+-- 模拟面试会话
+CREATE TABLE interview_session (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    resume_id BIGINT NOT NULL COMMENT '简历ID',
+    job_description_id BIGINT NOT NULL DEFAULT 0 COMMENT '目标岗位描述ID，0表示不针对具体岗位',
+    plan_id BIGINT NOT NULL DEFAULT 0 COMMENT '面试计划ID，0表示不使用面试计划',
+    panel_personas TEXT COMMENT '面试官小组成员的角色（JSON数组），为空表示不使用面试官小组',
+
+    status TINYINT NOT NULL DEFAULT 1 COMMENT '会话状态：1进行中 2已结束',
+    difficulty VARCHAR(32) NOT NULL DEFAULT '' COMMENT '面试难度（来自简历解析推荐难度）',
+    focus_areas TEXT COMMENT '面试关注领域（JSON数组）',
+    question_directions TEXT COMMENT '建议的提问方向（JSON数组）',
+    tech_stack TEXT COMMENT '候选人技术栈（JSON数组）',
+    turn_count INT NOT NULL DEFAULT 0 COMMENT '已回答轮次',
+    max_turns INT NOT NULL DEFAULT 10 COMMENT '最大提问轮次',
+    current_round INT NOT NULL DEFAULT 0 COMMENT '当前面试环节序号，0表示尚未开始或不使用面试计划',
+    ended_at BIGINT NOT NULL DEFAULT 0 COMMENT '结束时间（毫秒）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    deleted_at TIMESTAMP NULL COMMENT '软删除时间',
+    deleted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '删除状态（0=未删除, 1=已删除）',
+
+    PRIMARY KEY (id),
+    KEY idx_user_id (user_id),
+    KEY idx_resume_id (resume_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='模拟面试会话表';
+
+-- 模拟面试轮次
+CREATE TABLE interview_turn (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    session_id BIGINT NOT NULL COMMENT '会话ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    turn_no INT NOT NULL COMMENT '轮次序号（从1开始）',
+    round_no INT NOT NULL DEFAULT 0 COMMENT '所属面试环节序号，0表示不使用面试计划',
+    persona VARCHAR(32) NOT NULL DEFAULT '' COMMENT '提问的面试官角色，为空表示未区分角色',
+    question TEXT NOT NULL COMMENT '面试官提问',
+    answer TEXT COMMENT '候选人回答',
+    status TINYINT NOT NULL DEFAULT 1 COMMENT '轮次状态：1待回答 2已回答',
+    answered_at BIGINT NOT NULL DEFAULT 0 COMMENT '回答时间（毫秒）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_session_turn (session_id, turn_no),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='模拟面试轮次表';
+
+-- 模拟面试会话的环节，开始面试时从面试计划复制，记录各环节进度
+CREATE TABLE interview_session_round (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    session_id BIGINT NOT NULL COMMENT '会话ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    round_no INT NOT NULL COMMENT '环节序号（从1开始）',
+    round_type VARCHAR(32) NOT NULL COMMENT '环节类型：basics/project/algorithm/system_design/behavioral',
+    name VARCHAR(64) NOT NULL COMMENT '环节名称',
+    duration_minutes INT NOT NULL DEFAULT 0 COMMENT '环节时长（分钟），0表示不限时',
+    question_count INT NOT NULL COMMENT '环节提问数',
+    difficulty VARCHAR(32) NOT NULL DEFAULT '' COMMENT '环节难度，为空时使用面试难度',
+    persona VARCHAR(32) NOT NULL DEFAULT '' COMMENT '面试官角色：interviewer/tech_lead/architect/hr',
+    status TINYINT NOT NULL DEFAULT 1 COMMENT '环节状态：1未开始 2进行中 3已完成',
+    started_at BIGINT NOT NULL DEFAULT 0 COMMENT '开始时间（毫秒）',
+    ended_at BIGINT NOT NULL DEFAULT 0 COMMENT '结束时间（毫秒）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_session_round (session_id, round_no),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='模拟面试环节表';
+
+-- 模拟面试评估报告
+CREATE TABLE interview_evaluation (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    session_id BIGINT NOT NULL COMMENT '会话ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    status TINYINT NOT NULL DEFAULT 1 COMMENT '评估状态：1评估中 2已完成 3失败',
+    overall_score INT NOT NULL DEFAULT 0 COMMENT '综合得分（0-100）',
+    summary TEXT COMMENT '总体评价',
+    strengths TEXT COMMENT '优势（JSON数组）',
+    gaps TEXT COMMENT '不足（JSON数组）',
+    suggestions TEXT COMMENT '学习建议（JSON数组）',
+    error_msg VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '评估失败原因',
+    evaluated_at BIGINT NOT NULL DEFAULT 0 COMMENT '评估完成时间（毫秒）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_session_id (session_id),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='模拟面试评估报告表';
+
+-- 模拟面试单题评分
+CREATE TABLE interview_answer_score (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    session_id BIGINT NOT NULL COMMENT '会话ID',
+    turn_id BIGINT NOT NULL COMMENT '轮次ID',
+    turn_no INT NOT NULL COMMENT '轮次序号',
+
+    correctness INT NOT NULL DEFAULT 0 COMMENT '正确性（0-10）',
+    depth INT NOT NULL DEFAULT 0 COMMENT '深度（0-10）',
+    communication INT NOT NULL DEFAULT 0 COMMENT '表达（0-10）',
+    relevance INT NOT NULL DEFAULT 0 COMMENT '与候选人技术栈的相关度（0-10）',
+    comment TEXT COMMENT '点评',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_turn_id (turn_id),
+    KEY idx_session_id (session_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='模拟面试单题评分表';
+
+-- 简历结构化信息版本，记录模型解析结果及用户的修改
+CREATE TABLE resume_profile_version (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    resume_id BIGINT NOT NULL COMMENT '简历ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    version INT NOT NULL COMMENT '版本号，从1开始递增',
+    source TINYINT NOT NULL COMMENT '来源：1模型解析 2用户修改',
+    content LONGTEXT NOT NULL COMMENT '结构化简历信息（JSON）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_resume_version (resume_id, version),
+    KEY idx_user_id (user_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='简历结构化信息版本表';
+
+-- 简历解析记录，每次调用模型解析（含自动重试）记录一条
+CREATE TABLE resume_parse_attempt (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    resume_id BIGINT NOT NULL COMMENT '简历ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+
+    attempt INT NOT NULL COMMENT '第几次投递，与简历的 parse_attempts 对应',
+    retry INT NOT NULL DEFAULT 0 COMMENT '同一次投递内的自动重试序号，0表示首次执行',
+    model VARCHAR(255) NOT NULL DEFAULT '' COMMENT '使用的模型',
+    duration_ms BIGINT NOT NULL DEFAULT 0 COMMENT '耗时（毫秒）',
+    outcome TINYINT NOT NULL COMMENT '结果：1成功 2失败',
+    fail_reason VARCHAR(32) NOT NULL DEFAULT '' COMMENT '失败原因分类',
+    error VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '失败详情',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+
+    PRIMARY KEY (id),
+    KEY idx_resume_id (resume_id)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='简历解析记录表';
+
+-- 事务消息，与业务数据在同一事务中写入，由投递任务发送到 Kafka，保证消息至少投递一次
+CREATE TABLE outbox_event (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+
+    topic VARCHAR(255) NOT NULL COMMENT 'Kafka 主题',
+    msg_key VARCHAR(512) NOT NULL DEFAULT '' COMMENT '消息 key',
+    payload LONGTEXT NOT NULL COMMENT '消息内容',
+
+    status TINYINT NOT NULL DEFAULT 0 COMMENT '状态：0待投递 1已投递',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '投递次数',
+    last_error VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '最后一次投递失败原因',
+    next_attempt_at BIGINT NOT NULL DEFAULT 0 COMMENT '最早可投递时间（毫秒），投递中的消息在租期内不会被其他实例领取',
+    sent_at BIGINT NOT NULL DEFAULT 0 COMMENT '投递成功时间（毫秒）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+
+    PRIMARY KEY (id),
+    KEY idx_status_next_attempt (status, next_attempt_at)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='事务消息表';
+
+-- 进程内消息队列（kafka.backend=memory 且 memory.persist=mysql）的消息，offset 为消息在主题中的序号
+CREATE TABLE mq_message (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+
+    topic VARCHAR(255) NOT NULL COMMENT '主题',
+    msg_offset BIGINT NOT NULL COMMENT '消息在主题中的偏移量',
+    msg_key VARBINARY(512) NULL COMMENT '消息 key',
+    payload LONGBLOB NOT NULL COMMENT '消息内容',
+
+    created_at BIGINT NOT NULL DEFAULT 0 COMMENT '写入时间（毫秒）',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_topic_offset (topic, msg_offset)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='进程内消息队列消息表';
+
+-- 进程内消息队列各消费组的消费进度
+CREATE TABLE mq_consumer_offset (
+    group_id VARCHAR(255) NOT NULL COMMENT '消费组',
+    topic VARCHAR(255) NOT NULL COMMENT '主题',
+    next_offset BIGINT NOT NULL DEFAULT 0 COMMENT '下一条待消费消息的偏移量',
+
+    updated_at BIGINT NOT NULL DEFAULT 0 COMMENT '提交时间（毫秒）',
+
+    PRIMARY KEY (group_id, topic)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='进程内消息队列消费进度表';
+
+-- 题库
+CREATE TABLE question (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    creator_id BIGINT NOT NULL COMMENT '创建人用户ID',
+
+    title VARCHAR(512) NOT NULL COMMENT '题目',
+    content TEXT COMMENT '题目详细描述（Markdown）',
+    question_type VARCHAR(32) NOT NULL COMMENT '题型：concept/coding/system_design/behavioral',
+    difficulty VARCHAR(32) NOT NULL DEFAULT '' COMMENT '难度：初级/中级/高级',
+    reference_answer TEXT COMMENT '参考答案（Markdown）',
+    source VARCHAR(255) NOT NULL DEFAULT '' COMMENT '来源',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    deleted_at TIMESTAMP NULL COMMENT '软删除时间',
+    deleted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '删除状态（0=未删除, 1=已删除）',
+
+    PRIMARY KEY (id),
+    KEY idx_creator_id (creator_id),
+    KEY idx_type_difficulty (question_type, difficulty)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='题库题目表';
+
+-- 题目标签，一道题可以有多个标签
+CREATE TABLE question_tag (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    question_id BIGINT NOT NULL COMMENT '题目ID',
+    tag VARCHAR(64) NOT NULL COMMENT '标签（小写，如 go、mysql、redis、kafka）',
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_question_tag (question_id, tag),
+    KEY idx_tag (tag)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_unicode_ci
+  COMMENT='题目标签表';
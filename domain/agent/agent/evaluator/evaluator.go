@@ -0,0 +1,58 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino/adk"
+)
+
+// NewEvaluatorAgent 创建面试评估智能体
+// 面试结束后对每一轮回答打分，并生成整场面试的评估报告
+func NewEvaluatorAgent(ctx context.Context, model cchatmodel.ToolCallingChatModel) (adk.Agent, error) {
+	baseAgent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
+		Name:        "EvaluatorAgent",
+		Description: "一个专业的面试评估智能体，对模拟面试的回答进行评分并生成评估报告",
+		Instruction: `你是一名资深的技术面试评估专家。你的任务是根据一场模拟面试的完整问答记录，对候选人的每一轮回答打分，并给出整场面试的评估报告。
+
+评分标准（每一项 0-10 分，整数）：
+- correctness（正确性）：回答的技术内容是否准确，有无事实性错误
+- depth（深度）：是否深入到原理、权衡和实践经验，而不是停留在概念层面
+- communication（表达）：回答是否条理清晰、重点突出、易于理解
+- relevance（相关度）：回答与问题以及候选人技术栈的相关程度
+
+评估要求：
+1. 必须对输入中的每一轮问答都给出评分，turn_no 与输入保持一致
+2. 候选人未作答或答非所问时相应维度给低分，不要臆造候选人没有说过的内容
+3. overall_score 为整场面试的综合得分（0-100 分，整数），综合考虑各轮表现
+4. strengths 和 gaps 要具体到知识点或能力，避免空泛的评价
+5. study_suggestions 针对 gaps 给出可执行的学习建议
+6. 只返回JSON格式，不要返回其他文本
+
+必须返回的JSON格式：
+{
+  "answer_scores": [
+    {
+      "turn_no": 1,
+      "correctness": 0,
+      "depth": 0,
+      "communication": 0,
+      "relevance": 0,
+      "comment": "对该轮回答的简要点评"
+    }
+  ],
+  "overall_score": 0,
+  "summary": "整场面试的总体评价",
+  "strengths": ["优势1", "优势2"],
+  "gaps": ["不足1", "不足2"],
+  "study_suggestions": ["建议1", "建议2"]
+}`,
+		Model:         model,
+		MaxIterations: 5,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create evaluator agent: %w", err)
+	}
+	return baseAgent, nil
+}
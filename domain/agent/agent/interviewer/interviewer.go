@@ -0,0 +1,206 @@
+package interviewer
+
+import (
+	"context"
+	"fmt"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+	"strings"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/compose"
+)
+
+// InterviewerSeed 面试官初始化信息，来自简历解析结果
+type InterviewerSeed struct {
+	Difficulty         string      // 推荐面试难度
+	FocusAreas         []string    // 面试关注领域
+	QuestionDirections []string    // 建议的提问方向
+	TechStack          []string    // 候选人技术栈
+	MaxTurns           int32       // 最大提问轮次
+	TargetJob          *TargetJob  // 目标岗位，为空时不针对具体岗位
+	Round              *RoundState // 当前面试环节，为空时不使用面试计划
+	TotalRounds        int32       // 面试计划的环节数
+	Persona            *Persona    // 面试官角色，为空时为技术面试官
+	Panel              []*Persona  // 面试官小组的全部成员，为空时不使用面试官小组
+}
+
+// TargetJob 面试针对的目标岗位，来自岗位描述解析结果
+type TargetJob struct {
+	Title            string   // 岗位名称
+	Seniority        string   // 岗位职级
+	RequiredSkills   []string // 必备技能
+	PreferredSkills  []string // 加分技能
+	Responsibilities []string // 岗位职责
+	MissingSkills    []string // 简历中未体现的必备技能
+}
+
+// roundGuides 各类型面试环节的提问要求
+var roundGuides = map[string]string{
+	"basics":        "考察候选人技术栈中的核心概念和底层原理，由浅入深，注意区分背诵和真正理解",
+	"project":       "围绕候选人简历中的项目经历深入追问：项目背景、架构设计、技术难点、个人贡献和复盘反思",
+	"algorithm":     "给出一道与难度匹配的算法或编程题，请候选人讲解解题思路、时间和空间复杂度，可追问边界情况和优化方案",
+	"system_design": "给出一个开放式的系统设计题，考察需求澄清、整体架构、数据模型、扩展性和各方案的权衡取舍",
+	"behavioral":    "通过候选人过往的真实经历考察沟通协作、冲突处理、抗压能力和职业规划，按 STAR 方式追问细节",
+}
+
+// NewInterviewerAgent 创建面试官智能体
+// 根据简历解析结果逐轮向候选人提问，每次只输出一个问题；提供题库检索工具时参考题库中的题目提问。
+// seed 指定面试环节时，智能体以该环节的面试官身份提问，名称为 RoundAgentName，供面试计划编排器转交；
+// seed 指定面试官小组时，智能体作为小组成员提问，名称为 PanelistAgentName，供主持人转交
+func NewInterviewerAgent(ctx context.Context, model cchatmodel.ToolCallingChatModel, seed *InterviewerSeed, tools ...tool.BaseTool) (adk.Agent, error) {
+	name, description := "InterviewerAgent", "一个专业的技术面试官智能体，根据候选人简历进行模拟面试"
+	switch {
+	case seed.Round != nil:
+		name = RoundAgentName(seed.Round.RoundNo)
+		description = fmt.Sprintf("负责面试第 %d 个环节「%s」的面试官", seed.Round.RoundNo, seed.Round.Name)
+	case len(seed.Panel) > 0 && seed.Persona != nil:
+		name = PanelistAgentName(seed.Persona.Key)
+		description = fmt.Sprintf("%s：%s", seed.Persona.Name, seed.Persona.Instruction)
+	}
+
+	baseAgent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
+		Name:        name,
+		Description: description,
+		Instruction: buildInstruction(seed, len(tools) > 0),
+		Model:       model,
+		ToolsConfig: adk.ToolsConfig{
+			ToolsNodeConfig: compose.ToolsNodeConfig{Tools: tools},
+		},
+		MaxIterations: 5,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interviewer agent: %w", err)
+	}
+	return baseAgent, nil
+}
+
+// buildInstruction 根据简历解析结果生成面试官的系统提示词
+func buildInstruction(seed *InterviewerSeed, withQuestionBank bool) string {
+	difficulty := seed.Difficulty
+	if difficulty == "" {
+		difficulty = "中级"
+	}
+
+	instruction := fmt.Sprintf(`你是一名经验丰富的技术面试官，正在对候选人进行一场模拟面试。
+
+候选人信息：
+- 推荐面试难度：%s
+- 技术栈：%s
+- 重点关注领域：%s
+- 建议的提问方向：%s
+
+面试规则：
+1. 每次只提出一个问题，问题要具体、清晰，避免一次问多个问题
+2. 围绕重点关注领域和建议的提问方向展开，问题难度与推荐难度保持一致
+3. 根据候选人上一轮的回答决定是否追问：回答浅显时深入追问，回答充分时切换到新的领域
+4. 整场面试最多 %d 个问题，注意覆盖不同的关注领域
+5. 不要评价候选人的回答，不要给出答案，不要输出与问题无关的内容
+
+输出要求：
+- 只输出面试官要说的话（即下一个问题本身），不要包含任何前缀、编号或解释`,
+		difficulty,
+		joinOrNone(seed.TechStack),
+		joinOrNone(seed.FocusAreas),
+		joinOrNone(seed.QuestionDirections),
+		seed.MaxTurns,
+	)
+	if job := seed.TargetJob; job != nil {
+		instruction += fmt.Sprintf(`
+
+目标岗位：
+- 岗位：%s（%s）
+- 必备技能：%s
+- 加分技能：%s
+- 岗位职责：%s
+- 简历中未体现的必备技能：%s
+
+针对岗位：
+- 问题围绕目标岗位的必备技能和职责展开，结合候选人的经历考察其能否胜任该岗位
+- 对简历中未体现的必备技能，考察候选人是否具备相关基础或可迁移的经验，不要因简历未提及而跳过
+- 加分技能在必备技能考察充分后再涉及`,
+			orDefault(job.Title, "未注明"),
+			orDefault(job.Seniority, "未注明"),
+			joinOrNone(job.RequiredSkills),
+			joinOrNone(job.PreferredSkills),
+			joinOrNone(job.Responsibilities),
+			joinOrNone(job.MissingSkills),
+		)
+	}
+	if round := seed.Round; round != nil {
+		duration := "不限时"
+		if round.DurationMinutes > 0 {
+			duration = fmt.Sprintf("约 %d 分钟", round.DurationMinutes)
+		}
+		instruction += fmt.Sprintf(`
+
+当前面试环节：
+- 本场面试共 %d 个环节，当前为第 %d 个环节「%s」，时长%s，本环节共 %d 个问题，已问 %d 个
+%s
+- 本环节要求：%s
+- 本环节的要求优先于上述通用规则；只提出属于本环节的问题，不要提前进入其他环节`,
+			seed.TotalRounds,
+			round.RoundNo,
+			round.Name,
+			duration,
+			round.QuestionCount,
+			round.AskedCount,
+			personaLines(seed.Persona),
+			orDefault(roundGuides[round.Type], "围绕本环节的主题提问"),
+		)
+		if round.AskedCount == 0 && round.RoundNo > 1 {
+			instruction += "\n- 这是本环节的第一个问题，提问前用一句话自然地过渡到本环节"
+		}
+	}
+	if len(seed.Panel) > 0 && seed.Persona != nil {
+		names := make([]string, 0, len(seed.Panel))
+		for _, p := range seed.Panel {
+			names = append(names, p.Name)
+		}
+		instruction += fmt.Sprintf(`
+
+面试官小组：
+- 本场面试由 %d 位面试官（%s）组成小组轮流提问，由主持人决定每个问题由谁提出，现在轮到你提问
+%s
+- 只从你的身份和关注领域出发提问，可以承接其他面试官的话题追问，但不要重复已经问过的问题
+- 你的身份和语气优先于上述通用规则中的提问风格；不要在问题前加上自己的身份或名字`,
+			len(seed.Panel),
+			strings.Join(names, "、"),
+			personaLines(seed.Persona),
+		)
+	}
+	if !withQuestionBank {
+		return instruction
+	}
+
+	return instruction + `
+
+题库使用：
+- 切换到新的领域提问前，可调用 search_question_bank 工具检索题库中与候选人相关的题目，可以指定想考察的主题
+- 优先选用与推荐难度一致、且本场面试尚未问过的题目，可结合候选人的项目经历改写题目，不要透露题目来自题库
+- 追问时无需检索题库；题库没有合适的题目或检索失败时，直接根据候选人信息提问
+- 调用工具时不要输出其他内容`
+}
+
+// personaLines 面试官角色的身份、关注领域和语气，未指定角色时为技术面试官
+func personaLines(p *Persona) string {
+	if p == nil {
+		p = DefaultPersonas[0]
+	}
+	return fmt.Sprintf("- 你的身份：%s，%s\n- 你的关注领域：%s\n- 你的提问语气：%s",
+		p.Name, p.Instruction, joinOrNone(p.FocusAreas), orDefault(p.Tone, "专业、友好"))
+}
+
+func orDefault(s string, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return "无"
+	}
+	return strings.Join(items, "、")
+}
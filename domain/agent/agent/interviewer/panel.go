@@ -0,0 +1,154 @@
+package interviewer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/adk/prebuilt/supervisor"
+)
+
+// PanelModeratorName 面试官小组主持人智能体的名称
+const PanelModeratorName = "PanelModeratorAgent"
+
+// panelSpeakerSelectedKey 本次提问已选出发言面试官的会话标记
+const panelSpeakerSelectedKey = "panel_speaker_selected"
+
+// Persona 面试官角色定义
+type Persona struct {
+	Key         string   // 角色标识，只包含小写字母、数字和下划线
+	Name        string   // 角色名称
+	Instruction string   // 角色身份和考察重点
+	FocusAreas  []string // 关注领域
+	Tone        string   // 提问语气
+}
+
+// DefaultPersonas 内置面试官角色，可通过配置按 Key 覆盖或新增
+var DefaultPersonas = []*Persona{
+	{
+		Key:         "interviewer",
+		Name:        "技术面试官",
+		Instruction: "专业、客观，考察候选人技术知识的准确性和深度",
+		FocusAreas:  []string{"技术基础", "底层原理"},
+		Tone:        "专业、简洁",
+	},
+	{
+		Key:         "tech_lead",
+		Name:        "技术负责人",
+		Instruction: "关注候选人在项目中的实际贡献、技术决策和落地能力，善于追问细节",
+		FocusAreas:  []string{"项目经历", "技术决策", "问题排查"},
+		Tone:        "务实、直接，喜欢追问细节",
+	},
+	{
+		Key:         "architect",
+		Name:        "架构师",
+		Instruction: "关注系统的整体设计、技术选型的权衡以及可扩展性和稳定性",
+		FocusAreas:  []string{"系统设计", "技术选型", "高可用与扩展性"},
+		Tone:        "沉稳、开放，鼓励候选人讲清权衡取舍",
+	},
+	{
+		Key:         "hr",
+		Name:        "HR",
+		Instruction: "关注候选人的沟通表达、团队协作、职业动机和稳定性，不问技术细节",
+		FocusAreas:  []string{"沟通协作", "职业规划", "求职动机"},
+		Tone:        "亲和、轻松，像聊天一样提问",
+	},
+}
+
+// PanelistAgentName 面试官小组成员智能体的名称，主持人据此转交发言权
+func PanelistAgentName(key string) string {
+	return "Panelist_" + key
+}
+
+// PanelProgress 面试官小组的提问进度，供主持人决定下一位发言的面试官
+type PanelProgress struct {
+	TurnCount   int32            // 已提问数
+	MaxTurns    int32            // 最大提问数
+	AskedCounts map[string]int32 // 各面试官（Key）已提问数
+	LastSpeaker string           // 上一个问题的面试官 Key，为空表示尚未提问
+}
+
+// NewInterviewPanel 创建面试官小组
+// 主持人根据面试进度选择下一位面试官并转交发言权，该面试官提问后交回主持人，主持人直接结束本次提问；
+// panelists 与 personas 一一对应，需按 PanelistAgentName 命名
+func NewInterviewPanel(ctx context.Context, model cchatmodel.ToolCallingChatModel, personas []*Persona, progress *PanelProgress, panelists []adk.Agent) (adk.Agent, error) {
+	moderator, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
+		Name:          PanelModeratorName,
+		Description:   "模拟面试的主持人，决定下一个问题由面试官小组中的哪位面试官提出",
+		Instruction:   buildModeratorInstruction(personas, progress),
+		Model:         model,
+		MaxIterations: 3,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create panel moderator agent: %w", err)
+	}
+
+	panel, err := supervisor.New(ctx, &supervisor.Config{
+		Supervisor: &panelModerator{ChatModelAgent: moderator},
+		SubAgents:  panelists,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interview panel: %w", err)
+	}
+	return panel, nil
+}
+
+// panelModerator 面试官小组主持人；面试官提问后发言权会交回主持人，此时直接结束，不再调用模型
+type panelModerator struct {
+	*adk.ChatModelAgent
+}
+
+func (m *panelModerator) Run(ctx context.Context, input *adk.AgentInput, opts ...adk.AgentRunOption) *adk.AsyncIterator[*adk.AgentEvent] {
+	if _, selected := adk.GetSessionValue(ctx, panelSpeakerSelectedKey); selected {
+		iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+		gen.Send(&adk.AgentEvent{Action: adk.NewExitAction()})
+		gen.Close()
+		return iter
+	}
+
+	adk.AddSessionValue(ctx, panelSpeakerSelectedKey, true)
+	return m.ChatModelAgent.Run(ctx, input, opts...)
+}
+
+// buildModeratorInstruction 生成主持人的系统提示词
+func buildModeratorInstruction(personas []*Persona, progress *PanelProgress) string {
+	members := make([]string, 0, len(personas))
+	counts := make([]string, 0, len(personas))
+	lastSpeaker := "无（这是第一个问题）"
+	for _, p := range personas {
+		members = append(members, fmt.Sprintf("- %s（%s）：%s；关注领域：%s",
+			PanelistAgentName(p.Key), p.Name, p.Instruction, joinOrNone(p.FocusAreas)))
+		counts = append(counts, fmt.Sprintf("%s %d 个", p.Name, progress.AskedCounts[p.Key]))
+		if p.Key == progress.LastSpeaker {
+			lastSpeaker = p.Name
+		}
+	}
+
+	return fmt.Sprintf(`你是一场模拟面试的主持人，面试由一个面试官小组轮流提问。你的任务是根据面试进度决定下一个问题由哪位面试官提出。
+
+面试官小组：
+%s
+
+当前进度：
+- 已提问 %d 个问题，整场面试最多 %d 个
+- 各面试官已提问：%s
+- 上一个问题由「%s」提出
+
+选择规则：
+1. 候选人上一轮的回答浅显或存在疑点时，优先让上一位面试官继续追问
+2. 上一位面试官的话题已讨论充分时，换一位关注领域尚未覆盖的面试官
+3. 兼顾每位面试官的提问机会，剩余问题不多时优先安排尚未提问的面试官
+4. 技术类面试官优先，沟通协作、职业规划等非技术话题安排在面试后半段
+
+输出要求：
+- 只调用 transfer_to_agent 工具把发言权交给选定的面试官，不要输出任何其他内容，不要自己提问`,
+		strings.Join(members, "\n"),
+		progress.TurnCount,
+		progress.MaxTurns,
+		strings.Join(counts, "、"),
+		lastSpeaker,
+	)
+}
@@ -0,0 +1,86 @@
+package interviewer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeModeratorModel 总是把发言权转交给指定面试官的主持人模型
+type fakeModeratorModel struct {
+	dest         string
+	calls        int
+	instructions []string
+}
+
+func (m *fakeModeratorModel) Generate(_ context.Context, input []*schema.Message, _ ...model.Option) (*schema.Message, error) {
+	m.calls++
+	if len(input) > 0 && input[0].Role == schema.System {
+		m.instructions = append(m.instructions, input[0].Content)
+	}
+	return schema.AssistantMessage("", []schema.ToolCall{{
+		ID:       fmt.Sprintf("call_%d", m.calls),
+		Function: schema.FunctionCall{Name: adk.TransferToAgentToolName, Arguments: fmt.Sprintf(`{"agent_name":%q}`, m.dest)},
+	}}), nil
+}
+
+func (m *fakeModeratorModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := m.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (m *fakeModeratorModel) WithTools(_ []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+func TestInterviewPanel(t *testing.T) {
+	ctx := context.Background()
+	personas := DefaultPersonas[1:]
+	panelists := make([]adk.Agent, 0, len(personas))
+	for _, p := range personas {
+		panelists = append(panelists, &fakeRoundAgent{name: PanelistAgentName(p.Key)})
+	}
+
+	moderatorModel := &fakeModeratorModel{dest: PanelistAgentName("architect")}
+	progress := &PanelProgress{
+		TurnCount:   2,
+		MaxTurns:    6,
+		AskedCounts: map[string]int32{"tech_lead": 2},
+		LastSpeaker: "tech_lead",
+	}
+	panel, err := NewInterviewPanel(ctx, moderatorModel, personas, progress, panelists)
+	assert.NoError(t, err)
+
+	runner := adk.NewRunner(ctx, adk.RunnerConfig{Agent: panel})
+	iter := runner.Run(ctx, []adk.Message{schema.UserMessage("开始面试")}, adk.WithSkipTransferMessages())
+
+	var speaker, question string
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+		assert.NoError(t, event.Err)
+		if event.Output == nil || event.Output.MessageOutput == nil || event.AgentName == PanelModeratorName {
+			continue
+		}
+		if msg := event.Output.MessageOutput.Message; msg != nil && msg.Role == schema.Assistant && len(msg.ToolCalls) == 0 {
+			speaker, question = event.AgentName, msg.Content
+		}
+	}
+
+	assert.Equal(t, PanelistAgentName("architect"), speaker)
+	assert.Equal(t, PanelistAgentName("architect")+" 的问题", question)
+	// 面试官提问后主持人直接结束，不再调用模型
+	assert.Equal(t, 1, moderatorModel.calls)
+	assert.Contains(t, moderatorModel.instructions[0], "技术负责人 2 个、架构师 0 个、HR 0 个")
+	assert.Contains(t, moderatorModel.instructions[0], "上一个问题由「技术负责人」提出")
+}
@@ -0,0 +1,106 @@
+package interviewer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+)
+
+// RoundState 面试计划中的一个环节及其进度
+type RoundState struct {
+	RoundNo         int32     // 环节序号（从1开始）
+	Type            string    // 环节类型：basics/project/algorithm/system_design/behavioral
+	Name            string    // 环节名称
+	Persona         string    // 面试官角色：interviewer/tech_lead/architect/hr
+	QuestionCount   int32     // 环节提问数
+	DurationMinutes int32     // 环节时长（分钟），0表示不限时
+	AskedCount      int32     // 本环节已提问数
+	StartedAt       time.Time // 开始时间，零值表示尚未开始
+	Finished        bool      // 是否已标记为完成
+}
+
+// Done 环节是否已结束：已标记完成、提问数已用完或已超过环节时长
+func (r *RoundState) Done(now time.Time) bool {
+	if r.Finished || r.AskedCount >= r.QuestionCount {
+		return true
+	}
+	return r.DurationMinutes > 0 && !r.StartedAt.IsZero() &&
+		now.Sub(r.StartedAt) >= time.Duration(r.DurationMinutes)*time.Minute
+}
+
+// SelectRound 按顺序返回第一个未结束的环节，全部结束时返回 nil
+func SelectRound(rounds []*RoundState, now time.Time) *RoundState {
+	for _, round := range rounds {
+		if !round.Done(now) {
+			return round
+		}
+	}
+	return nil
+}
+
+// RoundDecision 编排器选择的环节，通过 AgentEvent 的 CustomizedOutput 输出
+type RoundDecision struct {
+	Round *RoundState // 本次提问所在的环节，为空表示所有环节均已结束
+}
+
+// RoundAgentName 环节面试官智能体的名称，编排器据此转交
+func RoundAgentName(roundNo int32) string {
+	return fmt.Sprintf("Round%dInterviewerAgent", roundNo)
+}
+
+// NewPlanOrchestrator 创建面试计划编排智能体
+// 编排器根据各环节进度选择当前环节，再转交给该环节的面试官智能体提问；roundAgents 需按 RoundAgentName 命名
+func NewPlanOrchestrator(ctx context.Context, rounds []*RoundState, roundAgents []adk.Agent) (adk.Agent, error) {
+	subAgents := make([]adk.Agent, 0, len(roundAgents))
+	for _, agent := range roundAgents {
+		// 环节面试官只负责提问，环节的切换由编排器决定
+		subAgents = append(subAgents, adk.AgentWithOptions(ctx, agent, adk.WithDisallowTransferToParent()))
+	}
+
+	orchestrator, err := adk.SetSubAgents(ctx, &planOrchestrator{rounds: rounds}, subAgents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interview plan orchestrator: %w", err)
+	}
+	return orchestrator, nil
+}
+
+type planOrchestrator struct {
+	rounds []*RoundState
+}
+
+func (o *planOrchestrator) Name(_ context.Context) string {
+	return "InterviewPlanOrchestrator"
+}
+
+func (o *planOrchestrator) Description(_ context.Context) string {
+	return "按面试计划推进面试环节，将提问转交给当前环节的面试官"
+}
+
+func (o *planOrchestrator) Run(ctx context.Context, _ *adk.AgentInput, _ ...adk.AgentRunOption) *adk.AsyncIterator[*adk.AgentEvent] {
+	iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+	defer gen.Close()
+
+	round := SelectRound(o.rounds, time.Now())
+	decision := &adk.AgentEvent{
+		Output: &adk.AgentOutput{CustomizedOutput: &RoundDecision{Round: round}},
+	}
+	if round == nil {
+		decision.Action = adk.NewExitAction()
+		gen.Send(decision)
+		return iter
+	}
+	gen.Send(decision)
+
+	dest := RoundAgentName(round.RoundNo)
+	assistantMsg, toolMsg := adk.GenTransferMessages(ctx, dest)
+	gen.Send(adk.EventFromMessage(assistantMsg, nil, schema.Assistant, ""))
+
+	transfer := adk.EventFromMessage(toolMsg, nil, schema.Tool, toolMsg.ToolName)
+	transfer.Action = adk.NewTransferToAgentAction(dest)
+	gen.Send(transfer)
+
+	return iter
+}
@@ -0,0 +1,93 @@
+package interviewer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRoundAgent 直接返回固定问题的环节面试官
+type fakeRoundAgent struct {
+	name string
+}
+
+func (a *fakeRoundAgent) Name(_ context.Context) string {
+	return a.name
+}
+
+func (a *fakeRoundAgent) Description(_ context.Context) string {
+	return a.name
+}
+
+func (a *fakeRoundAgent) Run(_ context.Context, _ *adk.AgentInput, _ ...adk.AgentRunOption) *adk.AsyncIterator[*adk.AgentEvent] {
+	iter, gen := adk.NewAsyncIteratorPair[*adk.AgentEvent]()
+	gen.Send(adk.EventFromMessage(schema.AssistantMessage(a.name+" 的问题", nil), nil, schema.Assistant, ""))
+	gen.Close()
+	return iter
+}
+
+func TestSelectRound(t *testing.T) {
+	now := time.Now()
+	rounds := []*RoundState{
+		{RoundNo: 1, QuestionCount: 2, AskedCount: 2},
+		{RoundNo: 2, QuestionCount: 3, AskedCount: 1, DurationMinutes: 10, StartedAt: now.Add(-11 * time.Minute)},
+		{RoundNo: 3, QuestionCount: 2, DurationMinutes: 10},
+	}
+
+	// 第1环节提问数已用完，第2环节已超时，未开始的第3环节不计时
+	assert.Equal(t, int32(3), SelectRound(rounds, now).RoundNo)
+
+	rounds[2].Finished = true
+	assert.Nil(t, SelectRound(rounds, now))
+}
+
+func TestPlanOrchestrator(t *testing.T) {
+	ctx := context.Background()
+	rounds := []*RoundState{
+		{RoundNo: 1, QuestionCount: 1, AskedCount: 1},
+		{RoundNo: 2, QuestionCount: 2},
+	}
+	agents := []adk.Agent{
+		&fakeRoundAgent{name: RoundAgentName(1)},
+		&fakeRoundAgent{name: RoundAgentName(2)},
+	}
+
+	run := func() (decision *RoundDecision, question string) {
+		orchestrator, err := NewPlanOrchestrator(ctx, rounds, agents)
+		assert.NoError(t, err)
+
+		runner := adk.NewRunner(ctx, adk.RunnerConfig{Agent: orchestrator})
+		iter := runner.Run(ctx, []adk.Message{schema.UserMessage("开始面试")}, adk.WithSkipTransferMessages())
+		for {
+			event, ok := iter.Next()
+			if !ok {
+				break
+			}
+			assert.NoError(t, event.Err)
+			if event.Output == nil {
+				continue
+			}
+			if d, ok := event.Output.CustomizedOutput.(*RoundDecision); ok {
+				decision = d
+				continue
+			}
+			if msg := event.Output.MessageOutput; msg != nil && msg.Role == schema.Assistant && msg.Message != nil {
+				question = msg.Message.Content
+			}
+		}
+		return decision, question
+	}
+
+	decision, question := run()
+	assert.Equal(t, int32(2), decision.Round.RoundNo)
+	assert.Equal(t, RoundAgentName(2)+" 的问题", question)
+
+	rounds[1].AskedCount = 2
+	decision, question = run()
+	assert.Nil(t, decision.Round)
+	assert.Empty(t, question)
+}
@@ -0,0 +1,51 @@
+package jobdescription
+
+import (
+	"context"
+	"fmt"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino/adk"
+)
+
+// NewJobDescriptionParserAgent 创建岗位描述解析智能体
+// 从岗位描述（JD）中提取技能要求、职级和职责，用于与简历匹配和针对岗位的模拟面试
+func NewJobDescriptionParserAgent(ctx context.Context, model cchatmodel.ToolCallingChatModel) (adk.Agent, error) {
+	baseAgent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
+		Name:        "JobDescriptionParserAgent",
+		Description: "一个专业的岗位描述分析智能体，用于提取岗位的技能要求、职级和职责",
+		Instruction: `你是一名资深的技术招聘专家。你的任务是分析一份岗位描述（JD），提取用于评估候选人匹配度的关键信息。
+
+提取要求：
+1. required_skills：任职要求中明确要求掌握的技术、工具或能力，每项尽量简短（如 "Go"、"MySQL"、"分布式系统设计"）
+2. preferred_skills："优先"、"加分项"、"熟悉者优先"等非必须的技能
+3. seniority：岗位职级，从 初级/中级/高级/专家 中选择，根据工作年限要求和职责范围判断
+4. min_work_years：要求的最少工作年限（整数），未提及时为 0
+5. responsibilities：岗位职责，每条概括为一句话
+6. keywords：岗位描述中出现的其他重要关键词（业务领域、技术方向等），不要与技能重复
+7. title 和 company：岗位名称和公司名称，未提及时返回空字符串
+
+重要提示：
+- 只提取岗位描述中真实出现的信息，不要臆造
+- 技能名称使用通用写法，不要附带"熟悉"、"精通"等修饰词
+- 只返回JSON格式，不要返回其他文本
+
+必须返回的JSON格式：
+{
+  "title": "岗位名称",
+  "company": "公司名称",
+  "seniority": "中级",
+  "min_work_years": 3,
+  "required_skills": ["技能1", "技能2"],
+  "preferred_skills": ["技能1", "技能2"],
+  "responsibilities": ["职责1", "职责2"],
+  "keywords": ["关键词1", "关键词2"]
+}`,
+		Model:         model,
+		MaxIterations: 5,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job description parser agent: %w", err)
+	}
+	return baseAgent, nil
+}
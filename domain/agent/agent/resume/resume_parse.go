@@ -3,26 +3,15 @@ package resume
 import (
 	"context"
 	"fmt"
-	"mianshiba/conf"
 	cchatmodel "mianshiba/infra/contract/chatmodel"
-	"mianshiba/infra/impl/chatmodel"
 
 	"github.com/cloudwego/eino/adk"
 )
 
 // NewResumeParserAgent 创建简历解析智能体
 // 用于解析简历内容，提取关键信息用于面试准备
-func NewResumeParserAgent() (adk.Agent, error) {
+func NewResumeParserAgent(model cchatmodel.ToolCallingChatModel) (adk.Agent, error) {
 	ctx := context.Background()
-	model, err := chatmodel.ChatModelDefaultFactory.CreateChatModel(ctx, cchatmodel.ProtocolOpenAI, &cchatmodel.Config{
-		APIKey:  conf.Global.OpenAPI.ModelAPIKey,
-		BaseURL: conf.Global.OpenAPI.ModelBaseURL,
-		Model:   conf.Global.OpenAPI.ModelModel,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create OpenAI chat model: %w", err)
-	}
-
 	baseAgent, err := adk.NewChatModelAgent(ctx, &adk.ChatModelAgentConfig{
 		Name:        "ResumeParserAgent",
 		Description: "一个专业的简历分析智能体，用于提取简历中的关键信息",
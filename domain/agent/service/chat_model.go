@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mianshiba/conf"
+	userDal "mianshiba/domain/user/dal"
+	userEntity "mianshiba/domain/user/entity"
+	userRepository "mianshiba/domain/user/repository"
+	userService "mianshiba/domain/user/service"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+	"mianshiba/infra/impl/chatmodel"
+	"mianshiba/pkg/encrypt"
+)
+
+// ChatModelResolver 为智能体解析对话模型：优先使用用户配置的默认模型，未配置时使用全局模型
+// feature 为调用模型的功能，用于统计用量；使用全局模型（平台共享密钥）时受每日配额限制，已达配额时返回 ErrUserTokenQuotaExceededCode
+type ChatModelResolver interface {
+	Resolve(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, error)
+	// ResolveWithName 同 Resolve，并返回所用模型的名称（协议/模型），用于记录
+	ResolveWithName(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, string, error)
+}
+
+// NewChatModelResolver usage 为空时不统计用量，也不限制配额
+func NewChatModelResolver(userModelRepo userRepository.UserModelRepository, usage userService.TokenUsage) ChatModelResolver {
+	return &chatModelResolverImpl{
+		userModelRepo: userModelRepo,
+		usage:         usage,
+	}
+}
+
+type chatModelResolverImpl struct {
+	userModelRepo userRepository.UserModelRepository
+	usage         userService.TokenUsage
+}
+
+func (r *chatModelResolverImpl) Resolve(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, error) {
+	model, _, err := r.ResolveWithName(ctx, userID, feature)
+	return model, err
+}
+
+func (r *chatModelResolverImpl) ResolveWithName(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, string, error) {
+	if userID > 0 && r.userModelRepo != nil {
+		userModel, exist, err := r.userModelRepo.GetDefaultUserModel(ctx, userID, userDal.UserModelScopeAgent)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get default user model: %w", err)
+		}
+
+		if exist {
+			apiKey, err := encrypt.DecryptAPIKey(userModel.APIKeyEncrypted)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to decrypt api key of user model %d: %w", userModel.ID, err)
+			}
+
+			config, err := BuildChatModelConfig(userModel.ModelKey, userModel.BaseURL, apiKey, userModel.ConfigJSON, userModel.DefaultParams)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid config of user model %d: %w", userModel.ID, err)
+			}
+
+			log.Printf("[ResolveChatModel] 使用用户默认模型，用户ID: %d，模型ID: %d，协议: %s", userID, userModel.ID, userModel.Protocol)
+			name := userModel.Protocol + "/" + userModel.ModelKey
+			model, err := chatmodel.ChatModelDefaultFactory.CreateChatModel(ctx, cchatmodel.Protocol(userModel.Protocol), config)
+			if err != nil {
+				return nil, name, err
+			}
+			return r.withUsage(model, userID, feature, name, false), name, nil
+		}
+	}
+
+	name := string(cchatmodel.ProtocolOpenAI) + "/" + conf.Global.OpenAPI.ModelModel
+	if userID > 0 && r.usage != nil {
+		if err := r.usage.CheckQuota(ctx, userID, feature); err != nil {
+			return nil, name, err
+		}
+	}
+
+	model, err := chatmodel.ChatModelDefaultFactory.CreateChatModel(ctx, cchatmodel.ProtocolOpenAI, &cchatmodel.Config{
+		APIKey:  conf.Global.OpenAPI.ModelAPIKey,
+		BaseURL: conf.Global.OpenAPI.ModelBaseURL,
+		Model:   conf.Global.OpenAPI.ModelModel,
+	})
+	if err != nil {
+		return nil, name, err
+	}
+	return r.withUsage(model, userID, feature, name, true), name, nil
+}
+
+// withUsage 记录模型每次调用的用量
+func (r *chatModelResolverImpl) withUsage(model cchatmodel.ToolCallingChatModel, userID int64, feature userEntity.UsageFeature, name string, sharedKey bool) cchatmodel.ToolCallingChatModel {
+	if r.usage == nil || userID <= 0 {
+		return model
+	}
+
+	return newUsageChatModel(model, r.usage, userEntity.TokenUsage{
+		UserID:    userID,
+		Feature:   feature,
+		Model:     name,
+		SharedKey: sharedKey,
+	})
+}
+
+// BuildChatModelConfig 将用户模型配置映射为 chatmodel.Config
+// configJSON 为协议相关的额外配置（如 ark、claude 字段），defaultParams 为 temperature、max_tokens 等默认参数，两者均按 chatmodel.Config 的 json 字段解析
+func BuildChatModelConfig(modelKey, baseURL, apiKey, configJSON, defaultParams string) (*cchatmodel.Config, error) {
+	config := &cchatmodel.Config{}
+
+	if configJSON != "" {
+		if err := json.Unmarshal([]byte(configJSON), config); err != nil {
+			return nil, fmt.Errorf("unmarshal config_json failed: %w", err)
+		}
+	}
+
+	if defaultParams != "" {
+		if err := json.Unmarshal([]byte(defaultParams), config); err != nil {
+			return nil, fmt.Errorf("unmarshal default_params failed: %w", err)
+		}
+	}
+
+	// 以表字段为准，避免被额外配置覆盖
+	config.Model = modelKey
+	config.BaseURL = baseURL
+	config.APIKey = apiKey
+
+	return config, nil
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mianshiba/domain/agent/agent/evaluator"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/repository"
+	userEntity "mianshiba/domain/user/entity"
+	mjson "mianshiba/pkg/json"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+)
+
+// EvaluationResult 面试评估结果
+type EvaluationResult struct {
+	AnswerScores []struct {
+		TurnNo        int32  `json:"turn_no"`
+		Correctness   int32  `json:"correctness"`
+		Depth         int32  `json:"depth"`
+		Communication int32  `json:"communication"`
+		Relevance     int32  `json:"relevance"`
+		Comment       string `json:"comment"`
+	} `json:"answer_scores"`
+	OverallScore     int32    `json:"overall_score"`
+	Summary          string   `json:"summary"`
+	Strengths        []string `json:"strengths"`
+	Gaps             []string `json:"gaps"`
+	StudySuggestions []string `json:"study_suggestions"`
+}
+
+type EvaluateInterviewRequest struct {
+	SessionID int64 // 会话ID
+	UserID    int64 // 用户ID
+}
+
+type EvaluatorAgentComponents struct {
+	SessionRepo    repository.InterviewSessionRepository
+	EvaluationRepo repository.InterviewEvaluationRepository
+	ModelResolver  ChatModelResolver
+}
+
+type EvaluatorAgent interface {
+	EvaluateAndSave(ctx context.Context, req *EvaluateInterviewRequest) error
+}
+
+func NewEvaluatorAgent(components *EvaluatorAgentComponents) EvaluatorAgent {
+	return &evaluatorAgentImpl{
+		EvaluatorAgentComponents: components,
+	}
+}
+
+type evaluatorAgentImpl struct {
+	*EvaluatorAgentComponents
+}
+
+// EvaluateAndSave 调用评估智能体对整场面试打分，并将评估报告保存到数据库；失败时记录失败原因
+func (e *evaluatorAgentImpl) EvaluateAndSave(ctx context.Context, req *EvaluateInterviewRequest) error {
+	evaluation, exist, err := e.EvaluationRepo.GetEvaluationBySessionID(ctx, req.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		log.Printf("[EvaluateAndSave] 评估记录不存在，会话ID: %d", req.SessionID)
+		return fmt.Errorf("evaluation of session %d not found", req.SessionID)
+	}
+
+	// 消息重复投递时不重复评估
+	if evaluation.Status == dal.EvaluationStatusFinished {
+		log.Printf("[EvaluateAndSave] 评估已完成，跳过，会话ID: %d", req.SessionID)
+		return nil
+	}
+
+	err = e.evaluate(ctx, req)
+	if err != nil {
+		if failErr := e.EvaluationRepo.FailEvaluation(ctx, req.SessionID, truncate(err.Error(), 1024)); failErr != nil {
+			log.Printf("[EvaluateAndSave] 记录评估失败原因失败: %v", failErr)
+		}
+		return err
+	}
+
+	log.Printf("[EvaluateAndSave] 面试评估成功，会话ID: %d", req.SessionID)
+	return nil
+}
+
+func (e *evaluatorAgentImpl) evaluate(ctx context.Context, req *EvaluateInterviewRequest) error {
+	session, exist, err := e.SessionRepo.GetSessionByID(ctx, req.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return fmt.Errorf("interview session %d not found", req.SessionID)
+	}
+
+	turns, err := e.SessionRepo.ListTurns(ctx, req.SessionID)
+	if err != nil {
+		return err
+	}
+
+	answeredTurns := make([]*model.InterviewTurn, 0, len(turns))
+	for _, turn := range turns {
+		if turn.Status == dal.TurnStatusAnswered {
+			answeredTurns = append(answeredTurns, turn)
+		}
+	}
+
+	// 没有任何作答时无需调用智能体
+	if len(answeredTurns) == 0 {
+		return e.EvaluationRepo.SaveEvaluationResult(ctx, req.SessionID, &model.InterviewEvaluation{
+			Summary:     "本场面试没有作答记录，无法评估",
+			Strengths:   []string{},
+			Gaps:        []string{},
+			Suggestions: []string{},
+			EvaluatedAt: time.Now().UnixMilli(),
+		}, nil)
+	}
+
+	result, err := e.runEvaluator(ctx, session, answeredTurns)
+	if err != nil {
+		return err
+	}
+
+	turnIDs := make(map[int32]int64, len(answeredTurns))
+	for _, turn := range answeredTurns {
+		turnIDs[turn.TurnNo] = turn.ID
+	}
+
+	scores := make([]*model.InterviewAnswerScore, 0, len(result.AnswerScores))
+	for _, s := range result.AnswerScores {
+		turnID, ok := turnIDs[s.TurnNo]
+		if !ok {
+			log.Printf("[EvaluateAndSave] 忽略不存在的轮次评分，会话ID: %d，轮次: %d", req.SessionID, s.TurnNo)
+			continue
+		}
+		delete(turnIDs, s.TurnNo)
+
+		scores = append(scores, &model.InterviewAnswerScore{
+			SessionID:     req.SessionID,
+			TurnID:        turnID,
+			TurnNo:        s.TurnNo,
+			Correctness:   clampScore(s.Correctness, 10),
+			Depth:         clampScore(s.Depth, 10),
+			Communication: clampScore(s.Communication, 10),
+			Relevance:     clampScore(s.Relevance, 10),
+			Comment:       s.Comment,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		})
+	}
+
+	return e.EvaluationRepo.SaveEvaluationResult(ctx, req.SessionID, &model.InterviewEvaluation{
+		OverallScore: clampScore(result.OverallScore, 100),
+		Summary:      result.Summary,
+		Strengths:    result.Strengths,
+		Gaps:         result.Gaps,
+		Suggestions:  result.StudySuggestions,
+		EvaluatedAt:  time.Now().UnixMilli(),
+	}, scores)
+}
+
+func (e *evaluatorAgentImpl) runEvaluator(ctx context.Context, session *model.InterviewSession, turns []*model.InterviewTurn) (*EvaluationResult, error) {
+	// 添加 120 秒超时
+	timeoutCtx, cancel := context.WithTimeout(ctx, 120*time.Second)
+	defer cancel()
+
+	model, err := e.ModelResolver.Resolve(timeoutCtx, session.UserID, userEntity.UsageFeatureEvaluation)
+	if err != nil {
+		log.Printf("[EvaluateAndSave] 创建对话模型失败: %v", err)
+		return nil, err
+	}
+
+	agent, err := evaluator.NewEvaluatorAgent(timeoutCtx, model)
+	if err != nil {
+		log.Printf("[EvaluateAndSave] 创建评估智能体失败: %v", err)
+		return nil, err
+	}
+
+	runner := adk.NewRunner(timeoutCtx, adk.RunnerConfig{
+		Agent: agent,
+	})
+
+	iter := runner.Run(timeoutCtx, []adk.Message{
+		schema.UserMessage(buildEvaluationQuery(session, turns)),
+	})
+
+	var lastMessage string
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if event.Err != nil {
+			log.Printf("[EvaluateAndSave] 错误: %v", event.Err)
+			return nil, fmt.Errorf("error during interview evaluation: %w", event.Err)
+		}
+
+		if event.Output != nil && event.Output.MessageOutput != nil {
+			lastMessage = event.Output.MessageOutput.Message.Content
+		}
+	}
+
+	if lastMessage == "" {
+		log.Printf("[EvaluateAndSave] 智能体未返回任何响应")
+		return nil, fmt.Errorf("agent returned empty response")
+	}
+
+	result := parseEvaluationResponse(lastMessage)
+	if result == nil {
+		log.Printf("[EvaluateAndSave] 无法解析评估响应: %s", lastMessage)
+		return nil, fmt.Errorf("failed to parse evaluation response")
+	}
+
+	return result, nil
+}
+
+// buildEvaluationQuery 将会话信息和问答记录组装为评估输入
+func buildEvaluationQuery(session *model.InterviewSession, turns []*model.InterviewTurn) string {
+	var sb strings.Builder
+	sb.WriteString("请评估以下模拟面试。\n\n")
+	fmt.Fprintf(&sb, "面试难度：%s\n", session.Difficulty)
+	fmt.Fprintf(&sb, "候选人技术栈：%s\n", strings.Join(session.TechStack, "、"))
+	fmt.Fprintf(&sb, "面试关注领域：%s\n\n", strings.Join(session.FocusAreas, "、"))
+	sb.WriteString("问答记录：\n")
+
+	for _, turn := range turns {
+		fmt.Fprintf(&sb, "\n【第 %d 轮】\n问题：%s\n回答：%s\n", turn.TurnNo, turn.Question, turn.Answer)
+	}
+
+	return sb.String()
+}
+
+// parseEvaluationResponse 从智能体响应解析评估结果
+func parseEvaluationResponse(agentResponse string) *EvaluationResult {
+	result := &EvaluationResult{}
+
+	if err := json.Unmarshal([]byte(agentResponse), result); err != nil {
+		jsonStr := mjson.ExtractJSONFromResponse(agentResponse)
+		if jsonStr == "" {
+			return nil
+		}
+
+		if err := json.Unmarshal([]byte(jsonStr), result); err != nil {
+			log.Printf("[parseEvaluationResponse] 解析提取的 JSON 失败: %v", err)
+			return nil
+		}
+	}
+
+	return result
+}
+
+func clampScore(score, limit int32) int32 {
+	if score < 0 {
+		return 0
+	}
+	if score > limit {
+		return limit
+	}
+	return score
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
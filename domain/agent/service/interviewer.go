@@ -0,0 +1,471 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mianshiba/domain/agent/agent/interviewer"
+	agentTool "mianshiba/domain/agent/tool"
+	userEntity "mianshiba/domain/user/entity"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+)
+
+// personaKeyPattern 面试官角色 Key 的格式，Key 会作为智能体名称的一部分
+var personaKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// InterviewQA 已完成的一轮问答
+type InterviewQA struct {
+	Question string // 面试官提问
+	Answer   string // 候选人回答
+	Persona  string // 提问的面试官角色，未区分角色时为空
+}
+
+// InterviewTargetJob 面试针对的目标岗位，来自岗位描述解析结果
+type InterviewTargetJob struct {
+	Title            string   // 岗位名称
+	Seniority        string   // 岗位职级
+	RequiredSkills   []string // 必备技能
+	PreferredSkills  []string // 加分技能
+	Responsibilities []string // 岗位职责
+	MissingSkills    []string // 简历中未体现的必备技能
+}
+
+// InterviewRound 面试计划中的一个环节及其进度
+type InterviewRound struct {
+	RoundNo         int32  // 环节序号（从1开始）
+	Type            string // 环节类型
+	Name            string // 环节名称
+	Persona         string // 面试官角色
+	Difficulty      string // 环节难度，为空时使用面试难度
+	QuestionCount   int32  // 环节提问数
+	DurationMinutes int32  // 环节时长（分钟），0表示不限时
+	AskedCount      int32  // 本环节已提问数
+	StartedAt       int64  // 开始时间（毫秒时间戳），0表示尚未开始
+	Finished        bool   // 是否已标记为完成
+}
+
+// InterviewPersona 面试官角色定义
+type InterviewPersona struct {
+	Key         string   // 角色标识，只包含小写字母、数字和下划线
+	Name        string   // 角色名称
+	Instruction string   // 角色身份和考察重点
+	FocusAreas  []string // 关注领域
+	Tone        string   // 提问语气
+}
+
+// InterviewQuestion 面试官生成的问题
+type InterviewQuestion struct {
+	Content  string // 问题内容
+	RoundNo  int32  // 问题所属的面试环节序号，不使用面试计划时为0
+	Persona  string // 提问的面试官角色，使用面试计划或面试官小组时有值
+	Finished bool   // 面试计划的所有环节均已结束，此时不生成问题
+}
+
+type AskQuestionRequest struct {
+	UserID             int64               // 用户ID，用于选择用户配置的模型
+	Difficulty         string              // 推荐面试难度
+	FocusAreas         []string            // 面试关注领域
+	QuestionDirections []string            // 建议的提问方向
+	TechStack          []string            // 候选人技术栈
+	Projects           []string            // 候选人项目经历摘要，用于检索题库
+	TargetJob          *InterviewTargetJob // 目标岗位，为空时不针对具体岗位
+	MaxTurns           int32               // 最大提问轮次
+	Rounds             []*InterviewRound   // 面试计划的环节，为空时不使用面试计划
+	Panel              []string            // 面试官小组成员的角色，为空时不使用面试官小组；与面试计划同时指定时按面试计划提问
+	History            []*InterviewQA      // 历史问答，按轮次顺序
+}
+
+type InterviewerAgent interface {
+	AskQuestion(ctx context.Context, req *AskQuestionRequest) (question *InterviewQuestion, err error)
+	StreamQuestion(ctx context.Context, req *AskQuestionRequest, onDelta func(delta string) error) (question *InterviewQuestion, err error)
+	// ListPersonas 可用的面试官角色：内置角色在前（已按配置覆盖），之后为配置新增的角色
+	ListPersonas(ctx context.Context) []*InterviewPersona
+}
+
+type InterviewerAgentComponents struct {
+	ModelResolver     ChatModelResolver
+	QuestionRetriever retriever.Retriever // 题库检索，为空时不向面试官提供题库工具
+	QuestionTopK      int                 // 每次检索返回的候选题目数，不大于 0 时为 5
+	Personas          []*InterviewPersona // 配置的面试官角色，按 Key 覆盖内置角色或新增角色
+}
+
+func NewInterviewerAgent(components *InterviewerAgentComponents) InterviewerAgent {
+	return &interviewerAgentImpl{
+		InterviewerAgentComponents: components,
+		personas:                   mergePersonas(components.Personas),
+	}
+}
+
+type interviewerAgentImpl struct {
+	*InterviewerAgentComponents
+	personas []*interviewer.Persona
+}
+
+func (i *interviewerAgentImpl) ListPersonas(ctx context.Context) []*InterviewPersona {
+	personas := make([]*InterviewPersona, 0, len(i.personas))
+	for _, p := range i.personas {
+		personas = append(personas, &InterviewPersona{
+			Key:         p.Key,
+			Name:        p.Name,
+			Instruction: p.Instruction,
+			FocusAreas:  p.FocusAreas,
+			Tone:        p.Tone,
+		})
+	}
+	return personas
+}
+
+// persona 按 Key 查找面试官角色，不存在时返回 nil
+func (i *interviewerAgentImpl) persona(key string) *interviewer.Persona {
+	for _, p := range i.personas {
+		if p.Key == key {
+			return p
+		}
+	}
+	return nil
+}
+
+// AskQuestion 根据简历信息和历史问答，让面试官智能体生成下一个问题；使用面试计划时由编排器决定当前环节
+func (i *interviewerAgentImpl) AskQuestion(ctx context.Context, req *AskQuestionRequest) (question *InterviewQuestion, err error) {
+	return i.runInterviewer(ctx, req, nil)
+}
+
+// StreamQuestion 流式生成下一个问题，每收到一段增量内容回调一次 onDelta；onDelta 返回错误时中止生成
+func (i *interviewerAgentImpl) StreamQuestion(ctx context.Context, req *AskQuestionRequest, onDelta func(delta string) error) (question *InterviewQuestion, err error) {
+	return i.runInterviewer(ctx, req, onDelta)
+}
+
+func (i *interviewerAgentImpl) runInterviewer(ctx context.Context, req *AskQuestionRequest, onDelta func(delta string) error) (question *InterviewQuestion, err error) {
+	// 添加 60 秒超时
+	timeoutCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	model, err := i.ModelResolver.Resolve(timeoutCtx, req.UserID, userEntity.UsageFeatureInterview)
+	if err != nil {
+		log.Printf("[AskQuestion] 创建对话模型失败: %v", err)
+		return nil, err
+	}
+
+	seed := &interviewer.InterviewerSeed{
+		Difficulty:         req.Difficulty,
+		FocusAreas:         req.FocusAreas,
+		QuestionDirections: req.QuestionDirections,
+		TechStack:          req.TechStack,
+		MaxTurns:           req.MaxTurns,
+	}
+	profile := &agentTool.CandidateProfile{
+		TechStack:  req.TechStack,
+		Projects:   req.Projects,
+		FocusAreas: req.FocusAreas,
+	}
+	if job := req.TargetJob; job != nil {
+		seed.TargetJob = &interviewer.TargetJob{
+			Title:            job.Title,
+			Seniority:        job.Seniority,
+			RequiredSkills:   job.RequiredSkills,
+			PreferredSkills:  job.PreferredSkills,
+			Responsibilities: job.Responsibilities,
+			MissingSkills:    job.MissingSkills,
+		}
+		profile.JobSkills = job.RequiredSkills
+	}
+
+	var tools []tool.BaseTool
+	if i.QuestionRetriever != nil {
+		questionBank, err := agentTool.NewQuestionBankTool(i.QuestionRetriever, profile, i.QuestionTopK)
+		if err != nil {
+			log.Printf("[AskQuestion] 创建题库检索工具失败: %v", err)
+			return nil, err
+		}
+		tools = append(tools, questionBank)
+	}
+
+	agent, speakers, err := i.newInterviewer(timeoutCtx, model, seed, req, tools)
+	if err != nil {
+		log.Printf("[AskQuestion] 创建面试官智能体失败: %v", err)
+		return nil, err
+	}
+
+	runner := adk.NewRunner(timeoutCtx, adk.RunnerConfig{
+		Agent:           agent,
+		EnableStreaming: onDelta != nil,
+	})
+
+	iter := runner.Run(timeoutCtx, buildInterviewMessages(req.History), adk.WithSkipTransferMessages())
+
+	question = &InterviewQuestion{}
+	var lastMessage string
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if event.Err != nil {
+			log.Printf("[AskQuestion] 错误: %v", event.Err)
+			return nil, fmt.Errorf("error during interviewer asking: %w", event.Err)
+		}
+
+		if event.Output == nil {
+			continue
+		}
+
+		// 面试计划编排器选择的环节
+		if decision, ok := event.Output.CustomizedOutput.(*interviewer.RoundDecision); ok {
+			if decision.Round == nil {
+				question.Finished = true
+			} else {
+				question.RoundNo = decision.Round.RoundNo
+				question.Persona = decision.Round.Persona
+			}
+			continue
+		}
+
+		if event.Output.MessageOutput == nil {
+			continue
+		}
+
+		// 题库检索工具的结果只给模型参考，面试官小组主持人只负责转交发言权
+		output := event.Output.MessageOutput
+		if output.Role == schema.Tool || event.AgentName == interviewer.PanelModeratorName {
+			if output.IsStreaming {
+				output.MessageStream.Close()
+			}
+			continue
+		}
+		message := output.Message
+		if output.IsStreaming {
+			message, err = drainMessageStream(output.MessageStream, onDelta)
+			if err != nil {
+				log.Printf("[AskQuestion] 读取流式响应失败: %v", err)
+				return nil, err
+			}
+		}
+		// 转交发言权和检索题库的消息带有工具调用，其中的内容不作为问题
+		if len(message.ToolCalls) == 0 {
+			lastMessage = message.Content
+		}
+		if persona, ok := speakers[event.AgentName]; ok {
+			question.Persona = persona
+		}
+	}
+
+	if question.Finished {
+		log.Printf("[AskQuestion] 面试计划的所有环节均已结束")
+		return question, nil
+	}
+
+	question.Content = strings.TrimSpace(lastMessage)
+	if question.Content == "" {
+		log.Printf("[AskQuestion] 智能体未返回任何问题")
+		return nil, fmt.Errorf("agent returned empty question")
+	}
+
+	return question, nil
+}
+
+// newInterviewer 创建面试官智能体，返回智能体及面试官小组成员名称到角色的映射
+// 使用面试计划时为每个环节创建一个面试官，由编排器按环节进度转交；
+// 使用面试官小组时为每个角色创建一个面试官，由主持人决定下一位发言的面试官
+func (i *interviewerAgentImpl) newInterviewer(ctx context.Context, model cchatmodel.ToolCallingChatModel, seed *interviewer.InterviewerSeed, req *AskQuestionRequest, tools []tool.BaseTool) (adk.Agent, map[string]string, error) {
+	switch {
+	case len(req.Rounds) > 0:
+		agent, err := i.newPlanInterviewer(ctx, model, seed, req.Rounds, tools)
+		return agent, nil, err
+	case len(req.Panel) > 0:
+		return i.newPanelInterviewer(ctx, model, seed, req, tools)
+	}
+
+	agent, err := interviewer.NewInterviewerAgent(ctx, model, seed, tools...)
+	return agent, nil, err
+}
+
+func (i *interviewerAgentImpl) newPlanInterviewer(ctx context.Context, model cchatmodel.ToolCallingChatModel, seed *interviewer.InterviewerSeed, rounds []*InterviewRound, tools []tool.BaseTool) (adk.Agent, error) {
+	states := make([]*interviewer.RoundState, 0, len(rounds))
+	roundAgents := make([]adk.Agent, 0, len(rounds))
+	for _, round := range rounds {
+		state := &interviewer.RoundState{
+			RoundNo:         round.RoundNo,
+			Type:            round.Type,
+			Name:            round.Name,
+			Persona:         round.Persona,
+			QuestionCount:   round.QuestionCount,
+			DurationMinutes: round.DurationMinutes,
+			AskedCount:      round.AskedCount,
+			Finished:        round.Finished,
+		}
+		if round.StartedAt > 0 {
+			state.StartedAt = time.UnixMilli(round.StartedAt)
+		}
+
+		roundSeed := *seed
+		roundSeed.Round = state
+		roundSeed.TotalRounds = int32(len(rounds))
+		roundSeed.Persona = i.persona(round.Persona)
+		if round.Difficulty != "" {
+			roundSeed.Difficulty = round.Difficulty
+		}
+
+		agent, err := interviewer.NewInterviewerAgent(ctx, model, &roundSeed, tools...)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+		roundAgents = append(roundAgents, agent)
+	}
+
+	return interviewer.NewPlanOrchestrator(ctx, states, roundAgents)
+}
+
+func (i *interviewerAgentImpl) newPanelInterviewer(ctx context.Context, model cchatmodel.ToolCallingChatModel, seed *interviewer.InterviewerSeed, req *AskQuestionRequest, tools []tool.BaseTool) (adk.Agent, map[string]string, error) {
+	personas := make([]*interviewer.Persona, 0, len(req.Panel))
+	for _, key := range req.Panel {
+		persona := i.persona(key)
+		if persona == nil {
+			return nil, nil, fmt.Errorf("unknown interviewer persona: %s", key)
+		}
+		personas = append(personas, persona)
+	}
+
+	speakers := make(map[string]string, len(personas))
+	panelists := make([]adk.Agent, 0, len(personas))
+	for _, persona := range personas {
+		panelistSeed := *seed
+		panelistSeed.Persona = persona
+		panelistSeed.Panel = personas
+
+		agent, err := interviewer.NewInterviewerAgent(ctx, model, &panelistSeed, tools...)
+		if err != nil {
+			return nil, nil, err
+		}
+		speakers[interviewer.PanelistAgentName(persona.Key)] = persona.Key
+		panelists = append(panelists, agent)
+	}
+
+	progress := &interviewer.PanelProgress{
+		TurnCount:   int32(len(req.History)),
+		MaxTurns:    req.MaxTurns,
+		AskedCounts: make(map[string]int32, len(personas)),
+	}
+	for _, qa := range req.History {
+		progress.AskedCounts[qa.Persona]++
+		progress.LastSpeaker = qa.Persona
+	}
+
+	panel, err := interviewer.NewInterviewPanel(ctx, model, personas, progress, panelists)
+	if err != nil {
+		return nil, nil, err
+	}
+	return panel, speakers, nil
+}
+
+// mergePersonas 按 Key 用配置覆盖内置面试官角色，未出现在内置角色中的配置作为新增角色；Key 不合法的配置会被忽略
+func mergePersonas(configured []*InterviewPersona) []*interviewer.Persona {
+	personas := make([]*interviewer.Persona, 0, len(interviewer.DefaultPersonas)+len(configured))
+	for _, p := range interviewer.DefaultPersonas {
+		copied := *p
+		personas = append(personas, &copied)
+	}
+
+	for _, c := range configured {
+		if c == nil || !personaKeyPattern.MatchString(c.Key) {
+			log.Printf("[NewInterviewerAgent] 忽略不合法的面试官角色配置: %+v", c)
+			continue
+		}
+
+		persona := &interviewer.Persona{
+			Key:         c.Key,
+			Name:        c.Name,
+			Instruction: c.Instruction,
+			FocusAreas:  c.FocusAreas,
+			Tone:        c.Tone,
+		}
+		idx := slices.IndexFunc(personas, func(p *interviewer.Persona) bool { return p.Key == c.Key })
+		if idx < 0 {
+			if persona.Name == "" {
+				persona.Name = persona.Key
+			}
+			personas = append(personas, persona)
+			continue
+		}
+
+		// 未配置的字段沿用内置角色
+		base := personas[idx]
+		if persona.Name == "" {
+			persona.Name = base.Name
+		}
+		if persona.Instruction == "" {
+			persona.Instruction = base.Instruction
+		}
+		if len(persona.FocusAreas) == 0 {
+			persona.FocusAreas = base.FocusAreas
+		}
+		if persona.Tone == "" {
+			persona.Tone = base.Tone
+		}
+		personas[idx] = persona
+	}
+
+	return personas
+}
+
+// drainMessageStream 读取流式消息，内容增量到达时即交给 onDelta；
+// 转交发言权和检索题库的工具调用出现在消息开头，一旦出现工具调用即停止推送，其中的内容不作为问题
+func drainMessageStream(stream adk.MessageStream, onDelta func(delta string) error) (adk.Message, error) {
+	defer stream.Close()
+
+	var chunks []adk.Message
+	forward := onDelta != nil
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// 工具调用的参数分散在多个增量中，合并后才是完整的调用
+		chunks = append(chunks, chunk)
+
+		if len(chunk.ToolCalls) > 0 {
+			forward = false
+		}
+		if !forward || chunk.Content == "" {
+			continue
+		}
+		if err = onDelta(chunk.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(chunks) == 0 {
+		return schema.AssistantMessage("", nil), nil
+	}
+
+	return schema.ConcatMessages(chunks)
+}
+
+// buildInterviewMessages 将历史问答还原为对话消息，用于会话恢复后继续提问
+func buildInterviewMessages(history []*InterviewQA) []adk.Message {
+	messages := make([]adk.Message, 0, len(history)*2+1)
+	messages = append(messages, schema.UserMessage("面试开始，请提出第一个问题。"))
+
+	for _, qa := range history {
+		messages = append(messages, schema.AssistantMessage(qa.Question, nil))
+		messages = append(messages, schema.UserMessage(qa.Answer))
+	}
+
+	return messages
+}
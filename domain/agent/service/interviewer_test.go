@@ -0,0 +1,98 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"mianshiba/domain/agent/agent/interviewer"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePersonas(t *testing.T) {
+	personas := mergePersonas([]*InterviewPersona{
+		{Key: "hr", Tone: "严肃"},
+		{Key: "pm", Name: "产品经理", FocusAreas: []string{"需求理解"}},
+		{Key: "Bad Key", Name: "非法角色"},
+	})
+
+	keys := make([]string, 0, len(personas))
+	for _, p := range personas {
+		keys = append(keys, p.Key)
+	}
+	assert.Equal(t, []string{"interviewer", "tech_lead", "architect", "hr", "pm"}, keys)
+
+	// 覆盖内置角色时未配置的字段沿用内置角色
+	hr := personas[3]
+	assert.Equal(t, "HR", hr.Name)
+	assert.Equal(t, "严肃", hr.Tone)
+	assert.Equal(t, interviewer.DefaultPersonas[3].FocusAreas, hr.FocusAreas)
+	// 内置角色本身不被修改
+	assert.NotEqual(t, "严肃", interviewer.DefaultPersonas[3].Tone)
+
+	assert.Equal(t, "产品经理", personas[4].Name)
+	assert.Equal(t, []string{"需求理解"}, personas[4].FocusAreas)
+}
+
+func TestDrainMessageStream(t *testing.T) {
+	stream := func(chunks ...*schema.Message) *schema.StreamReader[*schema.Message] {
+		return schema.StreamReaderFromArray(chunks)
+	}
+	index := 0
+
+	var deltas []string
+	onDelta := func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	}
+
+	// 工具调用出现后不再推送
+	message, err := drainMessageStream(stream(
+		schema.AssistantMessage("", []schema.ToolCall{{Index: &index, ID: "1", Function: schema.FunctionCall{Name: "search_question_bank", Arguments: `{"query":`}}}),
+		schema.AssistantMessage("我先查一下题库。", []schema.ToolCall{{Index: &index, Function: schema.FunctionCall{Arguments: `"channel"}`}}}),
+	), onDelta)
+	assert.NoError(t, err)
+	assert.Len(t, message.ToolCalls, 1)
+	assert.Equal(t, `{"query":"channel"}`, message.ToolCalls[0].Function.Arguments)
+	assert.Empty(t, deltas)
+
+	// 问题按增量推送，推送内容与返回的完整内容一致
+	message, err = drainMessageStream(stream(
+		schema.AssistantMessage("请介绍一下", nil),
+		schema.AssistantMessage("你的项目。", nil),
+	), onDelta)
+	assert.NoError(t, err)
+	assert.Empty(t, message.ToolCalls)
+	assert.Equal(t, "请介绍一下你的项目。", message.Content)
+	assert.Equal(t, []string{"请介绍一下", "你的项目。"}, deltas)
+}
+
+func TestDrainMessageStreamForwardsBeforeEOF(t *testing.T) {
+	reader, writer := schema.Pipe[*schema.Message](0)
+
+	received := make(chan string, 2)
+	done := make(chan string, 1)
+	go func() {
+		message, err := drainMessageStream(reader, func(delta string) error {
+			received <- delta
+			return nil
+		})
+		assert.NoError(t, err)
+		done <- message.Content
+	}()
+
+	// 流尚未结束时，已到达的增量即推送
+	writer.Send(schema.AssistantMessage("请介绍一下", nil), nil)
+	select {
+	case delta := <-received:
+		assert.Equal(t, "请介绍一下", delta)
+	case <-time.After(time.Second):
+		t.Fatal("delta was not forwarded before the stream ended")
+	}
+
+	writer.Send(schema.AssistantMessage("你的项目。", nil), nil)
+	writer.Close()
+	assert.Equal(t, "请介绍一下你的项目。", <-done)
+	assert.Equal(t, "你的项目。", <-received)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mianshiba/domain/agent/agent/jobdescription"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/repository"
+	userEntity "mianshiba/domain/user/entity"
+	mjson "mianshiba/pkg/json"
+	"time"
+
+	"github.com/cloudwego/eino/adk"
+	"github.com/cloudwego/eino/schema"
+)
+
+// JobDescriptionParseResult 岗位描述解析结果
+type JobDescriptionParseResult struct {
+	Title            string   `json:"title"`
+	Company          string   `json:"company"`
+	Seniority        string   `json:"seniority"`
+	MinWorkYears     int32    `json:"min_work_years"`
+	RequiredSkills   []string `json:"required_skills"`
+	PreferredSkills  []string `json:"preferred_skills"`
+	Responsibilities []string `json:"responsibilities"`
+	Keywords         []string `json:"keywords"`
+}
+
+type ParseJobDescriptionRequest struct {
+	ID     int64 // 岗位描述ID
+	UserID int64 // 用户ID
+}
+
+type JobDescriptionAgentComponents struct {
+	JDRepo        repository.JobDescriptionRepository
+	ModelResolver ChatModelResolver
+}
+
+type JobDescriptionAgent interface {
+	// ParseAndSave 解析岗位描述并保存结果；失败时记录失败原因
+	ParseAndSave(ctx context.Context, req *ParseJobDescriptionRequest) error
+}
+
+func NewJobDescriptionAgent(components *JobDescriptionAgentComponents) JobDescriptionAgent {
+	return &jobDescriptionAgentImpl{
+		JobDescriptionAgentComponents: components,
+	}
+}
+
+type jobDescriptionAgentImpl struct {
+	*JobDescriptionAgentComponents
+}
+
+func (j *jobDescriptionAgentImpl) ParseAndSave(ctx context.Context, req *ParseJobDescriptionRequest) error {
+	jd, exist, err := j.JDRepo.GetJobDescriptionByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if !exist || jd.ParseStatus != dal.JobDescriptionParsing {
+		log.Printf("[ParseJobDescription] 岗位描述不存在或不在解析中，跳过，ID: %d", req.ID)
+		return nil
+	}
+
+	result, err := j.parse(ctx, jd)
+	if err != nil {
+		if _, failErr := j.JDRepo.FailJobDescriptionParse(ctx, req.ID, truncate(err.Error(), 1024)); failErr != nil {
+			log.Printf("[ParseJobDescription] 记录解析失败原因失败: %v", failErr)
+		}
+		return err
+	}
+
+	contentJSON, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job description: %w", err)
+	}
+
+	// 用户填写的岗位名称和公司名称优先
+	var title, company string
+	if jd.Title == "" {
+		title = truncate(result.Title, 255)
+	}
+	if jd.Company == "" {
+		company = truncate(result.Company, 255)
+	}
+
+	saved, err := j.JDRepo.CompleteJobDescriptionParse(ctx, req.ID, string(contentJSON), title, company)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		log.Printf("[ParseJobDescription] 岗位描述已删除或状态已变更，丢弃解析结果，ID: %d", req.ID)
+		return nil
+	}
+
+	log.Printf("[ParseJobDescription] 岗位描述解析成功，ID: %d", req.ID)
+	return nil
+}
+
+func (j *jobDescriptionAgentImpl) parse(ctx context.Context, jd *model.JobDescription) (*JobDescriptionParseResult, error) {
+	// 添加 120 秒超时
+	timeoutCtx, cancel := context.WithTimeout(ctx, 120*time.Second)
+	defer cancel()
+
+	model, err := j.ModelResolver.Resolve(timeoutCtx, jd.UserID, userEntity.UsageFeatureJDParse)
+	if err != nil {
+		log.Printf("[ParseJobDescription] 创建对话模型失败: %v", err)
+		return nil, err
+	}
+
+	agent, err := jobdescription.NewJobDescriptionParserAgent(timeoutCtx, model)
+	if err != nil {
+		log.Printf("[ParseJobDescription] 创建岗位描述解析智能体失败: %v", err)
+		return nil, err
+	}
+
+	runner := adk.NewRunner(timeoutCtx, adk.RunnerConfig{
+		Agent: agent,
+	})
+
+	iter := runner.Run(timeoutCtx, []adk.Message{
+		schema.UserMessage("请解析以下岗位描述，返回 JSON 格式结果。\n\n岗位描述：\n" + jd.Content),
+	})
+
+	var lastMessage string
+	for {
+		event, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if event.Err != nil {
+			log.Printf("[ParseJobDescription] 错误: %v", event.Err)
+			return nil, fmt.Errorf("error during job description parsing: %w", event.Err)
+		}
+
+		if event.Output != nil && event.Output.MessageOutput != nil {
+			lastMessage = event.Output.MessageOutput.Message.Content
+		}
+	}
+
+	if lastMessage == "" {
+		log.Printf("[ParseJobDescription] 智能体未返回任何响应")
+		return nil, fmt.Errorf("agent returned empty response")
+	}
+
+	result := parseJobDescriptionResponse(lastMessage)
+	if result == nil {
+		log.Printf("[ParseJobDescription] 无法解析岗位描述响应: %s", lastMessage)
+		return nil, fmt.Errorf("failed to parse job description response")
+	}
+
+	if len(result.RequiredSkills) == 0 && len(result.PreferredSkills) == 0 && len(result.Responsibilities) == 0 {
+		return nil, fmt.Errorf("no skills or responsibilities found in job description")
+	}
+
+	return result, nil
+}
+
+// parseJobDescriptionResponse 从智能体响应解析岗位描述数据
+func parseJobDescriptionResponse(agentResponse string) *JobDescriptionParseResult {
+	result := &JobDescriptionParseResult{}
+
+	if err := json.Unmarshal([]byte(agentResponse), result); err != nil {
+		jsonStr := mjson.ExtractJSONFromResponse(agentResponse)
+		if jsonStr == "" {
+			return nil
+		}
+
+		if err := json.Unmarshal([]byte(jsonStr), result); err != nil {
+			log.Printf("[parseJobDescriptionResponse] 解析提取的 JSON 失败: %v", err)
+			return nil
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 匹配度中各部分的权重，岗位描述中缺少的部分不参与计算
+const (
+	requiredSkillWeight  = 70
+	preferredSkillWeight = 20
+	keywordWeight        = 10
+)
+
+// skillAliases 常见技能的别名，比较前统一为同一写法
+var skillAliases = map[string]string{
+	"golang":     "go",
+	"k8s":        "kubernetes",
+	"js":         "javascript",
+	"ts":         "typescript",
+	"postgres":   "postgresql",
+	"pg":         "postgresql",
+	"mongo":      "mongodb",
+	"reactjs":    "react",
+	"react.js":   "react",
+	"vuejs":      "vue",
+	"vue.js":     "vue",
+	"nodejs":     "node",
+	"node.js":    "node",
+	"es":         "elasticsearch",
+	"springboot": "spring boot",
+}
+
+// JobMatchReport 简历与岗位描述的匹配报告
+type JobMatchReport struct {
+	FitScore               int32    // 匹配度（0-100）
+	CoveredSkills          []string // 简历中已体现的技能（必备和加分项）
+	MissingSkills          []string // 简历中未体现的必备技能
+	MissingPreferredSkills []string // 简历中未体现的加分技能
+	KeywordGaps            []string // 简历中未出现的岗位关键词
+}
+
+// MatchJobDescription 对比岗位描述和简历解析结果：技能出现在简历的技术栈、技能、项目或工作经历中即视为已覆盖
+func MatchJobDescription(jd *JobDescriptionParseResult, resume *ResumeParseResult) *JobMatchReport {
+	profile := newResumeTerms(resume)
+	report := &JobMatchReport{
+		CoveredSkills:          []string{},
+		MissingSkills:          []string{},
+		MissingPreferredSkills: []string{},
+		KeywordGaps:            []string{},
+	}
+
+	var score, weights float64
+	addPart := func(items []string, weight float64, missing *[]string, covered *[]string) {
+		items = dedupeSkills(items)
+		if len(items) == 0 {
+			return
+		}
+
+		hit := 0
+		for _, item := range items {
+			if profile.covers(item) {
+				hit++
+				if covered != nil {
+					*covered = append(*covered, item)
+				}
+			} else {
+				*missing = append(*missing, item)
+			}
+		}
+		score += weight * float64(hit) / float64(len(items))
+		weights += weight
+	}
+
+	addPart(jd.RequiredSkills, requiredSkillWeight, &report.MissingSkills, &report.CoveredSkills)
+	addPart(jd.PreferredSkills, preferredSkillWeight, &report.MissingPreferredSkills, &report.CoveredSkills)
+	addPart(jd.Keywords, keywordWeight, &report.KeywordGaps, nil)
+
+	if weights > 0 {
+		report.FitScore = int32(math.Round(score / weights * 100))
+	}
+	return report
+}
+
+// resumeTerms 简历中可用于匹配的技能集合和全文
+type resumeTerms struct {
+	skills map[string]bool
+	text   string
+}
+
+func newResumeTerms(resume *ResumeParseResult) *resumeTerms {
+	terms := &resumeTerms{skills: make(map[string]bool)}
+	var sb strings.Builder
+	add := func(items ...string) {
+		for _, item := range items {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			terms.skills[normalizeSkill(item)] = true
+			sb.WriteString(strings.ToLower(item))
+			sb.WriteString("\n")
+		}
+	}
+	write := func(texts ...string) {
+		for _, text := range texts {
+			sb.WriteString(strings.ToLower(text))
+			sb.WriteString("\n")
+		}
+	}
+
+	add(resume.TechStack...)
+	add(resume.Skills...)
+	add(resume.Certifications...)
+	for _, project := range resume.Projects {
+		add(project.TechStack...)
+		write(project.Name, project.Description, project.Contribution)
+	}
+	for _, work := range resume.WorkExperience {
+		write(work.Position, work.Responsibilities)
+	}
+	write(resume.Strengths)
+
+	terms.text = sb.String()
+	return terms
+}
+
+// covers 技能在简历的技能集合中，或以完整词的形式出现在简历文本中
+func (t *resumeTerms) covers(skill string) bool {
+	normalized := normalizeSkill(skill)
+	if t.skills[normalized] {
+		return true
+	}
+	return mentions(t.text, strings.ToLower(strings.TrimSpace(skill))) || mentions(t.text, normalized)
+}
+
+// normalizeSkill 统一大小写、空白和别名
+func normalizeSkill(skill string) string {
+	skill = strings.Join(strings.Fields(strings.ToLower(skill)), " ")
+	if alias, ok := skillAliases[strings.ReplaceAll(skill, " ", "")]; ok {
+		return alias
+	}
+	return skill
+}
+
+// dedupeSkills 去除空白项和重复项，保留首次出现的写法
+func dedupeSkills(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		key := normalizeSkill(item)
+		if item == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, item)
+	}
+	return result
+}
+
+// mentions text 中是否出现 term；term 以字母或数字开头（结尾）时，要求前（后）一个字符不是字母或数字，避免 go 匹配到 google
+func mentions(text, term string) bool {
+	if term == "" {
+		return false
+	}
+
+	for offset := 0; ; {
+		idx := strings.Index(text[offset:], term)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(term)
+
+		first, _ := utf8.DecodeRuneInString(term)
+		last, _ := utf8.DecodeLastRuneInString(term)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !(isWordRune(first) && isWordRune(before)) && !(isWordRune(last) && isWordRune(after)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+}
+
+// isWordRune 英文字母或数字，汉字之间不需要分隔
+func isWordRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
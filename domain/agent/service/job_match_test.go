@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchJobDescription(t *testing.T) {
+	jd := &JobDescriptionParseResult{
+		RequiredSkills:  []string{"Golang", "MySQL", "Redis", "Kubernetes"},
+		PreferredSkills: []string{"Kafka", "Rust"},
+		Keywords:        []string{"高并发", "支付"},
+	}
+	resume := &ResumeParseResult{
+		TechStack: []string{"Go", "MySQL"},
+		Skills:    []string{"Google Cloud"},
+		Projects: []ResumeProject{{
+			Name:        "订单系统",
+			Description: "支撑高并发下单，使用 Redis 缓存热点数据",
+			TechStack:   []string{"Kafka"},
+		}},
+	}
+
+	report := MatchJobDescription(jd, resume)
+	assert.Equal(t, []string{"Golang", "MySQL", "Redis", "Kafka"}, report.CoveredSkills)
+	assert.Equal(t, []string{"Kubernetes"}, report.MissingSkills)
+	assert.Equal(t, []string{"Rust"}, report.MissingPreferredSkills)
+	assert.Equal(t, []string{"支付"}, report.KeywordGaps)
+	// 70*3/4 + 20*1/2 + 10*1/2 = 67.5
+	assert.Equal(t, int32(68), report.FitScore)
+
+	// 只有必备技能时按必备技能计算
+	report = MatchJobDescription(&JobDescriptionParseResult{RequiredSkills: []string{"Go", "go", "Java"}}, resume)
+	assert.Equal(t, []string{"Java"}, report.MissingSkills)
+	assert.Equal(t, int32(50), report.FitScore)
+
+	// go 不应匹配到 google
+	report = MatchJobDescription(&JobDescriptionParseResult{RequiredSkills: []string{"go"}}, &ResumeParseResult{Skills: []string{"Google Cloud"}})
+	assert.Equal(t, []string{"go"}, report.MissingSkills)
+	assert.Equal(t, int32(0), report.FitScore)
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino-ext/components/model/deepseek"
+	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino/schema"
+)
+
+// 模型连通性测试的错误分类
+const (
+	ProbeErrorAuth          = "auth"            // 鉴权失败（密钥错误、无权限）
+	ProbeErrorNetwork       = "network"         // 网络错误（地址错误、连接失败、超时）
+	ProbeErrorModelNotFound = "model_not_found" // 模型不存在
+	ProbeErrorQuota         = "quota"           // 额度不足或触发限流
+	ProbeErrorUnknown       = "unknown"         // 其他错误
+)
+
+const DefaultProbeTimeout = 15 * time.Second // 单次探测请求的默认超时时间
+
+type ProbeModelRequest struct {
+	Protocol      string // 协议类型
+	ModelKey      string // 模型标识
+	BaseURL       string // API 基础地址
+	APIKey        string // 明文 API 密钥
+	ConfigJSON    string // 额外配置
+	DefaultParams string // 默认参数
+}
+
+type ProbeModelResult struct {
+	Success           bool   // 基础对话是否成功
+	LatencyMs         int64  // 基础对话耗时（毫秒）
+	SupportToolCall   bool   // 是否支持工具调用
+	SupportJSONFormat bool   // 是否支持 JSON 格式输出
+	ErrorType         string // 错误分类，成功时为空
+	ErrorMsg          string // 原始错误信息，成功时为空
+}
+
+// ModelProber 模型连通性测试：发送极短的探测请求，检查模型是否可用以及支持的能力
+type ModelProber interface {
+	Probe(ctx context.Context, req *ProbeModelRequest) (result *ProbeModelResult, err error)
+}
+
+func NewModelProber(factory cchatmodel.Factory, timeout time.Duration) ModelProber {
+	if timeout <= 0 {
+		timeout = DefaultProbeTimeout
+	}
+
+	return &modelProberImpl{
+		factory: factory,
+		timeout: timeout,
+	}
+}
+
+type modelProberImpl struct {
+	factory cchatmodel.Factory
+	timeout time.Duration
+}
+
+// Probe 依次探测基础对话、工具调用和 JSON 格式输出；只有配置无法解析时返回 error，调用模型失败记录在结果中
+func (p *modelProberImpl) Probe(ctx context.Context, req *ProbeModelRequest) (result *ProbeModelResult, err error) {
+	protocol := cchatmodel.Protocol(req.Protocol)
+	if !p.factory.SupportProtocol(protocol) {
+		return nil, fmt.Errorf("protocol not support, protocol=%s", req.Protocol)
+	}
+
+	config, err := BuildChatModelConfig(req.ModelKey, req.BaseURL, req.APIKey, req.ConfigJSON, req.DefaultParams)
+	if err != nil {
+		return nil, err
+	}
+	config.Timeout = p.timeout
+
+	result = &ProbeModelResult{}
+
+	model, err := p.factory.CreateChatModel(ctx, protocol, config)
+	if err != nil {
+		result.ErrorType, result.ErrorMsg = ProbeErrorUnknown, err.Error()
+		return result, nil
+	}
+
+	// 1. 基础对话
+	start := time.Now()
+	_, err = p.generate(ctx, model, "ping，请只回复 pong")
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		log.Printf("[ProbeModel] 基础对话失败，协议: %s，模型: %s，错误: %v", req.Protocol, req.ModelKey, err)
+		result.ErrorType, result.ErrorMsg = classifyProbeError(err), err.Error()
+		return result, nil
+	}
+	result.Success = true
+
+	// 2. 工具调用
+	toolModel, err := model.WithTools([]*schema.ToolInfo{probeToolInfo})
+	if err == nil {
+		msg, err := p.generate(ctx, toolModel, "请调用 get_current_time 工具查询 Asia/Shanghai 的当前时间")
+		result.SupportToolCall = err == nil && len(msg.ToolCalls) > 0
+	}
+
+	// 3. JSON 格式输出
+	jsonModel, err := p.factory.CreateChatModel(ctx, protocol, withJSONResponseFormat(protocol, config))
+	if err == nil {
+		msg, err := p.generate(ctx, jsonModel, `请以 JSON 对象格式返回 {"status": "ok"}，不要输出其他内容`)
+		result.SupportJSONFormat = err == nil && json.Valid([]byte(strings.TrimSpace(msg.Content)))
+	}
+
+	return result, nil
+}
+
+func (p *modelProberImpl) generate(ctx context.Context, model cchatmodel.BaseChatModel, prompt string) (*schema.Message, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	return model.Generate(timeoutCtx, []*schema.Message{schema.UserMessage(prompt)})
+}
+
+var probeToolInfo = &schema.ToolInfo{
+	Name: "get_current_time",
+	Desc: "获取指定时区的当前时间",
+	ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+		"timezone": {
+			Type:     schema.String,
+			Desc:     "IANA 时区名称，如 Asia/Shanghai",
+			Required: true,
+		},
+	}),
+}
+
+// withJSONResponseFormat 复制一份配置并开启协议支持的 JSON 输出模式；不支持的协议仅依赖提示词
+func withJSONResponseFormat(protocol cchatmodel.Protocol, config *cchatmodel.Config) *cchatmodel.Config {
+	jsonConfig := *config
+	jsonObject := &openai.ChatCompletionResponseFormat{Type: openai.ChatCompletionResponseFormatTypeJSONObject}
+
+	switch protocol {
+	case cchatmodel.ProtocolOpenAI:
+		openAIConfig := cchatmodel.OpenAIConfig{}
+		if config.OpenAI != nil {
+			openAIConfig = *config.OpenAI
+		}
+		openAIConfig.ResponseFormat = jsonObject
+		jsonConfig.OpenAI = &openAIConfig
+	case cchatmodel.ProtocolQwen:
+		jsonConfig.Qwen = &cchatmodel.QwenConfig{ResponseFormat: jsonObject}
+	case cchatmodel.ProtocolDeepseek:
+		jsonConfig.Deepseek = &cchatmodel.DeepseekConfig{ResponseFormatType: deepseek.ResponseFormatTypeJSONObject}
+	}
+
+	return &jsonConfig
+}
+
+// classifyProbeError 根据错误信息对模型调用失败进行分类
+func classifyProbeError(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return ProbeErrorNetwork
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case containsAny(msg, "status code: 401", "status code: 403", "invalid api key", "incorrect api key", "unauthorized", "authentication"):
+		return ProbeErrorAuth
+	case containsAny(msg, "status code: 404", "model_not_found", "model not found", "does not exist"):
+		return ProbeErrorModelNotFound
+	case containsAny(msg, "status code: 429", "quota", "rate limit", "insufficient", "billing"):
+		return ProbeErrorQuota
+	case containsAny(msg, "connection refused", "no such host", "timeout", "dial tcp", "eof", "tls"):
+		return ProbeErrorNetwork
+	default:
+		return ProbeErrorUnknown
+	}
+}
+
+func containsAny(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"mianshiba/infra/impl/chatmodel"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	stubAPIKey = "sk-stub"
+	stubModel  = "stub-model"
+)
+
+// newOpenAIStubServer 模拟 OpenAI 协议的 /chat/completions 接口
+func newOpenAIStubServer(t *testing.T, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"error":{"message":"stub error","type":"stub_error"}}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+stubAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"Incorrect API key provided","type":"invalid_request_error","code":"invalid_api_key"}}`))
+			return
+		}
+
+		var req struct {
+			Model          string            `json:"model"`
+			Tools          []json.RawMessage `json:"tools"`
+			ResponseFormat *struct {
+				Type string `json:"type"`
+			} `json:"response_format"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request failed: %v", err)
+			return
+		}
+
+		if req.Model != stubModel {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"The model does not exist","type":"invalid_request_error","code":"model_not_found"}}`))
+			return
+		}
+
+		message := map[string]any{"role": "assistant", "content": "pong"}
+		switch {
+		case len(req.Tools) > 0:
+			message["content"] = ""
+			message["tool_calls"] = []map[string]any{{
+				"id":   "call_1",
+				"type": "function",
+				"function": map[string]any{
+					"name":      "get_current_time",
+					"arguments": `{"timezone":"Asia/Shanghai"}`,
+				},
+			}}
+		case req.ResponseFormat != nil && req.ResponseFormat.Type == "json_object":
+			message["content"] = `{"status": "ok"}`
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "chatcmpl-stub",
+			"object":  "chat.completion",
+			"created": time.Now().Unix(),
+			"model":   req.Model,
+			"choices": []map[string]any{{
+				"index":         0,
+				"message":       message,
+				"finish_reason": "stop",
+			}},
+			"usage": map[string]any{"prompt_tokens": 1, "completion_tokens": 1, "total_tokens": 2},
+		})
+	}))
+}
+
+func TestModelProber_Probe(t *testing.T) {
+	prober := NewModelProber(chatmodel.NewDefaultFactory(), 5*time.Second)
+
+	okServer := newOpenAIStubServer(t, http.StatusOK)
+	defer okServer.Close()
+
+	quotaServer := newOpenAIStubServer(t, http.StatusTooManyRequests)
+	defer quotaServer.Close()
+
+	closedServer := newOpenAIStubServer(t, http.StatusOK)
+	closedServer.Close()
+
+	tests := []struct {
+		name      string
+		req       *ProbeModelRequest
+		success   bool
+		errorType string
+	}{
+		{
+			name:    "success",
+			req:     &ProbeModelRequest{Protocol: "openai", ModelKey: stubModel, BaseURL: okServer.URL, APIKey: stubAPIKey},
+			success: true,
+		},
+		{
+			name:      "auth",
+			req:       &ProbeModelRequest{Protocol: "openai", ModelKey: stubModel, BaseURL: okServer.URL, APIKey: "sk-wrong"},
+			errorType: ProbeErrorAuth,
+		},
+		{
+			name:      "model not found",
+			req:       &ProbeModelRequest{Protocol: "openai", ModelKey: "missing-model", BaseURL: okServer.URL, APIKey: stubAPIKey},
+			errorType: ProbeErrorModelNotFound,
+		},
+		{
+			name:      "quota",
+			req:       &ProbeModelRequest{Protocol: "openai", ModelKey: stubModel, BaseURL: quotaServer.URL, APIKey: stubAPIKey},
+			errorType: ProbeErrorQuota,
+		},
+		{
+			name:      "network",
+			req:       &ProbeModelRequest{Protocol: "openai", ModelKey: stubModel, BaseURL: closedServer.URL, APIKey: stubAPIKey},
+			errorType: ProbeErrorNetwork,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := prober.Probe(context.Background(), tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.success, result.Success)
+			assert.Equal(t, tt.errorType, result.ErrorType)
+			assert.Equal(t, tt.success, result.SupportToolCall)
+			assert.Equal(t, tt.success, result.SupportJSONFormat)
+		})
+	}
+}
+
+func TestModelProber_InvalidConfig(t *testing.T) {
+	prober := NewModelProber(chatmodel.NewDefaultFactory(), time.Second)
+
+	_, err := prober.Probe(context.Background(), &ProbeModelRequest{Protocol: "unknown", ModelKey: stubModel})
+	assert.Error(t, err)
+
+	_, err = prober.Probe(context.Background(), &ProbeModelRequest{Protocol: "openai", ModelKey: stubModel, ConfigJSON: "{"})
+	assert.Error(t, err)
+}
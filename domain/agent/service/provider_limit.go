@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	userEntity "mianshiba/domain/user/entity"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const anyProvider = "*"
+
+// NewProviderLimitedResolver 限制各模型协议同时进行的请求数，超出上限的请求排队等待
+// limits 的 key 为协议（openai、ark 等），"*" 为未列出协议的上限，未配置或不大于 0 时不限制
+func NewProviderLimitedResolver(resolver ChatModelResolver, limits map[string]int) ChatModelResolver {
+	return &providerLimitedResolver{
+		ChatModelResolver: resolver,
+		limits:            limits,
+		semaphores:        make(map[string]chan struct{}),
+	}
+}
+
+type providerLimitedResolver struct {
+	ChatModelResolver
+
+	limits     map[string]int
+	mu         sync.Mutex
+	semaphores map[string]chan struct{} // 协议 → 令牌，同一进程内的智能体共用
+}
+
+func (r *providerLimitedResolver) Resolve(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, error) {
+	chatModel, _, err := r.ResolveWithName(ctx, userID, feature)
+	return chatModel, err
+}
+
+func (r *providerLimitedResolver) ResolveWithName(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, string, error) {
+	chatModel, name, err := r.ChatModelResolver.ResolveWithName(ctx, userID, feature)
+	if err != nil {
+		return nil, name, err
+	}
+
+	// 模型名称为 协议/模型
+	provider, _, _ := strings.Cut(name, "/")
+	sem := r.semaphore(provider)
+	if sem == nil {
+		return chatModel, name, nil
+	}
+
+	return &limitedChatModel{model: chatModel, sem: sem}, name, nil
+}
+
+func (r *providerLimitedResolver) semaphore(provider string) chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if sem, ok := r.semaphores[provider]; ok {
+		return sem
+	}
+
+	limit, ok := r.limits[provider]
+	if !ok {
+		limit = r.limits[anyProvider]
+	}
+
+	var sem chan struct{}
+	if limit > 0 {
+		sem = make(chan struct{}, limit)
+	}
+	r.semaphores[provider] = sem
+	return sem
+}
+
+// limitedChatModel 每次请求前获取所属协议的令牌，Generate 返回或流式输出结束后归还
+type limitedChatModel struct {
+	model cchatmodel.ToolCallingChatModel
+	sem   chan struct{}
+}
+
+func (m *limitedChatModel) acquire(ctx context.Context) error {
+	select {
+	case m.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *limitedChatModel) release() {
+	<-m.sem
+}
+
+func (m *limitedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if err := m.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer m.release()
+
+	return m.model.Generate(ctx, input, opts...)
+}
+
+func (m *limitedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	if err := m.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	stream, err := m.model.Stream(ctx, input, opts...)
+	if err != nil {
+		m.release()
+		return nil, err
+	}
+
+	// 转发流式输出，读完或调用方关闭后归还令牌
+	reader, writer := schema.Pipe[*schema.Message](0)
+	go func() {
+		defer m.release()
+		defer stream.Close()
+		defer writer.Close()
+
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if closed := writer.Send(chunk, err); closed || err != nil {
+				return
+			}
+		}
+	}()
+
+	return reader, nil
+}
+
+func (m *limitedChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	withTools, err := m.model.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedChatModel{model: withTools, sem: m.sem}, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	userEntity "mianshiba/domain/user/entity"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingChatModel 记录同时进行的请求数
+type countingChatModel struct {
+	running, peak atomic.Int32
+}
+
+func (m *countingChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	n := m.running.Add(1)
+	defer m.running.Add(-1)
+	for {
+		peak := m.peak.Load()
+		if n <= peak || m.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return schema.AssistantMessage("ok", nil), nil
+}
+
+func (m *countingChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return schema.StreamReaderFromArray([]*schema.Message{schema.AssistantMessage("ok", nil)}), nil
+}
+
+func (m *countingChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+type staticResolver struct {
+	model cchatmodel.ToolCallingChatModel
+	name  string
+}
+
+func (r *staticResolver) Resolve(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, error) {
+	return r.model, nil
+}
+
+func (r *staticResolver) ResolveWithName(ctx context.Context, userID int64, feature userEntity.UsageFeature) (cchatmodel.ToolCallingChatModel, string, error) {
+	return r.model, r.name, nil
+}
+
+func TestProviderLimitedResolver(t *testing.T) {
+	ctx := context.Background()
+	chatModel := &countingChatModel{}
+	resolver := NewProviderLimitedResolver(&staticResolver{model: chatModel, name: "openai/gpt-4o"}, map[string]int{"openai": 2, "*": 8})
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m, err := resolver.Resolve(ctx, 1, userEntity.UsageFeatureResumeParse)
+			assert.NoError(t, err)
+			m, err = m.WithTools(nil)
+			assert.NoError(t, err)
+			_, err = m.Generate(ctx, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), chatModel.peak.Load())
+
+	// 流式输出读完后归还令牌
+	m, err := resolver.Resolve(ctx, 1, userEntity.UsageFeatureResumeParse)
+	assert.NoError(t, err)
+	for range 3 {
+		stream, err := m.Stream(ctx, nil)
+		assert.NoError(t, err)
+		msgs, err := schema.ConcatMessageStream(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", msgs.Content)
+	}
+
+	// 未配置上限的协议不限制
+	unlimited := NewProviderLimitedResolver(&staticResolver{model: chatModel, name: "ark/doubao"}, map[string]int{"openai": 2})
+	m, err = unlimited.Resolve(ctx, 1, userEntity.UsageFeatureResumeParse)
+	assert.NoError(t, err)
+	assert.Same(t, chatModel, m)
+}
@@ -4,18 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"mianshiba/domain/agent/agent/resume"
 	"mianshiba/domain/interview/dal"
 	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
 	"mianshiba/domain/interview/repository"
+	userEntity "mianshiba/domain/user/entity"
+	"mianshiba/infra/contract/document"
 	"mianshiba/infra/contract/storage"
 	mjson "mianshiba/pkg/json"
-	"mianshiba/pkg/pdf"
+	"net"
 	"os"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cloudwego/eino/adk"
 	"github.com/cloudwego/eino/schema"
@@ -23,32 +29,61 @@ import (
 
 // ResumeParseResult 简历解析结果
 type ResumeParseResult struct {
-	BasicInfo struct {
-		Name      string `json:"name"`
-		WorkYears string `json:"work_years"`
-		Contact   string `json:"contact"`
-	} `json:"basic_info"`
-	Education []struct {
-		School         string `json:"school"`
-		Major          string `json:"major"`
-		Degree         string `json:"degree"`
-		GraduationYear string `json:"graduation_year"`
-	} `json:"education"`
-	WorkExperience []struct {
-		Company          string `json:"company"`
-		Position         string `json:"position"`
-		Duration         string `json:"duration"`
-		Responsibilities string `json:"responsibilities"`
-	} `json:"work_experience"`
-	TechStack                   []string      `json:"tech_stack"`
-	Projects                    []interface{} `json:"projects"`
-	Skills                      []string      `json:"skills"`
-	Certifications              []string      `json:"certifications"`
-	Strengths                   string        `json:"strengths"`
-	PotentialWeaknesses         string        `json:"potential_weaknesses"`
-	RecommendedDifficulty       string        `json:"recommended_difficulty"`
-	InterviewFocusAreas         []string      `json:"interview_focus_areas"`
-	SuggestedQuestionDirections []string      `json:"suggested_questions_directions"`
+	BasicInfo                   ResumeBasicInfo        `json:"basic_info"`
+	Education                   []ResumeEducation      `json:"education"`
+	WorkExperience              []ResumeWorkExperience `json:"work_experience"`
+	TechStack                   []string               `json:"tech_stack"`
+	Projects                    []ResumeProject        `json:"projects"`
+	Skills                      []string               `json:"skills"`
+	Certifications              []string               `json:"certifications"`
+	Strengths                   string                 `json:"strengths"`
+	PotentialWeaknesses         string                 `json:"potential_weaknesses"`
+	RecommendedDifficulty       string                 `json:"recommended_difficulty"`
+	InterviewFocusAreas         []string               `json:"interview_focus_areas"`
+	SuggestedQuestionDirections []string               `json:"suggested_questions_directions"`
+}
+
+// ResumeBasicInfo 候选人基本信息
+type ResumeBasicInfo struct {
+	Name      string `json:"name"`
+	WorkYears string `json:"work_years"`
+	Contact   string `json:"contact"`
+}
+
+// ResumeEducation 教育经历
+type ResumeEducation struct {
+	School         string `json:"school"`
+	Major          string `json:"major"`
+	Degree         string `json:"degree"`
+	GraduationYear string `json:"graduation_year"`
+}
+
+// ResumeWorkExperience 工作经历
+type ResumeWorkExperience struct {
+	Company          string `json:"company"`
+	Position         string `json:"position"`
+	Duration         string `json:"duration"`
+	Responsibilities string `json:"responsibilities"`
+}
+
+// ResumeProject 项目经历
+type ResumeProject struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	TechStack    []string `json:"tech_stack"`
+	Contribution string   `json:"contribution"`
+}
+
+// UnmarshalJSON 兼容模型将项目直接输出为字符串的情况
+func (p *ResumeProject) UnmarshalJSON(data []byte) error {
+	var description string
+	if err := json.Unmarshal(data, &description); err == nil {
+		*p = ResumeProject{Description: description}
+		return nil
+	}
+
+	type project ResumeProject
+	return json.Unmarshal(data, (*project)(p))
 }
 
 type ParseResumeRequest struct {
@@ -58,17 +93,73 @@ type ParseResumeRequest struct {
 	Filename string // 文件名
 	Filetype string // 文件类型
 	Filesize int64  // 文件大小
+	Attempt  int32  // 第几次投递
+	Retry    int32  // 同一次投递内的自动重试序号，0表示首次执行
 }
 
 type ResumeAgentComponents struct {
-	OSSClient  storage.Storage
-	ResumeRepo repository.ResumeRepository
+	OSSClient     storage.Storage
+	Extractors    document.Factory
+	ResumeRepo    repository.ResumeRepository
+	ModelResolver ChatModelResolver
 }
 
 type ResumeAgent interface {
+	// ParseResumeAndSave 失败时返回 *ResumeParseError，可通过 ParseFailReasonOf 获取失败原因
 	ParseResumeAndSave(ctx context.Context, req *ParseResumeRequest) error
 }
 
+// ResumeParseError 简历解析失败及其原因分类
+type ResumeParseError struct {
+	Reason entity.ParseFailReason
+	Err    error
+}
+
+func (e *ResumeParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ResumeParseError) Unwrap() error {
+	return e.Err
+}
+
+func newResumeParseError(reason entity.ParseFailReason, err error) error {
+	return &ResumeParseError{Reason: reason, Err: err}
+}
+
+// ParseFailReasonOf 获取解析失败原因，未分类的错误视为内部错误
+func ParseFailReasonOf(err error) entity.ParseFailReason {
+	var parseErr *ResumeParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.Reason
+	}
+	return entity.ParseFailInternal
+}
+
+// IsTransientParseError 是否为可自动重试的临时错误：模型超时、网络错误或模型服务端 5xx 错误
+func IsTransientParseError(err error) bool {
+	var parseErr *ResumeParseError
+	if !errors.As(err, &parseErr) {
+		return false
+	}
+
+	switch parseErr.Reason {
+	case entity.ParseFailModelTimeout:
+		return true
+	case entity.ParseFailModelError:
+		var netErr net.Error
+		if errors.Is(parseErr.Err, context.DeadlineExceeded) || errors.As(parseErr.Err, &netErr) {
+			return true
+		}
+		msg := strings.ToLower(parseErr.Err.Error())
+		return containsAny(msg, "status code: 5", "connection reset", "connection refused", "timeout", "eof")
+	default:
+		return false
+	}
+}
+
+const maxAttemptErrorLength = 1024 // 与 resume_parse_attempt.error 列长度一致
+
 func NewResumeAgent(components *ResumeAgentComponents) ResumeAgent {
 	return &resumeAgentImpl{
 		ResumeAgentComponents: components,
@@ -79,17 +170,38 @@ type resumeAgentImpl struct {
 	*ResumeAgentComponents
 }
 
-// ParseResumeAndSave 调用简历解析智能体解析简历，并将结果保存到数据库
+// ParseResumeAndSave 调用简历解析智能体解析简历，并将结果保存到数据库；每次调用都会记录解析记录
 func (r *resumeAgentImpl) ParseResumeAndSave(ctx context.Context, req *ParseResumeRequest) error {
+	log.Printf("[ParseResumeAndSave] 开始解析简历，简历ID: %d, 第 %d 次投递, 重试序号: %d", req.FileID, req.Attempt, req.Retry)
+
+	start := time.Now()
+	modelName, err := r.parseResumeAndSave(ctx, req)
+	r.recordParseAttempt(ctx, req, modelName, time.Since(start), err)
+
+	return err
+}
+
+// parseResumeAndSave 返回所用模型的名称，模型创建前失败时为空
+func (r *resumeAgentImpl) parseResumeAndSave(ctx context.Context, req *ParseResumeRequest) (string, error) {
 	// 添加 120 秒超时
 	timeoutCtx, cancel := context.WithTimeout(ctx, 120*time.Second)
 	defer cancel()
 
-	// 创建简历解析智能体
-	agent, err := resume.NewResumeParserAgent()
+	// 使用用户配置的默认模型创建简历解析智能体
+	model, modelName, err := r.ModelResolver.ResolveWithName(timeoutCtx, req.UserID, userEntity.UsageFeatureResumeParse)
+	if IsQuotaExceededError(err) {
+		log.Printf("[ParseResumeAndSave] 用户今日模型用量已达配额，用户ID: %d", req.UserID)
+		return modelName, newResumeParseError(entity.ParseFailQuotaExceeded, err)
+	}
+	if err != nil {
+		log.Printf("[ParseResumeAndSave] 创建对话模型失败: %v", err)
+		return modelName, newResumeParseError(entity.ParseFailModelError, err)
+	}
+
+	agent, err := resume.NewResumeParserAgent(model)
 	if err != nil {
 		log.Printf("[ParseResumeAndSave] 创建简历解析智能体失败: %v", err)
-		return err
+		return modelName, newResumeParseError(entity.ParseFailModelError, err)
 	}
 
 	// 创建 runner
@@ -100,15 +212,25 @@ func (r *resumeAgentImpl) ParseResumeAndSave(ctx context.Context, req *ParseResu
 	bytes, err := r.GetResumeObject(timeoutCtx, req)
 	if err != nil {
 		log.Printf("[ParseResumeAndSave] 获取简历文件内容失败: %v", err)
-		return err
+		return modelName, newResumeParseError(entity.ParseFailInternal, err)
 	}
 
-	fmt.Printf("[ParseResumeAndSave] 原始文件内容: %s", bytes)
+	// 根据文件头和声明的文件类型选择文本提取器
+	fileType := document.DetectFileType(req.Filetype, bytes)
+	extractor, err := r.Extractors.GetExtractor(fileType)
+	if err != nil {
+		log.Printf("[ParseResumeAndSave] 不支持的简历文件类型: %s", fileType)
+		return modelName, newResumeParseError(entity.ParseFailUnsupportedFile, err)
+	}
 
-	resumeContent, err := pdf.TryParsePDFWithMultipleEncodings(bytes)
+	resumeContent, err := r.extractResumeContent(timeoutCtx, extractor, bytes)
 	if err != nil {
-		log.Printf("[ParseResumeAndSave] 解析PDF内容失败: %v", err)
-		return err
+		log.Printf("[ParseResumeAndSave] 提取简历文本失败, 文件类型: %s, err: %v", fileType, err)
+		return modelName, newResumeParseError(entity.ParseFailUnreadableFile, err)
+	}
+	if strings.TrimSpace(resumeContent) == "" {
+		log.Printf("[ParseResumeAndSave] 简历中未提取到文字, 文件类型: %s", fileType)
+		return modelName, newResumeParseError(entity.ParseFailUnreadableFile, fmt.Errorf("no text extracted from %s file", fileType))
 	}
 	fmt.Printf("[ParseResumeAndSave] 解析后的简历内容: %s", resumeContent)
 
@@ -147,7 +269,7 @@ func (r *resumeAgentImpl) ParseResumeAndSave(ctx context.Context, req *ParseResu
 		select {
 		case <-timeoutCtx.Done():
 			log.Printf("[ParseResumeAndSave] 超时：等待智能体响应超过 120 秒")
-			return fmt.Errorf("timeout waiting for resume parsing (120s)")
+			return modelName, newResumeParseError(entity.ParseFailModelTimeout, fmt.Errorf("timeout waiting for resume parsing (120s)"))
 		default:
 		}
 
@@ -160,7 +282,11 @@ func (r *resumeAgentImpl) ParseResumeAndSave(ctx context.Context, req *ParseResu
 
 		if event.Err != nil {
 			log.Printf("[ParseResumeAndSave] 错误: %v", event.Err)
-			return fmt.Errorf("error during resume parsing: %w", event.Err)
+			reason := entity.ParseFailModelError
+			if errors.Is(event.Err, context.DeadlineExceeded) {
+				reason = entity.ParseFailModelTimeout
+			}
+			return modelName, newResumeParseError(reason, fmt.Errorf("error during resume parsing: %w", event.Err))
 		}
 
 		// 收集最后一条消息
@@ -172,31 +298,78 @@ func (r *resumeAgentImpl) ParseResumeAndSave(ctx context.Context, req *ParseResu
 	// 解析智能体响应
 	if lastMessage == "" {
 		log.Printf("[ParseResumeAndSave] 智能体未返回任何响应")
-		return fmt.Errorf("agent returned empty response")
+		return modelName, newResumeParseError(entity.ParseFailEmptyResult, fmt.Errorf("agent returned empty response"))
 	}
 
 	log.Printf("[ParseResumeAndSave] 智能体响应内容: %s", lastMessage)
 	parseResult := parseResumeResponse(lastMessage)
 	if parseResult == nil {
 		log.Printf("[ParseResumeAndSave] 无法解析简历响应")
-		return fmt.Errorf("failed to parse resume response")
+		return modelName, newResumeParseError(entity.ParseFailInvalidJSON, fmt.Errorf("failed to parse resume response"))
 	}
 
 	// 验证解析结果是否有效（不能全是空数据）
 	if !isValidResumeResult(parseResult) {
 		log.Printf("[ParseResumeAndSave] 解析结果无效（全是空数据），请检查简历文件是否正确")
-		return fmt.Errorf("resume parsing result is empty or invalid")
+		return modelName, newResumeParseError(entity.ParseFailEmptyResult, fmt.Errorf("resume parsing result is empty or invalid"))
 	}
 
 	// 将解析结果保存到数据库
 	err = r.saveResumeToDatabase(ctx, req, parseResult)
 	if err != nil {
 		log.Printf("[ParseResumeAndSave] 保存简历失败: %v", err)
-		return fmt.Errorf("failed to save resume: %w", err)
+		return modelName, fmt.Errorf("failed to save resume: %w", err)
 	}
 
 	log.Printf("[ParseResumeAndSave] 简历解析成功，简历ID: %d", req.FileID)
-	return nil
+	return modelName, nil
+}
+
+// extractResumeContent 提取简历文本；支持版面解析的提取器（如 PDF）按阅读顺序输出，并记录解析失败的页面
+func (r *resumeAgentImpl) extractResumeContent(ctx context.Context, extractor document.Extractor, data []byte) (string, error) {
+	layout, ok := extractor.(document.LayoutExtractor)
+	if !ok {
+		return extractor.Extract(ctx, data)
+	}
+
+	doc, err := layout.ExtractDocument(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	if failed := doc.FailedPages(); len(failed) > 0 {
+		log.Printf("[extractResumeContent] 部分页面解析失败, 页码: %v, 总页数: %d", failed, len(doc.Pages))
+		if len(failed) == len(doc.Pages) {
+			return "", fmt.Errorf("all %d pages failed to parse, first error: %s", len(doc.Pages), doc.Pages[0].Err)
+		}
+	}
+
+	return doc.Text(), nil
+}
+
+// recordParseAttempt 记录本次解析的模型、耗时和结果，记录失败不影响解析结果
+func (r *resumeAgentImpl) recordParseAttempt(ctx context.Context, req *ParseResumeRequest, modelName string, duration time.Duration, parseErr error) {
+	attempt := &model.ResumeParseAttempt{
+		ResumeID:   req.FileID,
+		UserID:     req.UserID,
+		Attempt:    req.Attempt,
+		Retry:      req.Retry,
+		Model:      modelName,
+		DurationMs: duration.Milliseconds(),
+		Outcome:    dal.ParseOutcomeSuccess,
+	}
+	if parseErr != nil {
+		attempt.Outcome = dal.ParseOutcomeFailed
+		attempt.FailReason = string(ParseFailReasonOf(parseErr))
+		attempt.Error = parseErr.Error()
+		if utf8.RuneCountInString(attempt.Error) > maxAttemptErrorLength {
+			attempt.Error = string([]rune(attempt.Error)[:maxAttemptErrorLength])
+		}
+	}
+
+	if err := r.ResumeRepo.CreateParseAttempt(ctx, attempt); err != nil {
+		log.Printf("[recordParseAttempt] 保存解析记录失败，简历ID: %d, err: %v", req.FileID, err)
+	}
 }
 
 func (r *resumeAgentImpl) GetResumeObject(ctx context.Context, req *ParseResumeRequest) (bytes []byte, err error) {
@@ -335,16 +508,17 @@ func (r *resumeAgentImpl) saveResumeToDatabase(ctx context.Context, req *ParseRe
 	fileName := req.Filename
 	log.Printf("[saveResumeToDatabase], 提取的文件名: %s", fileName)
 
-	// 解析结果保存数据库
-	err = r.ResumeRepo.UpdateResume(ctx, req.FileID, &model.Resume{
-		LlmParseContent: string(contentJSON),
-		Status:          dal.StatusParseSuccess,
-	})
+	// 解析结果保存数据库，作为结构化信息的新版本；简历已删除或状态已变更时不保存
+	version, saved, err := r.ResumeRepo.CompleteParse(ctx, req.FileID, string(contentJSON))
 	if err != nil {
 		log.Printf("[saveResumeToDatabase] 创建简历记录失败: %v", err)
 		return fmt.Errorf("failed to create resume record: %w", err)
 	}
+	if !saved {
+		log.Printf("[saveResumeToDatabase] 简历不在解析中状态，丢弃解析结果，ID: %d", req.FileID)
+		return fmt.Errorf("resume %d is no longer being parsed", req.FileID)
+	}
 
-	log.Printf("[saveResumeToDatabase] 简历记录已保存，ID: %d, 用户ID: %d, 文件Key: %s, 文件名: %s", req.FileID, req.UserID, req.FileKey, fileName)
+	log.Printf("[saveResumeToDatabase] 简历记录已保存，ID: %d, 用户ID: %d, 文件Key: %s, 文件名: %s, 版本: %d", req.FileID, req.UserID, req.FileKey, fileName, version)
 	return nil
 }
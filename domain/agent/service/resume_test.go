@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"mianshiba/domain/interview/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransientParseError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{"timeout", newResumeParseError(entity.ParseFailModelTimeout, errors.New("timeout waiting for resume parsing (120s)")), true},
+		{"deadline", newResumeParseError(entity.ParseFailModelError, fmt.Errorf("error during resume parsing: %w", context.DeadlineExceeded)), true},
+		{"5xx", newResumeParseError(entity.ParseFailModelError, errors.New("error, status code: 503, status: 503 Service Unavailable")), true},
+		{"4xx", newResumeParseError(entity.ParseFailModelError, errors.New("error, status code: 401, message: invalid api key")), false},
+		{"invalid json", newResumeParseError(entity.ParseFailInvalidJSON, errors.New("failed to parse resume response")), false},
+		{"unclassified", errors.New("failed to save resume"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.transient, IsTransientParseError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	userEntity "mianshiba/domain/user/entity"
+	userService "mianshiba/domain/user/service"
+	cchatmodel "mianshiba/infra/contract/chatmodel"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// IsQuotaExceededError 是否因用户当天共享密钥用量已达配额而无法调用模型
+func IsQuotaExceededError(err error) bool {
+	var statusErr errorx.StatusError
+	return errors.As(err, &statusErr) && statusErr.Code() == errno.ErrUserTokenQuotaExceededCode
+}
+
+// usageChatModel 每次请求结束后从响应元数据中取出 token 用量并记录，记录失败只打印日志
+type usageChatModel struct {
+	model cchatmodel.ToolCallingChatModel
+	usage userService.TokenUsage
+	tmpl  userEntity.TokenUsage // 用户、功能、模型等固定字段
+}
+
+func newUsageChatModel(chatModel cchatmodel.ToolCallingChatModel, usage userService.TokenUsage, tmpl userEntity.TokenUsage) *usageChatModel {
+	return &usageChatModel{model: chatModel, usage: usage, tmpl: tmpl}
+}
+
+func (m *usageChatModel) record(ctx context.Context, tokenUsage *schema.TokenUsage) {
+	if tokenUsage == nil {
+		return
+	}
+
+	record := m.tmpl
+	record.PromptTokens = int64(tokenUsage.PromptTokens)
+	record.CompletionTokens = int64(tokenUsage.CompletionTokens)
+	record.TotalTokens = int64(tokenUsage.TotalTokens)
+	if record.TotalTokens == 0 {
+		record.TotalTokens = record.PromptTokens + record.CompletionTokens
+	}
+
+	// 请求 ctx 可能已超时或取消，用量仍需记录
+	if err := m.usage.Record(context.WithoutCancel(ctx), &record); err != nil {
+		log.Printf("[RecordTokenUsage] 记录模型用量失败，用户ID: %d，功能: %s，err: %v", record.UserID, record.Feature, err)
+	}
+}
+
+func (m *usageChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	msg, err := m.model.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.ResponseMeta != nil {
+		m.record(ctx, msg.ResponseMeta.Usage)
+	}
+	return msg, nil
+}
+
+func (m *usageChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	stream, err := m.model.Stream(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转发流式输出，用量一般只出现在最后一个分片，按各分片的最大值统计，流结束后记录
+	reader, writer := schema.Pipe[*schema.Message](0)
+	go func() {
+		defer stream.Close()
+		defer writer.Close()
+
+		var tokenUsage *schema.TokenUsage
+		defer func() { m.record(ctx, tokenUsage) }()
+
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if chunk != nil && chunk.ResponseMeta != nil && chunk.ResponseMeta.Usage != nil {
+				tokenUsage = maxTokenUsage(tokenUsage, chunk.ResponseMeta.Usage)
+			}
+			if closed := writer.Send(chunk, err); closed || err != nil {
+				return
+			}
+		}
+	}()
+
+	return reader, nil
+}
+
+func (m *usageChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	withTools, err := m.model.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return newUsageChatModel(withTools, m.usage, m.tmpl), nil
+}
+
+func maxTokenUsage(a, b *schema.TokenUsage) *schema.TokenUsage {
+	if a == nil {
+		u := *b
+		return &u
+	}
+	a.PromptTokens = max(a.PromptTokens, b.PromptTokens)
+	a.CompletionTokens = max(a.CompletionTokens, b.CompletionTokens)
+	a.TotalTokens = max(a.TotalTokens, b.TotalTokens)
+	return a
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	userEntity "mianshiba/domain/user/entity"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// usageReportingChatModel 在响应元数据中返回固定的用量，流式输出只在最后一个分片返回
+type usageReportingChatModel struct{}
+
+func (m *usageReportingChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	msg := schema.AssistantMessage("ok", nil)
+	msg.ResponseMeta = &schema.ResponseMeta{Usage: &schema.TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15}}
+	return msg, nil
+}
+
+func (m *usageReportingChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	last := schema.AssistantMessage("", nil)
+	last.ResponseMeta = &schema.ResponseMeta{Usage: &schema.TokenUsage{PromptTokens: 20, CompletionTokens: 8}}
+	return schema.StreamReaderFromArray([]*schema.Message{schema.AssistantMessage("o", nil), schema.AssistantMessage("k", nil), last}), nil
+}
+
+func (m *usageReportingChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+type recordedUsage struct {
+	mu      sync.Mutex
+	records []*userEntity.TokenUsage
+}
+
+func (r *recordedUsage) Record(ctx context.Context, usage *userEntity.TokenUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, usage)
+	return nil
+}
+
+func (r *recordedUsage) CheckQuota(ctx context.Context, userID int64, feature userEntity.UsageFeature) error {
+	return nil
+}
+
+func (r *recordedUsage) ListDaily(ctx context.Context, userID int64, days int) ([]*userEntity.DailyUsage, error) {
+	return nil, nil
+}
+
+func TestUsageChatModel(t *testing.T) {
+	ctx := context.Background()
+	usage := &recordedUsage{}
+	m := newUsageChatModel(&usageReportingChatModel{}, usage, userEntity.TokenUsage{
+		UserID:    1,
+		Feature:   userEntity.UsageFeatureInterview,
+		Model:     "openai/gpt-4o",
+		SharedKey: true,
+	})
+
+	withTools, err := m.WithTools(nil)
+	assert.NoError(t, err)
+	_, err = withTools.Generate(ctx, nil)
+	assert.NoError(t, err)
+
+	stream, err := m.Stream(ctx, nil)
+	assert.NoError(t, err)
+	msg, err := schema.ConcatMessageStream(stream)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", msg.Content)
+
+	// 流结束后才记录用量
+	assert.Eventually(t, func() bool {
+		usage.mu.Lock()
+		defer usage.mu.Unlock()
+		return len(usage.records) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, int64(15), usage.records[0].TotalTokens)
+	assert.Equal(t, userEntity.UsageFeatureInterview, usage.records[0].Feature)
+	assert.True(t, usage.records[0].SharedKey)
+	// 未返回总数时按输入、输出之和统计
+	assert.Equal(t, int64(20), usage.records[1].PromptTokens)
+	assert.Equal(t, int64(28), usage.records[1].TotalTokens)
+}
+
+func TestIsQuotaExceededError(t *testing.T) {
+	err := errorx.New(errno.ErrUserTokenQuotaExceededCode, errorx.KV("msg", "daily token quota exceeded"))
+	assert.True(t, IsQuotaExceededError(err))
+	assert.True(t, IsQuotaExceededError(newResumeParseError("", err)))
+	assert.False(t, IsQuotaExceededError(errorx.New(errno.ErrUserModelNotFoundCode)))
+	assert.False(t, IsQuotaExceededError(nil))
+}
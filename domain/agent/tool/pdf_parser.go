@@ -85,7 +85,7 @@ func ConvertPDFToText(ctx context.Context, req *PDFToTextRequest) (*PDFToTextRes
 	)
 	if err != nil {
 		result.Success = false
-		result.ErrorMsg = fmt.Sprintf("PDF解析失败：%v（仅支持文本型PDF，不支持扫描件/加密PDF）", err)
+		result.ErrorMsg = fmt.Sprintf("PDF解析失败：%v（不支持加密PDF）", err)
 		return &result, errors.New(result.ErrorMsg)
 	}
 
@@ -123,7 +123,7 @@ func ConvertPDFToText(ctx context.Context, req *PDFToTextRequest) (*PDFToTextRes
 // CreatePDFToTextTool 创建工具实例（供Eino框架注册，大模型识别）
 func CreatePDFToTextTool() tool.InvokableTool {
 	// 工具元信息：大模型识别的关键（名称、描述、参数定义）
-	pdfTool, err := utils.InferTool("pdf_to_text", "将本地PDF文件转换为纯文本，仅支持文本型PDF（可复制文字），不支持扫描件、加密PDF。需传入本地PDF的绝对路径，可选择按页面分割或合并所有页。", ConvertPDFToText)
+	pdfTool, err := utils.InferTool("pdf_to_text", "将本地PDF文件转换为纯文本，不支持加密PDF；简历中的扫描页在上传解析时已通过OCR识别，可直接使用解析结果。需传入本地PDF的绝对路径，可选择按页面分割或合并所有页。", ConvertPDFToText)
 	if err != nil {
 		log.Fatalf("infer tool failed: %v", err)
 	}
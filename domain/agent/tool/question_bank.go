@@ -0,0 +1,112 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	questionService "mianshiba/domain/question/service"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+const (
+	QuestionBankToolName = "search_question_bank"
+
+	defaultQuestionBankTopK = 5
+)
+
+// CandidateProfile 检索题库时使用的候选人画像，来自简历解析结果
+type CandidateProfile struct {
+	TechStack  []string // 技术栈
+	Projects   []string // 项目经历摘要
+	FocusAreas []string // 面试关注领域
+	JobSkills  []string // 目标岗位的必备技能，不针对具体岗位时为空
+}
+
+// Query 生成检索文本：topic 不为空时以其为主，并附上候选人画像
+func (p *CandidateProfile) Query(topic string) string {
+	var sb strings.Builder
+	if topic = strings.TrimSpace(topic); topic != "" {
+		sb.WriteString(topic)
+		sb.WriteString("\n")
+	}
+	if len(p.TechStack) > 0 {
+		sb.WriteString("技术栈：" + strings.Join(p.TechStack, "、") + "\n")
+	}
+	if len(p.JobSkills) > 0 {
+		sb.WriteString("岗位要求：" + strings.Join(p.JobSkills, "、") + "\n")
+	}
+	if len(p.FocusAreas) > 0 {
+		sb.WriteString("关注领域：" + strings.Join(p.FocusAreas, "、") + "\n")
+	}
+	if len(p.Projects) > 0 {
+		sb.WriteString("项目经历：" + strings.Join(p.Projects, "；") + "\n")
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// SearchQuestionBankRequest 大模型调用工具的入参
+type SearchQuestionBankRequest struct {
+	Topic string `json:"topic,omitempty" jsonschema:"description=本轮想考察的主题，例如某项技术或候选人的某个项目；为空时按候选人的整体画像检索"`
+}
+
+// SearchQuestionBankResult 工具返回的候选题目
+type SearchQuestionBankResult struct {
+	Questions []*QuestionCandidate `json:"questions" jsonschema:"description=按相似度倒序排列的候选题目"`
+	ErrorMsg  string               `json:"error_msg,omitempty" jsonschema:"description=检索失败的原因，此时直接根据候选人信息提问"`
+}
+
+// QuestionCandidate 题库中的一道候选题目
+type QuestionCandidate struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title" jsonschema:"description=题目"`
+	Content    string   `json:"content,omitempty" jsonschema:"description=题目详细描述"`
+	Type       string   `json:"type" jsonschema:"description=题型：concept/coding/system_design/behavioral"`
+	Difficulty string   `json:"difficulty,omitempty" jsonschema:"description=难度：初级/中级/高级"`
+	Tags       []string `json:"tags,omitempty"`
+	Score      float64  `json:"score" jsonschema:"description=与候选人的相关度，越大越相关"`
+}
+
+// NewQuestionBankTool 创建题库检索工具：按与候选人技术栈、项目经历和关注领域的相似度从题库中挑选候选题目
+// topK 不大于 0 时返回 5 道
+func NewQuestionBankTool(r retriever.Retriever, profile *CandidateProfile, topK int) (tool.InvokableTool, error) {
+	if topK <= 0 {
+		topK = defaultQuestionBankTopK
+	}
+
+	search := func(ctx context.Context, req *SearchQuestionBankRequest) (*SearchQuestionBankResult, error) {
+		docs, err := r.Retrieve(ctx, profile.Query(req.Topic), retriever.WithTopK(topK))
+		if err != nil {
+			// 检索失败不中断面试，由模型直接提问
+			log.Printf("[SearchQuestionBank] 检索题库失败: %v", err)
+			return &SearchQuestionBankResult{ErrorMsg: "题库暂不可用"}, nil
+		}
+
+		result := &SearchQuestionBankResult{Questions: make([]*QuestionCandidate, 0, len(docs))}
+		for _, doc := range docs {
+			candidate := &QuestionCandidate{
+				ID:      doc.ID,
+				Content: doc.Content,
+				Score:   doc.Score(),
+			}
+			candidate.Title, _ = doc.MetaData[questionService.DocMetaTitle].(string)
+			candidate.Type, _ = doc.MetaData[questionService.DocMetaType].(string)
+			candidate.Difficulty, _ = doc.MetaData[questionService.DocMetaDifficulty].(string)
+			candidate.Tags, _ = doc.MetaData[questionService.DocMetaTags].([]string)
+			result.Questions = append(result.Questions, candidate)
+		}
+		return result, nil
+	}
+
+	t, err := utils.InferTool(QuestionBankToolName,
+		"从面试题库中检索与候选人技术栈、项目经历和关注领域最相关的题目，作为提问的参考。可以指定本轮想考察的主题。",
+		search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create question bank tool: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,79 @@
+package tool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	questionService "mianshiba/domain/question/service"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRetriever struct {
+	query string
+	topK  int
+	err   error
+}
+
+func (r *fakeRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	r.query = query
+	r.topK = *retriever.GetCommonOptions(nil, opts...).TopK
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	doc := &schema.Document{
+		ID:      "1",
+		Content: "比较 RDB 和 AOF",
+		MetaData: map[string]any{
+			questionService.DocMetaTitle:      "Redis 的持久化机制有哪些",
+			questionService.DocMetaType:       "concept",
+			questionService.DocMetaDifficulty: "中级",
+			questionService.DocMetaTags:       []string{"redis"},
+		},
+	}
+	return []*schema.Document{doc.WithScore(0.8)}, nil
+}
+
+func TestQuestionBankTool(t *testing.T) {
+	ctx := context.Background()
+	r := &fakeRetriever{}
+	profile := &CandidateProfile{
+		TechStack:  []string{"Go", "Redis"},
+		Projects:   []string{"秒杀系统（Redis、Kafka）"},
+		FocusAreas: []string{"缓存设计"},
+	}
+
+	bank, err := NewQuestionBankTool(r, profile, 3)
+	assert.NoError(t, err)
+	info, err := bank.Info(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, QuestionBankToolName, info.Name)
+
+	output, err := bank.InvokableRun(ctx, `{"topic":"Redis 持久化"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "Redis 持久化\n技术栈：Go、Redis\n关注领域：缓存设计\n项目经历：秒杀系统（Redis、Kafka）", r.query)
+	assert.Equal(t, 3, r.topK)
+
+	var result SearchQuestionBankResult
+	assert.NoError(t, json.Unmarshal([]byte(output), &result))
+	assert.Equal(t, []*QuestionCandidate{{
+		ID:         "1",
+		Title:      "Redis 的持久化机制有哪些",
+		Content:    "比较 RDB 和 AOF",
+		Type:       "concept",
+		Difficulty: "中级",
+		Tags:       []string{"redis"},
+		Score:      0.8,
+	}}, result.Questions)
+
+	// 检索失败时不中断面试
+	r.err = errors.New("embedding service unavailable")
+	output, err = bank.InvokableRun(ctx, `{}`)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "error_msg")
+}
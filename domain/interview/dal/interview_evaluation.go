@@ -0,0 +1,164 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/dal/query"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	EvaluationStatusPending  = 1 // 评估中
+	EvaluationStatusFinished = 2 // 已完成
+	EvaluationStatusFailed   = 3 // 失败
+)
+
+func NewInterviewEvaluationDAO(db *gorm.DB) *InterviewEvaluationDAO {
+	return &InterviewEvaluationDAO{
+		query: query.Use(db),
+	}
+}
+
+type InterviewEvaluationDAO struct {
+	query *query.Query
+}
+
+func (d *InterviewEvaluationDAO) CreateEvaluation(ctx context.Context, evaluation *model.InterviewEvaluation) error {
+	return d.query.InterviewEvaluation.WithContext(ctx).Create(evaluation)
+}
+
+// CreateQueued 新建待评估记录，并在同一事务中写入评估消息
+func (d *InterviewEvaluationDAO) CreateQueued(ctx context.Context, evaluation *model.InterviewEvaluation, event *model.OutboxEvent) error {
+	evaluation.Status = EvaluationStatusPending
+
+	return d.query.Transaction(func(tx *query.Query) error {
+		if err := tx.InterviewEvaluation.WithContext(ctx).Create(evaluation); err != nil {
+			return err
+		}
+		return tx.OutboxEvent.WithContext(ctx).Create(event)
+	})
+}
+
+// Requeue 仅当评估失败、或评估中但更新时间早于 staleBefore 时将评估重新置为评估中，并在同一事务中写入评估消息；
+// 事务消息表中还有该会话未投递的评估消息时不重复写入，由投递任务继续重试；
+// 条件更新保证并发调用时只有一个调用方重新投递，返回是否重新投递
+func (d *InterviewEvaluationDAO) Requeue(ctx context.Context, sessionID int64, staleBefore time.Time, event *model.OutboxEvent) (bool, error) {
+	requeued := false
+	err := d.query.Transaction(func(tx *query.Query) error {
+		ob := tx.OutboxEvent
+		unsent, err := ob.WithContext(ctx).Where(
+			ob.Topic.Eq(event.Topic),
+			ob.MsgKey.Eq(event.MsgKey),
+			ob.Status.Eq(OutboxStatusPending),
+		).Count()
+		if err != nil {
+			return err
+		}
+
+		if unsent > 0 {
+			return nil
+		}
+
+		ev := tx.InterviewEvaluation
+		info, err := ev.WithContext(ctx).Where(
+			ev.SessionID.Eq(sessionID),
+		).Where(
+			ev.Where(ev.Status.Eq(EvaluationStatusFailed)).Or(ev.Status.Eq(EvaluationStatusPending), ev.UpdatedAt.Lt(staleBefore)),
+		).Updates(map[string]any{
+			"status":     EvaluationStatusPending,
+			"error_msg":  "",
+			"updated_at": time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if info.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.OutboxEvent.WithContext(ctx).Create(event); err != nil {
+			return err
+		}
+
+		requeued = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return requeued, nil
+}
+
+func (d *InterviewEvaluationDAO) GetEvaluationBySessionID(ctx context.Context, sessionID int64) (*model.InterviewEvaluation, bool, error) {
+	evaluation, err := d.query.InterviewEvaluation.WithContext(ctx).Where(
+		d.query.InterviewEvaluation.SessionID.Eq(sessionID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return evaluation, true, nil
+}
+
+// SaveEvaluationResult 保存单题评分和评估报告，重复评估时覆盖之前的单题评分
+func (d *InterviewEvaluationDAO) SaveEvaluationResult(ctx context.Context, sessionID int64, evaluation *model.InterviewEvaluation, scores []*model.InterviewAnswerScore) error {
+	return d.query.Transaction(func(tx *query.Query) error {
+		_, err := tx.InterviewAnswerScore.WithContext(ctx).Where(
+			tx.InterviewAnswerScore.SessionID.Eq(sessionID),
+		).Delete()
+		if err != nil {
+			return err
+		}
+
+		if len(scores) > 0 {
+			if err = tx.InterviewAnswerScore.WithContext(ctx).Create(scores...); err != nil {
+				return err
+			}
+		}
+
+		// 数组字段需要经过 json 序列化，使用结构体更新并显式指定列，保证零值也会被写入
+		evaluation.Status = EvaluationStatusFinished
+		evaluation.ErrorMsg = ""
+		_, err = tx.InterviewEvaluation.WithContext(ctx).Where(
+			tx.InterviewEvaluation.SessionID.Eq(sessionID),
+		).Select(
+			tx.InterviewEvaluation.Status,
+			tx.InterviewEvaluation.OverallScore,
+			tx.InterviewEvaluation.Summary,
+			tx.InterviewEvaluation.Strengths,
+			tx.InterviewEvaluation.Gaps,
+			tx.InterviewEvaluation.Suggestions,
+			tx.InterviewEvaluation.ErrorMsg,
+			tx.InterviewEvaluation.EvaluatedAt,
+		).Updates(evaluation)
+		return err
+	})
+}
+
+// FailEvaluation 记录评估失败原因
+func (d *InterviewEvaluationDAO) FailEvaluation(ctx context.Context, sessionID int64, errMsg string) error {
+	_, err := d.query.InterviewEvaluation.WithContext(ctx).Where(
+		d.query.InterviewEvaluation.SessionID.Eq(sessionID),
+	).Updates(map[string]any{
+		"status":    EvaluationStatusFailed,
+		"error_msg": errMsg,
+	})
+
+	return err
+}
+
+// ListAnswerScores 按轮次顺序获取会话的单题评分
+func (d *InterviewEvaluationDAO) ListAnswerScores(ctx context.Context, sessionID int64) ([]*model.InterviewAnswerScore, error) {
+	return d.query.InterviewAnswerScore.WithContext(ctx).Where(
+		d.query.InterviewAnswerScore.SessionID.Eq(sessionID),
+	).Order(d.query.InterviewAnswerScore.TurnNo).Find()
+}
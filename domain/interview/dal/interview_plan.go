@@ -0,0 +1,114 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/dal/query"
+
+	"gorm.io/gorm"
+)
+
+func NewInterviewPlanDAO(db *gorm.DB) *InterviewPlanDAO {
+	return &InterviewPlanDAO{
+		query: query.Use(db),
+	}
+}
+
+type InterviewPlanDAO struct {
+	query *query.Query
+}
+
+// CreatePlan 在同一事务中创建面试计划及其环节
+func (d *InterviewPlanDAO) CreatePlan(ctx context.Context, plan *model.InterviewPlan, rounds []*model.InterviewPlanRound) error {
+	return d.query.Transaction(func(tx *query.Query) error {
+		if err := tx.InterviewPlan.WithContext(ctx).Create(plan); err != nil {
+			return err
+		}
+		return tx.InterviewPlanRound.WithContext(ctx).Create(rounds...)
+	})
+}
+
+func (d *InterviewPlanDAO) GetPlanByID(ctx context.Context, id int64) (*model.InterviewPlan, bool, error) {
+	plan, err := d.query.InterviewPlan.WithContext(ctx).Where(
+		d.query.InterviewPlan.ID.Eq(id),
+		d.query.InterviewPlan.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return plan, true, nil
+}
+
+// ListPlans 按创建时间倒序获取用户的面试计划
+func (d *InterviewPlanDAO) ListPlans(ctx context.Context, userID int64) ([]*model.InterviewPlan, error) {
+	plan := d.query.InterviewPlan
+	return plan.WithContext(ctx).Where(
+		plan.UserID.Eq(userID),
+		plan.Deleted.Is(false),
+	).Order(plan.CreatedAt.Desc(), plan.ID.Desc()).Find()
+}
+
+// ListPlanRounds 按环节顺序获取面试计划的环节，key 为面试计划ID
+func (d *InterviewPlanDAO) ListPlanRounds(ctx context.Context, planIDs []int64) (map[int64][]*model.InterviewPlanRound, error) {
+	result := make(map[int64][]*model.InterviewPlanRound, len(planIDs))
+	if len(planIDs) == 0 {
+		return result, nil
+	}
+
+	round := d.query.InterviewPlanRound
+	rounds, err := round.WithContext(ctx).Where(
+		round.PlanID.In(planIDs...),
+	).Order(round.PlanID, round.RoundNo).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rounds {
+		result[r.PlanID] = append(result[r.PlanID], r)
+	}
+
+	return result, nil
+}
+
+// UpdatePlan 更新面试计划，并在同一事务中替换其全部环节
+func (d *InterviewPlanDAO) UpdatePlan(ctx context.Context, id int64, updates map[string]any, rounds []*model.InterviewPlanRound) error {
+	return d.query.Transaction(func(tx *query.Query) error {
+		_, err := tx.InterviewPlan.WithContext(ctx).Where(
+			tx.InterviewPlan.ID.Eq(id),
+			tx.InterviewPlan.Deleted.Is(false),
+		).Updates(updates)
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.InterviewPlanRound.WithContext(ctx).Where(tx.InterviewPlanRound.PlanID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		return tx.InterviewPlanRound.WithContext(ctx).Create(rounds...)
+	})
+}
+
+// DeletePlan 软删除面试计划，返回是否实际删除；已开始的面试使用的是开始时复制的环节，不受影响
+func (d *InterviewPlanDAO) DeletePlan(ctx context.Context, userID int64, id int64) (bool, error) {
+	info, err := d.query.InterviewPlan.WithContext(ctx).Where(
+		d.query.InterviewPlan.ID.Eq(id),
+		d.query.InterviewPlan.UserID.Eq(userID),
+		d.query.InterviewPlan.Deleted.Is(false),
+	).Updates(map[string]any{
+		"deleted":    true,
+		"deleted_at": time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
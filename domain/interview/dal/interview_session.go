@@ -0,0 +1,214 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/dal/query"
+
+	"gorm.io/gorm"
+)
+
+const (
+	SessionStatusInProgress = 1 // 进行中
+	SessionStatusEnded      = 2 // 已结束
+)
+
+const (
+	TurnStatusPending  = 1 // 待回答
+	TurnStatusAnswered = 2 // 已回答
+)
+
+// 面试环节状态：未开始 → 进行中 → 已完成；进入下一环节或面试结束时，之前的环节标记为已完成
+const (
+	RoundStatusPending    = 1 // 未开始
+	RoundStatusInProgress = 2 // 进行中
+	RoundStatusFinished   = 3 // 已完成
+)
+
+func NewInterviewSessionDAO(db *gorm.DB) *InterviewSessionDAO {
+	return &InterviewSessionDAO{
+		query: query.Use(db),
+	}
+}
+
+type InterviewSessionDAO struct {
+	query *query.Query
+}
+
+// CreateSession 创建会话；使用面试计划时在同一事务中创建会话的环节
+func (d *InterviewSessionDAO) CreateSession(ctx context.Context, session *model.InterviewSession, rounds []*model.InterviewSessionRound) error {
+	if len(rounds) == 0 {
+		return d.query.InterviewSession.WithContext(ctx).Create(session)
+	}
+
+	return d.query.Transaction(func(tx *query.Query) error {
+		if err := tx.InterviewSession.WithContext(ctx).Create(session); err != nil {
+			return err
+		}
+		return tx.InterviewSessionRound.WithContext(ctx).Create(rounds...)
+	})
+}
+
+func (d *InterviewSessionDAO) GetSessionByID(ctx context.Context, id int64) (*model.InterviewSession, bool, error) {
+	session, err := d.query.InterviewSession.WithContext(ctx).Where(
+		d.query.InterviewSession.ID.Eq(id),
+		d.query.InterviewSession.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return session, true, nil
+}
+
+func (d *InterviewSessionDAO) UpdateSession(ctx context.Context, id int64, session *model.InterviewSession) error {
+	_, err := d.query.InterviewSession.WithContext(ctx).Where(
+		d.query.InterviewSession.ID.Eq(id),
+	).Updates(session)
+
+	return err
+}
+
+// EndSession 结束进行中的会话，并将进行中的环节标记为已完成，返回是否实际发生了状态变更
+func (d *InterviewSessionDAO) EndSession(ctx context.Context, id int64, endedAt int64) (bool, error) {
+	ended := false
+	err := d.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.InterviewSession.WithContext(ctx).Where(
+			tx.InterviewSession.ID.Eq(id),
+			tx.InterviewSession.Status.Eq(SessionStatusInProgress),
+		).Updates(map[string]any{
+			"status":   SessionStatusEnded,
+			"ended_at": endedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return nil
+		}
+
+		_, err = tx.InterviewSessionRound.WithContext(ctx).Where(
+			tx.InterviewSessionRound.SessionID.Eq(id),
+			tx.InterviewSessionRound.Status.Eq(RoundStatusInProgress),
+		).Updates(map[string]any{
+			"status":   RoundStatusFinished,
+			"ended_at": endedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		ended = true
+		return nil
+	})
+
+	return ended, err
+}
+
+func (d *InterviewSessionDAO) CreateTurn(ctx context.Context, turn *model.InterviewTurn) error {
+	return d.query.InterviewTurn.WithContext(ctx).Create(turn)
+}
+
+// ListTurns 按轮次顺序获取会话的全部轮次
+func (d *InterviewSessionDAO) ListTurns(ctx context.Context, sessionID int64) ([]*model.InterviewTurn, error) {
+	return d.query.InterviewTurn.WithContext(ctx).Where(
+		d.query.InterviewTurn.SessionID.Eq(sessionID),
+	).Order(d.query.InterviewTurn.TurnNo).Find()
+}
+
+// AnswerTurn 保存候选人回答，并累加会话已回答轮次；仅待回答的轮次会被更新
+func (d *InterviewSessionDAO) AnswerTurn(ctx context.Context, turnID int64, answer string, answeredAt int64) (bool, error) {
+	answered := false
+	err := d.query.Transaction(func(tx *query.Query) error {
+		turn, err := tx.InterviewTurn.WithContext(ctx).Where(tx.InterviewTurn.ID.Eq(turnID)).First()
+		if err != nil {
+			return err
+		}
+
+		info, err := tx.InterviewTurn.WithContext(ctx).Where(
+			tx.InterviewTurn.ID.Eq(turnID),
+			tx.InterviewTurn.Status.Eq(TurnStatusPending),
+		).Updates(map[string]any{
+			"answer":      answer,
+			"status":      TurnStatusAnswered,
+			"answered_at": answeredAt,
+		})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return nil
+		}
+
+		_, err = tx.InterviewSession.WithContext(ctx).Where(
+			tx.InterviewSession.ID.Eq(turn.SessionID),
+		).UpdateSimple(tx.InterviewSession.TurnCount.Add(1))
+		if err != nil {
+			return err
+		}
+
+		answered = true
+		return nil
+	})
+
+	return answered, err
+}
+
+// ListRounds 按环节顺序获取会话的全部环节
+func (d *InterviewSessionDAO) ListRounds(ctx context.Context, sessionID int64) ([]*model.InterviewSessionRound, error) {
+	return d.query.InterviewSessionRound.WithContext(ctx).Where(
+		d.query.InterviewSessionRound.SessionID.Eq(sessionID),
+	).Order(d.query.InterviewSessionRound.RoundNo).Find()
+}
+
+// StartRound 开始会话的某个环节：之前未完成的环节标记为已完成，并更新会话的当前环节；
+// 仅未开始的环节会被开始，返回是否实际开始
+func (d *InterviewSessionDAO) StartRound(ctx context.Context, sessionID int64, roundNo int32, startedAt int64) (bool, error) {
+	started := false
+	err := d.query.Transaction(func(tx *query.Query) error {
+		round := tx.InterviewSessionRound
+		info, err := round.WithContext(ctx).Where(
+			round.SessionID.Eq(sessionID),
+			round.RoundNo.Eq(roundNo),
+			round.Status.Eq(RoundStatusPending),
+		).Updates(map[string]any{
+			"status":     RoundStatusInProgress,
+			"started_at": startedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return nil
+		}
+
+		_, err = round.WithContext(ctx).Where(
+			round.SessionID.Eq(sessionID),
+			round.RoundNo.Lt(roundNo),
+			round.Status.Neq(RoundStatusFinished),
+		).Updates(map[string]any{
+			"status":   RoundStatusFinished,
+			"ended_at": startedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.InterviewSession.WithContext(ctx).Where(
+			tx.InterviewSession.ID.Eq(sessionID),
+		).UpdateSimple(tx.InterviewSession.CurrentRound.Value(roundNo))
+		if err != nil {
+			return err
+		}
+
+		started = true
+		return nil
+	})
+
+	return started, err
+}
@@ -0,0 +1,157 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/dal/query"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobDescriptionSourceText = 1 // 粘贴文本
+	JobDescriptionSourceFile = 2 // 上传文件
+)
+
+// 岗位描述解析状态：parsing → parsed/failed，解析完成或失败后可重新解析
+const (
+	JobDescriptionParsing = 1 // 解析中
+	JobDescriptionParsed  = 2 // 解析成功
+	JobDescriptionFailed  = 3 // 解析失败
+)
+
+// JobDescriptionFilter 岗位描述列表筛选条件
+type JobDescriptionFilter struct {
+	Keyword string // 匹配岗位名称或公司名称
+	Offset  int
+	Limit   int
+}
+
+func NewJobDescriptionDAO(db *gorm.DB) *JobDescriptionDAO {
+	return &JobDescriptionDAO{
+		query: query.Use(db),
+	}
+}
+
+type JobDescriptionDAO struct {
+	query *query.Query
+}
+
+func (d *JobDescriptionDAO) CreateJobDescription(ctx context.Context, jd *model.JobDescription) error {
+	return d.query.JobDescription.WithContext(ctx).Create(jd)
+}
+
+func (d *JobDescriptionDAO) GetJobDescriptionByID(ctx context.Context, id int64) (*model.JobDescription, bool, error) {
+	jd, err := d.query.JobDescription.WithContext(ctx).Where(
+		d.query.JobDescription.ID.Eq(id),
+		d.query.JobDescription.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return jd, true, nil
+}
+
+// ListJobDescriptions 按创建时间倒序分页获取用户的岗位描述
+func (d *JobDescriptionDAO) ListJobDescriptions(ctx context.Context, userID int64, filter *JobDescriptionFilter) ([]*model.JobDescription, int64, error) {
+	jd := d.query.JobDescription
+	do := jd.WithContext(ctx).Where(
+		jd.UserID.Eq(userID),
+		jd.Deleted.Is(false),
+	)
+
+	if filter.Keyword != "" {
+		keyword := "%" + filter.Keyword + "%"
+		do = do.Where(jd.Where(jd.Title.Like(keyword)).Or(jd.Company.Like(keyword)))
+	}
+
+	return do.Order(jd.CreatedAt.Desc(), jd.ID.Desc()).FindByPage(filter.Offset, filter.Limit)
+}
+
+// DeleteJobDescription 软删除岗位描述，返回是否实际删除；已关联的面试会话不受影响
+func (d *JobDescriptionDAO) DeleteJobDescription(ctx context.Context, userID int64, id int64) (bool, error) {
+	info, err := d.query.JobDescription.WithContext(ctx).Where(
+		d.query.JobDescription.ID.Eq(id),
+		d.query.JobDescription.UserID.Eq(userID),
+		d.query.JobDescription.Deleted.Is(false),
+	).Updates(map[string]any{
+		"deleted":    true,
+		"deleted_at": time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
+
+// StartJobDescriptionParse 重新解析：解析完成或失败、以及 staleBefore 之前就开始解析（服务重启等原因中断）的岗位描述进入解析中，返回是否更新成功
+func (d *JobDescriptionDAO) StartJobDescriptionParse(ctx context.Context, id int64, staleBefore time.Time) (bool, error) {
+	jd := d.query.JobDescription
+	info, err := jd.WithContext(ctx).Where(
+		jd.ID.Eq(id),
+		jd.Deleted.Is(false),
+	).Where(
+		jd.Where(jd.ParseStatus.In(JobDescriptionParsed, JobDescriptionFailed)).
+			Or(jd.ParseStatus.Eq(JobDescriptionParsing), jd.UpdatedAt.Lt(staleBefore)),
+	).Updates(map[string]any{
+		"parse_status": JobDescriptionParsing,
+		"parse_error":  "",
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
+
+// CompleteJobDescriptionParse 保存解析结果，title、company 不为空时一并更新；仅解析中的岗位描述会被更新
+func (d *JobDescriptionDAO) CompleteJobDescriptionParse(ctx context.Context, id int64, content string, title string, company string) (bool, error) {
+	updates := map[string]any{
+		"parse_status":   JobDescriptionParsed,
+		"parse_error":    "",
+		"parsed_content": content,
+	}
+	if title != "" {
+		updates["title"] = title
+	}
+	if company != "" {
+		updates["company"] = company
+	}
+
+	info, err := d.query.JobDescription.WithContext(ctx).Where(
+		d.query.JobDescription.ID.Eq(id),
+		d.query.JobDescription.Deleted.Is(false),
+		d.query.JobDescription.ParseStatus.Eq(JobDescriptionParsing),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
+
+// FailJobDescriptionParse 记录解析失败原因，仅解析中的岗位描述会被更新
+func (d *JobDescriptionDAO) FailJobDescriptionParse(ctx context.Context, id int64, parseError string) (bool, error) {
+	info, err := d.query.JobDescription.WithContext(ctx).Where(
+		d.query.JobDescription.ID.Eq(id),
+		d.query.JobDescription.Deleted.Is(false),
+		d.query.JobDescription.ParseStatus.Eq(JobDescriptionParsing),
+	).Updates(map[string]any{
+		"parse_status": JobDescriptionFailed,
+		"parse_error":  parseError,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
@@ -14,19 +14,23 @@ const TableNameResume = "resume"
 
 // Resume 用户简历元信息表
 type Resume struct {
-	ID              int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
-	UserID          int64          `gorm:"column:user_id;not null;comment:用户ID" json:"user_id"`                                 // 用户ID
-	FileKey         string         `gorm:"column:file_key;not null;comment:对象存储中的文件唯一标识" json:"file_key"`                       // 对象存储中的文件唯一标识
-	Filename        string         `gorm:"column:filename;not null;comment:原始文件名" json:"filename"`                              // 原始文件名
-	Filetype        string         `gorm:"column:filetype;comment:文件类型，如 pdf/docx" json:"filetype"`                             // 文件类型，如 pdf/docx
-	Filesize        int64          `gorm:"column:filesize;comment:文件大小（字节）" json:"filesize"`                                    // 文件大小（字节）
-	Status          int32          `gorm:"column:status;not null;default:1;comment:简历状态：1已上传 2解析中 3已解析 4已删除 5失败" json:"status"` // 简历状态：1已上传 2解析中 3已解析 4已删除 5失败
-	ParseStatus     int32          `gorm:"column:parse_status;not null;comment:解析状态：0未开始 1解析中 2成功 3失败" json:"parse_status"`     // 解析状态：0未开始 1解析中 2成功 3失败
-	ParseError      string         `gorm:"column:parse_error;comment:解析失败原因摘要" json:"parse_error"`                              // 解析失败原因摘要
-	UploadAt        time.Time      `gorm:"column:upload_at;not null;default:CURRENT_TIMESTAMP;comment:上传时间" json:"upload_at"`   // 上传时间
-	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;comment:软删除时间" json:"deleted_at"`                                   // 软删除时间
-	Deleted         bool           `gorm:"column:deleted;not null;comment:删除状态（0=未删除, 1=已删除）" json:"deleted"`                   // 删除状态（0=未删除, 1=已删除）
-	LlmParseContent string         `gorm:"column:llm_parse_content" json:"llm_parse_content"`
+	ID              int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                         // 主键ID
+	UserID          int64          `gorm:"column:user_id;not null;comment:用户ID" json:"user_id"`                                    // 用户ID
+	FileKey         string         `gorm:"column:file_key;not null;comment:对象存储中的文件唯一标识" json:"file_key"`                          // 对象存储中的文件唯一标识
+	Filename        string         `gorm:"column:filename;not null;comment:原始文件名" json:"filename"`                                 // 原始文件名
+	Filetype        string         `gorm:"column:filetype;comment:文件类型，如 pdf/docx" json:"filetype"`                                // 文件类型，如 pdf/docx
+	Filesize        int64          `gorm:"column:filesize;comment:文件大小（字节）" json:"filesize"`                                       // 文件大小（字节）
+	Status          int32          `gorm:"column:status;not null;default:1;comment:简历状态：1已上传 2解析中 3已解析 4已删除 5失败" json:"status"`    // 简历状态：1已上传 2解析中 3已解析 4已删除 5失败
+	ParseStatus     int32          `gorm:"column:parse_status;not null;comment:解析状态：0已上传 1已入队 2解析中 3成功 4失败" json:"parse_status"`   // 解析状态：0已上传 1已入队 2解析中 3成功 4失败
+	ParseError      string         `gorm:"column:parse_error;comment:解析失败原因，格式为 分类: 详情" json:"parse_error"`                        // 解析失败原因，格式为 分类: 详情
+	ParseAttempts   int32          `gorm:"column:parse_attempts;not null;comment:解析任务投递次数（首次上传及每次重新解析各计一次）" json:"parse_attempts"` // 解析任务投递次数（首次上传及每次重新解析各计一次）
+	ParseStartedAt  int64          `gorm:"column:parse_started_at;not null;comment:最近一次开始解析的时间（毫秒）" json:"parse_started_at"`       // 最近一次开始解析的时间（毫秒）
+	UploadAt        time.Time      `gorm:"column:upload_at;not null;default:CURRENT_TIMESTAMP;comment:上传时间" json:"upload_at"`      // 上传时间
+	DeletedAt       gorm.DeletedAt `gorm:"column:deleted_at;comment:软删除时间" json:"deleted_at"`                                      // 软删除时间
+	Deleted         bool           `gorm:"column:deleted;not null;comment:删除状态（0=未删除, 1=已删除）" json:"deleted"`                      // 删除状态（0=未删除, 1=已删除）
+	DeleteReason    string         `gorm:"column:delete_reason;not null;comment:删除原因" json:"delete_reason"`                        // 删除原因
+	LlmParseContent string         `gorm:"column:llm_parse_content;comment:大模型解析结果（JSON），用户修改后为修改后的内容" json:"llm_parse_content"`   // 大模型解析结果（JSON），用户修改后为修改后的内容
+	ProfileVersion  int32          `gorm:"column:profile_version;not null;comment:当前结构化信息版本号，0表示尚未解析" json:"profile_version"`      // 当前结构化信息版本号，0表示尚未解析
 }
 
 // TableName Resume's table name
@@ -0,0 +1,89 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/dal/query"
+
+	"gorm.io/gorm"
+)
+
+const (
+	OutboxStatusPending = 0 // 待投递
+	OutboxStatusSent    = 1 // 已投递
+)
+
+func NewOutboxDAO(db *gorm.DB) *OutboxDAO {
+	return &OutboxDAO{
+		query: query.Use(db),
+	}
+}
+
+type OutboxDAO struct {
+	query *query.Query
+}
+
+// ClaimPending 领取到期的待投递消息：将其下次可投递时间推迟一个租期并增加投递次数，
+// 多个实例同时领取时每条消息只会被一个实例领到；投递方在租期内未标记结果的消息会被重新领取
+func (o *OutboxDAO) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error) {
+	ob := o.query.OutboxEvent
+	now := time.Now().UnixMilli()
+
+	candidates, err := ob.WithContext(ctx).Where(
+		ob.Status.Eq(OutboxStatusPending),
+		ob.NextAttemptAt.Lte(now),
+	).Order(ob.ID).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*model.OutboxEvent, 0, len(candidates))
+	for _, event := range candidates {
+		info, err := ob.WithContext(ctx).Where(
+			ob.ID.Eq(event.ID),
+			ob.Status.Eq(OutboxStatusPending),
+			ob.NextAttemptAt.Lte(now),
+		).Updates(map[string]any{
+			"next_attempt_at": now + lease.Milliseconds(),
+			"attempts":        gorm.Expr("attempts + 1"),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if info.RowsAffected > 0 {
+			event.Attempts++
+			claimed = append(claimed, event)
+		}
+	}
+
+	return claimed, nil
+}
+
+// MarkSent 标记消息投递成功
+func (o *OutboxDAO) MarkSent(ctx context.Context, id int64) error {
+	_, err := o.query.OutboxEvent.WithContext(ctx).Where(
+		o.query.OutboxEvent.ID.Eq(id),
+	).Updates(map[string]any{
+		"status":     OutboxStatusSent,
+		"sent_at":    time.Now().UnixMilli(),
+		"last_error": "",
+	})
+
+	return err
+}
+
+// MarkRetry 记录投递失败原因，消息在 nextAttemptAt 之后重新投递
+func (o *OutboxDAO) MarkRetry(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	_, err := o.query.OutboxEvent.WithContext(ctx).Where(
+		o.query.OutboxEvent.ID.Eq(id),
+		o.query.OutboxEvent.Status.Eq(OutboxStatusPending),
+	).Updates(map[string]any{
+		"last_error":      lastError,
+		"next_attempt_at": nextAttemptAt.UnixMilli(),
+	})
+
+	return err
+}
@@ -16,34 +16,89 @@ import (
 )
 
 var (
-	Q      = new(Query)
-	Resume *resume
+	Q                     = new(Query)
+	InterviewAnswerScore  *interviewAnswerScore
+	InterviewEvaluation   *interviewEvaluation
+	InterviewPlan         *interviewPlan
+	InterviewPlanRound    *interviewPlanRound
+	InterviewSession      *interviewSession
+	InterviewSessionRound *interviewSessionRound
+	InterviewTurn         *interviewTurn
+	JobDescription        *jobDescription
+	OutboxEvent           *outboxEvent
+	Resume                *resume
+	ResumeParseAttempt    *resumeParseAttempt
+	ResumeProfileVersion  *resumeProfileVersion
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	InterviewAnswerScore = &Q.InterviewAnswerScore
+	InterviewEvaluation = &Q.InterviewEvaluation
+	InterviewPlan = &Q.InterviewPlan
+	InterviewPlanRound = &Q.InterviewPlanRound
+	InterviewSession = &Q.InterviewSession
+	InterviewSessionRound = &Q.InterviewSessionRound
+	InterviewTurn = &Q.InterviewTurn
+	JobDescription = &Q.JobDescription
+	OutboxEvent = &Q.OutboxEvent
 	Resume = &Q.Resume
+	ResumeParseAttempt = &Q.ResumeParseAttempt
+	ResumeProfileVersion = &Q.ResumeProfileVersion
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:     db,
-		Resume: newResume(db, opts...),
+		db:                    db,
+		InterviewAnswerScore:  newInterviewAnswerScore(db, opts...),
+		InterviewEvaluation:   newInterviewEvaluation(db, opts...),
+		InterviewPlan:         newInterviewPlan(db, opts...),
+		InterviewPlanRound:    newInterviewPlanRound(db, opts...),
+		InterviewSession:      newInterviewSession(db, opts...),
+		InterviewSessionRound: newInterviewSessionRound(db, opts...),
+		InterviewTurn:         newInterviewTurn(db, opts...),
+		JobDescription:        newJobDescription(db, opts...),
+		OutboxEvent:           newOutboxEvent(db, opts...),
+		Resume:                newResume(db, opts...),
+		ResumeParseAttempt:    newResumeParseAttempt(db, opts...),
+		ResumeProfileVersion:  newResumeProfileVersion(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Resume resume
+	InterviewAnswerScore  interviewAnswerScore
+	InterviewEvaluation   interviewEvaluation
+	InterviewPlan         interviewPlan
+	InterviewPlanRound    interviewPlanRound
+	InterviewSession      interviewSession
+	InterviewSessionRound interviewSessionRound
+	InterviewTurn         interviewTurn
+	JobDescription        jobDescription
+	OutboxEvent           outboxEvent
+	Resume                resume
+	ResumeParseAttempt    resumeParseAttempt
+	ResumeProfileVersion  resumeProfileVersion
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:     db,
-		Resume: q.Resume.clone(db),
+		db:                    db,
+		InterviewAnswerScore:  q.InterviewAnswerScore.clone(db),
+		InterviewEvaluation:   q.InterviewEvaluation.clone(db),
+		InterviewPlan:         q.InterviewPlan.clone(db),
+		InterviewPlanRound:    q.InterviewPlanRound.clone(db),
+		InterviewSession:      q.InterviewSession.clone(db),
+		InterviewSessionRound: q.InterviewSessionRound.clone(db),
+		InterviewTurn:         q.InterviewTurn.clone(db),
+		JobDescription:        q.JobDescription.clone(db),
+		OutboxEvent:           q.OutboxEvent.clone(db),
+		Resume:                q.Resume.clone(db),
+		ResumeParseAttempt:    q.ResumeParseAttempt.clone(db),
+		ResumeProfileVersion:  q.ResumeProfileVersion.clone(db),
 	}
 }
 
@@ -57,18 +112,51 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:     db,
-		Resume: q.Resume.replaceDB(db),
+		db:                    db,
+		InterviewAnswerScore:  q.InterviewAnswerScore.replaceDB(db),
+		InterviewEvaluation:   q.InterviewEvaluation.replaceDB(db),
+		InterviewPlan:         q.InterviewPlan.replaceDB(db),
+		InterviewPlanRound:    q.InterviewPlanRound.replaceDB(db),
+		InterviewSession:      q.InterviewSession.replaceDB(db),
+		InterviewSessionRound: q.InterviewSessionRound.replaceDB(db),
+		InterviewTurn:         q.InterviewTurn.replaceDB(db),
+		JobDescription:        q.JobDescription.replaceDB(db),
+		OutboxEvent:           q.OutboxEvent.replaceDB(db),
+		Resume:                q.Resume.replaceDB(db),
+		ResumeParseAttempt:    q.ResumeParseAttempt.replaceDB(db),
+		ResumeProfileVersion:  q.ResumeProfileVersion.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Resume IResumeDo
+	InterviewAnswerScore  IInterviewAnswerScoreDo
+	InterviewEvaluation   IInterviewEvaluationDo
+	InterviewPlan         IInterviewPlanDo
+	InterviewPlanRound    IInterviewPlanRoundDo
+	InterviewSession      IInterviewSessionDo
+	InterviewSessionRound IInterviewSessionRoundDo
+	InterviewTurn         IInterviewTurnDo
+	JobDescription        IJobDescriptionDo
+	OutboxEvent           IOutboxEventDo
+	Resume                IResumeDo
+	ResumeParseAttempt    IResumeParseAttemptDo
+	ResumeProfileVersion  IResumeProfileVersionDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Resume: q.Resume.WithContext(ctx),
+		InterviewAnswerScore:  q.InterviewAnswerScore.WithContext(ctx),
+		InterviewEvaluation:   q.InterviewEvaluation.WithContext(ctx),
+		InterviewPlan:         q.InterviewPlan.WithContext(ctx),
+		InterviewPlanRound:    q.InterviewPlanRound.WithContext(ctx),
+		InterviewSession:      q.InterviewSession.WithContext(ctx),
+		InterviewSessionRound: q.InterviewSessionRound.WithContext(ctx),
+		InterviewTurn:         q.InterviewTurn.WithContext(ctx),
+		JobDescription:        q.JobDescription.WithContext(ctx),
+		OutboxEvent:           q.OutboxEvent.WithContext(ctx),
+		Resume:                q.Resume.WithContext(ctx),
+		ResumeParseAttempt:    q.ResumeParseAttempt.WithContext(ctx),
+		ResumeProfileVersion:  q.ResumeProfileVersion.WithContext(ctx),
 	}
 }
 
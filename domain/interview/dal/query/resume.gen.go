@@ -37,10 +37,14 @@ func newResume(db *gorm.DB, opts ...gen.DOOption) resume {
 	_resume.Status = field.NewInt32(tableName, "status")
 	_resume.ParseStatus = field.NewInt32(tableName, "parse_status")
 	_resume.ParseError = field.NewString(tableName, "parse_error")
+	_resume.ParseAttempts = field.NewInt32(tableName, "parse_attempts")
+	_resume.ParseStartedAt = field.NewInt64(tableName, "parse_started_at")
 	_resume.UploadAt = field.NewTime(tableName, "upload_at")
 	_resume.DeletedAt = field.NewField(tableName, "deleted_at")
 	_resume.Deleted = field.NewBool(tableName, "deleted")
+	_resume.DeleteReason = field.NewString(tableName, "delete_reason")
 	_resume.LlmParseContent = field.NewString(tableName, "llm_parse_content")
+	_resume.ProfileVersion = field.NewInt32(tableName, "profile_version")
 
 	_resume.fillFieldMap()
 
@@ -59,12 +63,16 @@ type resume struct {
 	Filetype        field.String // 文件类型，如 pdf/docx
 	Filesize        field.Int64  // 文件大小（字节）
 	Status          field.Int32  // 简历状态：1已上传 2解析中 3已解析 4已删除 5失败
-	ParseStatus     field.Int32  // 解析状态：0未开始 1解析中 2成功 3失败
-	ParseError      field.String // 解析失败原因摘要
+	ParseStatus     field.Int32  // 解析状态：0已上传 1已入队 2解析中 3成功 4失败
+	ParseError      field.String // 解析失败原因，格式为 分类: 详情
+	ParseAttempts   field.Int32  // 解析任务投递次数（首次上传及每次重新解析各计一次）
+	ParseStartedAt  field.Int64  // 最近一次开始解析的时间（毫秒）
 	UploadAt        field.Time   // 上传时间
 	DeletedAt       field.Field  // 软删除时间
 	Deleted         field.Bool   // 删除状态（0=未删除, 1=已删除）
-	LlmParseContent field.String
+	DeleteReason    field.String // 删除原因
+	LlmParseContent field.String // 大模型解析结果（JSON），用户修改后为修改后的内容
+	ProfileVersion  field.Int32  // 当前结构化信息版本号，0表示尚未解析
 
 	fieldMap map[string]field.Expr
 }
@@ -90,10 +98,14 @@ func (r *resume) updateTableName(table string) *resume {
 	r.Status = field.NewInt32(table, "status")
 	r.ParseStatus = field.NewInt32(table, "parse_status")
 	r.ParseError = field.NewString(table, "parse_error")
+	r.ParseAttempts = field.NewInt32(table, "parse_attempts")
+	r.ParseStartedAt = field.NewInt64(table, "parse_started_at")
 	r.UploadAt = field.NewTime(table, "upload_at")
 	r.DeletedAt = field.NewField(table, "deleted_at")
 	r.Deleted = field.NewBool(table, "deleted")
+	r.DeleteReason = field.NewString(table, "delete_reason")
 	r.LlmParseContent = field.NewString(table, "llm_parse_content")
+	r.ProfileVersion = field.NewInt32(table, "profile_version")
 
 	r.fillFieldMap()
 
@@ -110,7 +122,7 @@ func (r *resume) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *resume) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 13)
+	r.fieldMap = make(map[string]field.Expr, 17)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["user_id"] = r.UserID
 	r.fieldMap["file_key"] = r.FileKey
@@ -120,10 +132,14 @@ func (r *resume) fillFieldMap() {
 	r.fieldMap["status"] = r.Status
 	r.fieldMap["parse_status"] = r.ParseStatus
 	r.fieldMap["parse_error"] = r.ParseError
+	r.fieldMap["parse_attempts"] = r.ParseAttempts
+	r.fieldMap["parse_started_at"] = r.ParseStartedAt
 	r.fieldMap["upload_at"] = r.UploadAt
 	r.fieldMap["deleted_at"] = r.DeletedAt
 	r.fieldMap["deleted"] = r.Deleted
+	r.fieldMap["delete_reason"] = r.DeleteReason
 	r.fieldMap["llm_parse_content"] = r.LlmParseContent
+	r.fieldMap["profile_version"] = r.ProfileVersion
 }
 
 func (r resume) clone(db *gorm.DB) resume {
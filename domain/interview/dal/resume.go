@@ -2,18 +2,66 @@ package dal
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"mianshiba/domain/interview/dal/model"
 	"mianshiba/domain/interview/dal/query"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
+	StatusUploaded     = 1 // 已上传
 	StatusParsing      = 2 // 解析中
 	StatusParseSuccess = 3 // 解析成功
-	StatusParseFailed  = 4 // 解析失败
+	StatusDeleted      = 4 // 已删除
+	StatusParseFailed  = 5 // 解析失败
+)
+
+// 解析状态：uploaded → queued → parsing → parsed/failed，失败或已解析的简历可重新入队
+const (
+	ParseStatusUploaded = 0 // 已上传，尚未入队
+	ParseStatusQueued   = 1 // 已入队，等待解析
+	ParseStatusParsing  = 2 // 解析中
+	ParseStatusParsed   = 3 // 解析成功
+	ParseStatusFailed   = 4 // 解析失败
+)
+
+// StatusOfParse 解析状态对应的简历状态，两者同时更新
+func StatusOfParse(parseStatus int32) int32 {
+	switch parseStatus {
+	case ParseStatusParsing:
+		return StatusParsing
+	case ParseStatusParsed:
+		return StatusParseSuccess
+	case ParseStatusFailed:
+		return StatusParseFailed
+	default:
+		return StatusUploaded
+	}
+}
+
+// 单次解析的结果
+const (
+	ParseOutcomeSuccess = 1 // 成功
+	ParseOutcomeFailed  = 2 // 失败
+)
+
+const (
+	ProfileSourceModel = 1 // 模型解析
+	ProfileSourceUser  = 2 // 用户修改
 )
 
+// ResumeFilter 简历列表筛选条件
+type ResumeFilter struct {
+	Status  *int32
+	Keyword string // 匹配文件名
+	Offset  int
+	Limit   int
+}
+
 func NewResumeDAO(db *gorm.DB) *ResumeDAO {
 	return &ResumeDAO{
 		query: query.Use(db),
@@ -28,6 +76,86 @@ func (r *ResumeDAO) Create(ctx context.Context, resume *model.Resume) error {
 	return r.query.Resume.WithContext(ctx).Create(resume)
 }
 
+// CreateQueued 新建已入队的简历，并在同一事务中写入解析消息
+func (r *ResumeDAO) CreateQueued(ctx context.Context, resume *model.Resume, event *model.OutboxEvent) error {
+	resume.ParseStatus = ParseStatusQueued
+	resume.Status = StatusOfParse(ParseStatusQueued)
+
+	return r.query.Transaction(func(tx *query.Query) error {
+		if err := tx.Resume.WithContext(ctx).Create(resume); err != nil {
+			return err
+		}
+		return tx.OutboxEvent.WithContext(ctx).Create(event)
+	})
+}
+
+// QueueParse 锁定简历，由 canQueue 根据当前状态判断能否重新解析，可以时将简历置为已入队、投递次数加一，
+// 并在同一事务中写入 newEvent 生成的解析消息；返回更新后的简历和是否更新成功
+func (r *ResumeDAO) QueueParse(ctx context.Context, id int64, canQueue func(current *model.Resume) bool, newEvent func(resume *model.Resume) (*model.OutboxEvent, error)) (*model.Resume, bool, error) {
+	var queued *model.Resume
+	err := r.query.Transaction(func(tx *query.Query) error {
+		current, err := lockResume(ctx, tx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !canQueue(current) {
+			return nil
+		}
+
+		current.ParseStatus = ParseStatusQueued
+		current.Status = StatusOfParse(ParseStatusQueued)
+		current.ParseError = ""
+		current.ParseAttempts++
+		_, err = tx.Resume.WithContext(ctx).Where(
+			tx.Resume.ID.Eq(current.ID),
+		).Updates(map[string]any{
+			"parse_status":   current.ParseStatus,
+			"status":         current.Status,
+			"parse_error":    current.ParseError,
+			"parse_attempts": current.ParseAttempts,
+		})
+		if err != nil {
+			return err
+		}
+
+		event, err := newEvent(current)
+		if err != nil {
+			return err
+		}
+		if err := tx.OutboxEvent.WithContext(ctx).Create(event); err != nil {
+			return err
+		}
+
+		queued = current
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return queued, queued != nil, nil
+}
+
+func (r *ResumeDAO) GetResumeByID(ctx context.Context, id int64) (*model.Resume, bool, error) {
+	resume, err := r.query.Resume.WithContext(ctx).Where(
+		r.query.Resume.ID.Eq(id),
+		r.query.Resume.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resume, true, nil
+}
+
 func (r *ResumeDAO) UpdateResume(ctx context.Context, id int64, resume *model.Resume) error {
 	_, err := r.query.Resume.WithContext(ctx).Where(
 		r.query.Resume.ID.Eq(id),
@@ -35,3 +163,215 @@ func (r *ResumeDAO) UpdateResume(ctx context.Context, id int64, resume *model.Re
 
 	return err
 }
+
+func (r *ResumeDAO) GetResumeByFileKey(ctx context.Context, fileKey string) (*model.Resume, bool, error) {
+	resume, err := r.query.Resume.WithContext(ctx).Where(
+		r.query.Resume.FileKey.Eq(fileKey),
+		r.query.Resume.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resume, true, nil
+}
+
+func (r *ResumeDAO) ListResumes(ctx context.Context, userID int64, filter *ResumeFilter) ([]*model.Resume, int64, error) {
+	rs := r.query.Resume
+	do := rs.WithContext(ctx).Where(
+		rs.UserID.Eq(userID),
+		rs.Deleted.Is(false),
+	)
+
+	if filter.Status != nil {
+		do = do.Where(rs.Status.Eq(*filter.Status))
+	}
+	if filter.Keyword != "" {
+		do = do.Where(rs.Filename.Like("%" + filter.Keyword + "%"))
+	}
+
+	return do.Order(rs.UploadAt.Desc(), rs.ID.Desc()).FindByPage(filter.Offset, filter.Limit)
+}
+
+// DeleteResume 软删除简历并记录删除原因，返回是否实际删除
+func (r *ResumeDAO) DeleteResume(ctx context.Context, userID int64, id int64, reason string) (bool, error) {
+	info, err := r.query.Resume.WithContext(ctx).Where(
+		r.query.Resume.ID.Eq(id),
+		r.query.Resume.UserID.Eq(userID),
+		r.query.Resume.Deleted.Is(false),
+	).Updates(map[string]any{
+		"status":        StatusDeleted,
+		"deleted":       true,
+		"deleted_at":    time.Now(),
+		"delete_reason": reason,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
+
+// UpdateParseStatus 条件更新解析状态：仅当前解析状态属于 from 时更新为 to，简历状态随之更新，返回是否更新成功
+func (r *ResumeDAO) UpdateParseStatus(ctx context.Context, id int64, from []int32, to int32, parseError string) (bool, error) {
+	info, err := r.query.Resume.WithContext(ctx).Where(
+		r.query.Resume.ID.Eq(id),
+		r.query.Resume.Deleted.Is(false),
+		r.query.Resume.ParseStatus.In(from...),
+	).Updates(map[string]any{
+		"parse_status": to,
+		"status":       StatusOfParse(to),
+		"parse_error":  parseError,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
+
+// StartParse 锁定简历，由 canStart 根据当前状态判断能否开始解析，可以时更新为解析中并记录开始解析的时间，返回是否开始解析；
+// 根据锁定读到的状态判断而不依赖更新的影响行数，解析中的简历由重新投递的消息接管时状态不变同样能开始
+func (r *ResumeDAO) StartParse(ctx context.Context, id int64, canStart func(current *model.Resume) bool) (bool, error) {
+	started := false
+	err := r.query.Transaction(func(tx *query.Query) error {
+		current, err := lockResume(ctx, tx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !canStart(current) {
+			return nil
+		}
+
+		_, err = tx.Resume.WithContext(ctx).Where(
+			tx.Resume.ID.Eq(current.ID),
+		).Updates(map[string]any{
+			"parse_status":     ParseStatusParsing,
+			"status":           StatusOfParse(ParseStatusParsing),
+			"parse_error":      "",
+			"parse_started_at": time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+
+		started = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return started, nil
+}
+
+// SaveProfile 保存简历结构化信息：追加新版本并将其设为简历当前内容，返回新版本号
+func (r *ResumeDAO) SaveProfile(ctx context.Context, resume *model.Resume, source int32) (int32, error) {
+	var version int32
+	err := r.query.Transaction(func(tx *query.Query) error {
+		current, err := lockResume(ctx, tx, resume.ID)
+		if err != nil {
+			return err
+		}
+
+		version, err = appendProfileVersion(ctx, tx, current, resume.LlmParseContent, source)
+		return err
+	})
+
+	return version, err
+}
+
+// CompleteParse 保存模型解析结果并将解析状态置为成功，仅当简历仍处于解析中时生效，返回新版本号和是否保存
+func (r *ResumeDAO) CompleteParse(ctx context.Context, id int64, content string) (int32, bool, error) {
+	var version int32
+	saved := false
+	err := r.query.Transaction(func(tx *query.Query) error {
+		current, err := lockResume(ctx, tx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if current.ParseStatus != ParseStatusParsing {
+			return nil
+		}
+
+		version, err = appendProfileVersion(ctx, tx, current, content, ProfileSourceModel)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Resume.WithContext(ctx).Where(
+			tx.Resume.ID.Eq(current.ID),
+		).Updates(map[string]any{
+			"parse_status": ParseStatusParsed,
+			"status":       StatusParseSuccess,
+			"parse_error":  "",
+		})
+		saved = err == nil
+		return err
+	})
+
+	return version, saved, err
+}
+
+// lockResume 锁定简历记录，保证版本号递增、状态判断与更新之间不被并发修改
+func lockResume(ctx context.Context, tx *query.Query, id int64) (*model.Resume, error) {
+	return tx.Resume.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(
+		tx.Resume.ID.Eq(id),
+		tx.Resume.Deleted.Is(false),
+	).First()
+}
+
+// appendProfileVersion 追加结构化信息版本，并将其设为简历当前内容
+func appendProfileVersion(ctx context.Context, tx *query.Query, current *model.Resume, content string, source int32) (int32, error) {
+	version := current.ProfileVersion + 1
+	err := tx.ResumeProfileVersion.WithContext(ctx).Create(&model.ResumeProfileVersion{
+		ResumeID: current.ID,
+		UserID:   current.UserID,
+		Version:  version,
+		Source:   source,
+		Content:  content,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Resume.WithContext(ctx).Where(
+		tx.Resume.ID.Eq(current.ID),
+	).Updates(&model.Resume{
+		LlmParseContent: content,
+		ProfileVersion:  version,
+	})
+
+	return version, err
+}
+
+// ListProfileVersions 按版本号顺序获取简历结构化信息的全部版本
+func (r *ResumeDAO) ListProfileVersions(ctx context.Context, resumeID int64) ([]*model.ResumeProfileVersion, error) {
+	return r.query.ResumeProfileVersion.WithContext(ctx).Where(
+		r.query.ResumeProfileVersion.ResumeID.Eq(resumeID),
+	).Order(r.query.ResumeProfileVersion.Version).Find()
+}
+
+// CreateParseAttempt 记录一次解析
+func (r *ResumeDAO) CreateParseAttempt(ctx context.Context, attempt *model.ResumeParseAttempt) error {
+	return r.query.ResumeParseAttempt.WithContext(ctx).Create(attempt)
+}
+
+// ListParseAttempts 按时间顺序获取简历的全部解析记录
+func (r *ResumeDAO) ListParseAttempts(ctx context.Context, resumeID int64) ([]*model.ResumeParseAttempt, error) {
+	return r.query.ResumeParseAttempt.WithContext(ctx).Where(
+		r.query.ResumeParseAttempt.ResumeID.Eq(resumeID),
+	).Order(r.query.ResumeParseAttempt.ID).Find()
+}
@@ -0,0 +1,31 @@
+package entity
+
+type InterviewEvaluation struct {
+	SessionID    int64          // 会话ID
+	UserID       int64          // 用户ID
+	Status       int32          // 评估状态：1评估中 2已完成 3失败
+	OverallScore int32          // 综合得分（0-100）
+	Summary      string         // 总体评价
+	Strengths    []string       // 优势
+	Gaps         []string       // 不足
+	Suggestions  []string       // 学习建议
+	ErrorMsg     string         // 评估失败原因
+	EvaluatedAt  int64          // 评估完成时间（毫秒时间戳）
+	AnswerScores []*AnswerScore // 单题评分，按轮次顺序
+}
+
+type AnswerScore struct {
+	TurnID        int64  // 轮次ID
+	TurnNo        int32  // 轮次序号
+	Correctness   int32  // 正确性（0-10）
+	Depth         int32  // 深度（0-10）
+	Communication int32  // 表达（0-10）
+	Relevance     int32  // 与候选人技术栈的相关度（0-10）
+	Comment       string // 点评
+}
+
+// EvaluationMsg 面试评估的Kafka消息结构
+type EvaluationMsg struct {
+	SessionID int64 `json:"session_id"`
+	UserID    int64 `json:"user_id"`
+}
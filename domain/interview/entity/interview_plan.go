@@ -0,0 +1,70 @@
+package entity
+
+import "slices"
+
+// 面试环节类型
+const (
+	RoundTypeBasics       = "basics"        // 技术基础
+	RoundTypeProject      = "project"       // 项目深挖
+	RoundTypeAlgorithm    = "algorithm"     // 算法
+	RoundTypeSystemDesign = "system_design" // 系统设计
+	RoundTypeBehavioral   = "behavioral"    // 行为面试
+)
+
+// RoundTypes 所有环节类型
+var RoundTypes = []string{RoundTypeBasics, RoundTypeProject, RoundTypeAlgorithm, RoundTypeSystemDesign, RoundTypeBehavioral}
+
+// 面试官角色
+const (
+	PersonaInterviewer = "interviewer" // 技术面试官
+	PersonaTechLead    = "tech_lead"   // 技术负责人
+	PersonaArchitect   = "architect"   // 架构师
+	PersonaHR          = "hr"          // HR
+)
+
+// Personas 所有面试官角色
+var Personas = []string{PersonaInterviewer, PersonaTechLead, PersonaArchitect, PersonaHR}
+
+// Difficulties 面试难度，由易到难，与简历解析推荐的面试难度一致
+var Difficulties = []string{"初级", "中级", "高级"}
+
+type InterviewPlan struct {
+	ID          int64        // 主键ID，内置计划为固定ID
+	UserID      int64        // 创建人用户ID，内置计划为0
+	Name        string       // 计划名称
+	Description string       // 计划说明
+	Builtin     bool         // 是否为内置计划，内置计划不可修改
+	Rounds      []*PlanRound // 按顺序进行的环节
+	CreatedAt   int64        // 创建时间（毫秒时间戳）
+	UpdatedAt   int64        // 更新时间（毫秒时间戳）
+}
+
+// PlanRound 面试计划中的一个环节
+type PlanRound struct {
+	RoundNo         int32  // 环节序号（从1开始）
+	Type            string // 环节类型
+	Name            string // 环节名称
+	DurationMinutes int32  // 环节时长（分钟），0表示不限时
+	QuestionCount   int32  // 环节提问数
+	Difficulty      string // 环节难度，为空时使用面试难度
+	Persona         string // 面试官角色，为空时为技术面试官
+}
+
+// IsValidRoundType 是否为已知环节类型
+func IsValidRoundType(roundType string) bool {
+	return slices.Contains(RoundTypes, roundType)
+}
+
+// IsValidPersona 是否为已知面试官角色
+func IsValidPersona(persona string) bool {
+	return slices.Contains(Personas, persona)
+}
+
+// TotalQuestions 计划中所有环节的提问数之和
+func (p *InterviewPlan) TotalQuestions() int32 {
+	var total int32
+	for _, round := range p.Rounds {
+		total += round.QuestionCount
+	}
+	return total
+}
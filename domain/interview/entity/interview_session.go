@@ -0,0 +1,64 @@
+package entity
+
+type InterviewSession struct {
+	ID                 int64    // 主键ID
+	UserID             int64    // 用户ID
+	ResumeID           int64    // 简历ID
+	JobDescriptionID   int64    // 目标岗位描述ID，0表示不针对具体岗位
+	PlanID             int64    // 面试计划ID，0表示不使用面试计划
+	PanelPersonas      []string // 面试官小组成员的角色，为空表示不使用面试官小组
+	Status             int32    // 会话状态：1进行中 2已结束
+	Difficulty         string   // 面试难度
+	FocusAreas         []string // 面试关注领域
+	QuestionDirections []string // 建议的提问方向
+	TechStack          []string // 候选人技术栈
+	TurnCount          int32    // 已回答轮次
+	MaxTurns           int32    // 最大提问轮次
+	CurrentRound       int32    // 当前面试环节序号，0表示尚未开始或不使用面试计划
+	StartedAt          int64    // 开始时间（毫秒时间戳）
+	EndedAt            int64    // 结束时间（毫秒时间戳）
+}
+
+type InterviewTurn struct {
+	ID         int64  // 主键ID
+	SessionID  int64  // 会话ID
+	TurnNo     int32  // 轮次序号（从1开始）
+	RoundNo    int32  // 所属面试环节序号，0表示不使用面试计划
+	Persona    string // 提问的面试官角色，为空表示未区分角色
+	Question   string // 面试官提问
+	Answer     string // 候选人回答
+	Status     int32  // 轮次状态：1待回答 2已回答
+	AnsweredAt int64  // 回答时间（毫秒时间戳）
+	CreatedAt  int64  // 创建时间（毫秒时间戳）
+}
+
+// InterviewSessionRound 会话的面试环节，开始面试时从面试计划复制
+type InterviewSessionRound struct {
+	ID              int64  // 主键ID
+	SessionID       int64  // 会话ID
+	RoundNo         int32  // 环节序号（从1开始）
+	Type            string // 环节类型
+	Name            string // 环节名称
+	DurationMinutes int32  // 环节时长（分钟），0表示不限时
+	QuestionCount   int32  // 环节提问数
+	Difficulty      string // 环节难度，为空时使用面试难度
+	Persona         string // 面试官角色
+	Status          int32  // 环节状态：1未开始 2进行中 3已完成
+	StartedAt       int64  // 开始时间（毫秒时间戳）
+	EndedAt         int64  // 结束时间（毫秒时间戳）
+}
+
+// IsEnded 会话是否已结束（结束时会同时记录结束时间）
+func (s *InterviewSession) IsEnded() bool {
+	return s.EndedAt > 0
+}
+
+// IsAnswered 该轮问题是否已回答（回答时会同时记录回答时间）
+func (t *InterviewTurn) IsAnswered() bool {
+	return t.AnsweredAt > 0
+}
+
+// IsFinished 环节是否已完成（完成时会同时记录结束时间）
+func (r *InterviewSessionRound) IsFinished() bool {
+	return r.EndedAt > 0
+}
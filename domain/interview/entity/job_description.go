@@ -0,0 +1,17 @@
+package entity
+
+type JobDescription struct {
+	ID            int64  // 主键ID
+	UserID        int64  // 用户ID
+	Title         string // 岗位名称
+	Company       string // 公司名称
+	Source        int32  // 来源：1粘贴文本 2上传文件
+	FileKey       string // 上传文件在对象存储中的唯一标识
+	Filename      string // 上传文件的原始文件名
+	Filetype      string // 上传文件的类型
+	Content       string // 岗位描述原文
+	ParseStatus   int32  // 解析状态：1解析中 2成功 3失败
+	ParseError    string // 解析失败原因
+	ParsedContent string // 大模型解析结果（JSON）
+	CreatedAt     int64  // 创建时间（毫秒时间戳）
+}
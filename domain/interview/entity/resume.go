@@ -1,5 +1,36 @@
 package entity
 
+import "strings"
+
+// ParseFailReason 简历解析失败原因分类，写入 parse_error 的前缀
+type ParseFailReason string
+
+const (
+	ParseFailUnsupportedFile ParseFailReason = "unsupported_file" // 不支持的文件类型
+	ParseFailUnreadableFile  ParseFailReason = "unreadable_file"  // 文件损坏、加密或无法提取文字（如未识别的扫描件）
+	ParseFailModelTimeout    ParseFailReason = "model_timeout"    // 模型响应超时
+	ParseFailModelError      ParseFailReason = "model_error"      // 模型未配置或调用失败
+	ParseFailQuotaExceeded   ParseFailReason = "quota_exceeded"   // 今日共享模型用量已达配额
+	ParseFailInvalidJSON     ParseFailReason = "invalid_json"     // 模型返回的内容无法解析为 JSON
+	ParseFailEmptyResult     ParseFailReason = "empty_result"     // 模型未返回内容或解析结果全为空
+	ParseFailInternal        ParseFailReason = "internal"         // 存储、数据库等内部错误
+	ParseFailQueue           ParseFailReason = "queue_failed"     // 解析任务投递失败
+)
+
+// FormatParseError 生成 parse_error 内容：分类: 详情
+func FormatParseError(reason ParseFailReason, detail string) string {
+	return string(reason) + ": " + detail
+}
+
+// ParseFailReasonOf 从 parse_error 中取出失败原因分类
+func ParseFailReasonOf(parseError string) ParseFailReason {
+	reason, _, found := strings.Cut(parseError, ": ")
+	if !found {
+		return ""
+	}
+	return ParseFailReason(reason)
+}
+
 type Resume struct {
 	ID          int64  // 主键ID
 	UserID      int64  // 用户ID
@@ -8,12 +39,41 @@ type Resume struct {
 	Filetype    string // 文件类型，如 pdf/docx
 	Filesize    int64  // 文件大小（字节）
 	Status      int32  // 简历状态：1已上传 2解析中 3已解析 4已删除 5失败
-	ParseStatus int32  // 解析状态：0未开始 1解析中 2成功 3失败
-	ParseError  string // 解析失败原因摘要
+	ParseStatus int32  // 解析状态：0已上传 1已入队 2解析中 3成功 4失败
+	ParseError  string // 解析失败原因，格式为 分类: 详情
 	UploadAt    int64  // 更新时间
+
+	ParseAttempts int32 // 解析任务投递次数
+
+	LlmParseContent string // 大模型解析结果（JSON），用户修改后为修改后的内容
+	ProfileVersion  int32  // 当前结构化信息版本号
+}
+
+// ResumeProfileVersion 简历结构化信息的一个版本
+type ResumeProfileVersion struct {
+	ID        int64  // 主键ID
+	ResumeID  int64  // 简历ID
+	Version   int32  // 版本号
+	Source    int32  // 来源：1模型解析 2用户修改
+	Content   string // 结构化简历信息（JSON）
+	CreatedAt int64  // 创建时间
+}
+
+// ResumeParseAttempt 一次解析记录，自动重试也各记一条
+type ResumeParseAttempt struct {
+	ID         int64           // 主键ID
+	ResumeID   int64           // 简历ID
+	Attempt    int32           // 第几次投递
+	Retry      int32           // 同一次投递内的自动重试序号，0表示首次执行
+	Model      string          // 使用的模型
+	DurationMs int64           // 耗时（毫秒）
+	Success    bool            // 是否成功
+	FailReason ParseFailReason // 失败原因分类
+	Error      string          // 失败详情
+	CreatedAt  int64           // 创建时间
 }
 
-// ResumeMsg Kafka消息结构
+// ResumeMsg 简历解析的Kafka消息结构
 type ResumeMsg struct {
 	FileKey  string `json:"file_key"`
 	FileID   int64  `json:"file_id"`
@@ -21,4 +81,5 @@ type ResumeMsg struct {
 	Filetype string `json:"filetype"`
 	Filesize int64  `json:"filesize"`
 	UserID   int64  `json:"user_id"`
+	Attempt  int32  `json:"attempt"` // 第几次投递，首次上传为1，每次重新解析加一
 }
@@ -0,0 +1,10 @@
+package event
+
+import "time"
+
+// InterviewEvaluateEvent 面试评估事件
+type InterviewEvaluateEvent struct {
+	SessionID int64     `json:"session_id"` // 会话ID
+	UserID    int64     `json:"user_id"`    // 用户ID
+	CreatedAt time.Time `json:"created_at"` // 事件创建时间
+}
@@ -10,5 +10,6 @@ type ResumeParseEvent struct {
 	Filename  string    `json:"filename"`   // 文件名
 	Filetype  string    `json:"filetype"`   // 文件类型
 	Filesize  int64     `json:"filesize"`   // 文件大小
+	Attempt   int32     `json:"attempt"`    // 第几次投递，从1开始
 	CreatedAt time.Time `json:"created_at"` // 事件创建时间
 }
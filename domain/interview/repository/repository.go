@@ -4,6 +4,7 @@ import (
 	"context"
 	"mianshiba/domain/interview/dal"
 	"mianshiba/domain/interview/dal/model"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -14,5 +15,85 @@ func NewResumeRepo(db *gorm.DB) ResumeRepository {
 
 type ResumeRepository interface {
 	Create(ctx context.Context, resume *model.Resume) error
+	CreateQueued(ctx context.Context, resume *model.Resume, event *model.OutboxEvent) error
+	GetResumeByID(ctx context.Context, id int64) (*model.Resume, bool, error)
+	GetResumeByFileKey(ctx context.Context, fileKey string) (*model.Resume, bool, error)
+	ListResumes(ctx context.Context, userID int64, filter *dal.ResumeFilter) ([]*model.Resume, int64, error)
 	UpdateResume(ctx context.Context, id int64, resume *model.Resume) error
+	DeleteResume(ctx context.Context, userID int64, id int64, reason string) (bool, error)
+	UpdateParseStatus(ctx context.Context, id int64, from []int32, to int32, parseError string) (bool, error)
+	StartParse(ctx context.Context, id int64, canStart func(current *model.Resume) bool) (bool, error)
+	QueueParse(ctx context.Context, id int64, canQueue func(current *model.Resume) bool, newEvent func(resume *model.Resume) (*model.OutboxEvent, error)) (*model.Resume, bool, error)
+	SaveProfile(ctx context.Context, resume *model.Resume, source int32) (int32, error)
+	CompleteParse(ctx context.Context, id int64, content string) (int32, bool, error)
+	ListProfileVersions(ctx context.Context, resumeID int64) ([]*model.ResumeProfileVersion, error)
+	CreateParseAttempt(ctx context.Context, attempt *model.ResumeParseAttempt) error
+	ListParseAttempts(ctx context.Context, resumeID int64) ([]*model.ResumeParseAttempt, error)
+}
+
+func NewOutboxRepo(db *gorm.DB) OutboxRepository {
+	return dal.NewOutboxDAO(db)
+}
+
+type OutboxRepository interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error
+}
+
+func NewInterviewSessionRepo(db *gorm.DB) InterviewSessionRepository {
+	return dal.NewInterviewSessionDAO(db)
+}
+
+type InterviewSessionRepository interface {
+	CreateSession(ctx context.Context, session *model.InterviewSession, rounds []*model.InterviewSessionRound) error
+	GetSessionByID(ctx context.Context, id int64) (*model.InterviewSession, bool, error)
+	UpdateSession(ctx context.Context, id int64, session *model.InterviewSession) error
+	EndSession(ctx context.Context, id int64, endedAt int64) (bool, error)
+	CreateTurn(ctx context.Context, turn *model.InterviewTurn) error
+	ListTurns(ctx context.Context, sessionID int64) ([]*model.InterviewTurn, error)
+	AnswerTurn(ctx context.Context, turnID int64, answer string, answeredAt int64) (bool, error)
+	ListRounds(ctx context.Context, sessionID int64) ([]*model.InterviewSessionRound, error)
+	StartRound(ctx context.Context, sessionID int64, roundNo int32, startedAt int64) (bool, error)
+}
+
+func NewInterviewPlanRepo(db *gorm.DB) InterviewPlanRepository {
+	return dal.NewInterviewPlanDAO(db)
+}
+
+type InterviewPlanRepository interface {
+	CreatePlan(ctx context.Context, plan *model.InterviewPlan, rounds []*model.InterviewPlanRound) error
+	GetPlanByID(ctx context.Context, id int64) (*model.InterviewPlan, bool, error)
+	ListPlans(ctx context.Context, userID int64) ([]*model.InterviewPlan, error)
+	ListPlanRounds(ctx context.Context, planIDs []int64) (map[int64][]*model.InterviewPlanRound, error)
+	UpdatePlan(ctx context.Context, id int64, updates map[string]any, rounds []*model.InterviewPlanRound) error
+	DeletePlan(ctx context.Context, userID int64, id int64) (bool, error)
+}
+
+func NewJobDescriptionRepo(db *gorm.DB) JobDescriptionRepository {
+	return dal.NewJobDescriptionDAO(db)
+}
+
+type JobDescriptionRepository interface {
+	CreateJobDescription(ctx context.Context, jd *model.JobDescription) error
+	GetJobDescriptionByID(ctx context.Context, id int64) (*model.JobDescription, bool, error)
+	ListJobDescriptions(ctx context.Context, userID int64, filter *dal.JobDescriptionFilter) ([]*model.JobDescription, int64, error)
+	DeleteJobDescription(ctx context.Context, userID int64, id int64) (bool, error)
+	StartJobDescriptionParse(ctx context.Context, id int64, staleBefore time.Time) (bool, error)
+	CompleteJobDescriptionParse(ctx context.Context, id int64, content string, title string, company string) (bool, error)
+	FailJobDescriptionParse(ctx context.Context, id int64, parseError string) (bool, error)
+}
+
+func NewInterviewEvaluationRepo(db *gorm.DB) InterviewEvaluationRepository {
+	return dal.NewInterviewEvaluationDAO(db)
+}
+
+type InterviewEvaluationRepository interface {
+	CreateEvaluation(ctx context.Context, evaluation *model.InterviewEvaluation) error
+	CreateQueued(ctx context.Context, evaluation *model.InterviewEvaluation, event *model.OutboxEvent) error
+	Requeue(ctx context.Context, sessionID int64, staleBefore time.Time, event *model.OutboxEvent) (bool, error)
+	GetEvaluationBySessionID(ctx context.Context, sessionID int64) (*model.InterviewEvaluation, bool, error)
+	SaveEvaluationResult(ctx context.Context, sessionID int64, evaluation *model.InterviewEvaluation, scores []*model.InterviewAnswerScore) error
+	FailEvaluation(ctx context.Context, sessionID int64, errMsg string) error
+	ListAnswerScores(ctx context.Context, sessionID int64) ([]*model.InterviewAnswerScore, error)
 }
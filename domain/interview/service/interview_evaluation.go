@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/interview/entity"
+)
+
+type InterviewEvaluation interface {
+	// Prepare 为已结束的会话创建待评估记录，评估失败或评估中超时时重新发起；
+	// 评估消息与评估记录在同一事务中写入事务消息表，queued 为 true 表示本次投递了评估消息
+	Prepare(ctx context.Context, session *entity.InterviewSession) (evaluation *entity.InterviewEvaluation, queued bool, err error)
+	Get(ctx context.Context, sessionID int64) (evaluation *entity.InterviewEvaluation, err error)
+}
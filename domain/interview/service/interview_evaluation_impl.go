@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/repository"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"strconv"
+	"time"
+)
+
+// evaluationPendingTimeout 评估中超过该时长仍未完成时，视为评估消息丢失，可重新投递
+const evaluationPendingTimeout = 10 * time.Minute
+
+type InterviewEvaluationComponents struct {
+	IDGen           idgen.IDGenerator
+	EvaluationRepo  repository.InterviewEvaluationRepository
+	EvaluationTopic string // 面试评估消息的 Kafka 主题
+}
+
+func NewInterviewEvaluationDomain(ctx context.Context, c *InterviewEvaluationComponents) InterviewEvaluation {
+	return &interviewEvaluationImpl{
+		InterviewEvaluationComponents: c,
+	}
+}
+
+type interviewEvaluationImpl struct {
+	*InterviewEvaluationComponents
+}
+
+func (e *interviewEvaluationImpl) Prepare(ctx context.Context, session *entity.InterviewSession) (evaluation *entity.InterviewEvaluation, queued bool, err error) {
+	if !session.IsEnded() {
+		return nil, false, errorx.New(errno.ErrInterviewSessionNotEndedCode, errorx.KV("msg", "Interview session has not ended"))
+	}
+
+	evaluationPo, exist, err := e.EvaluationRepo.GetEvaluationBySessionID(ctx, session.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if exist {
+		return e.requeue(ctx, session, evaluationPo)
+	}
+
+	evaluationID, err := e.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("generate id error: %w", err)
+	}
+
+	event, err := e.newEvaluationEvent(session)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newEvaluation := &model.InterviewEvaluation{
+		ID:        evaluationID,
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		Status:    dal.EvaluationStatusPending,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// session_id 唯一，并发创建失败时以已存在的记录为准
+	if err = e.EvaluationRepo.CreateQueued(ctx, newEvaluation, event); err != nil {
+		evaluationPo, exist, getErr := e.EvaluationRepo.GetEvaluationBySessionID(ctx, session.ID)
+		if getErr != nil || !exist {
+			return nil, false, err
+		}
+
+		return evaluationPo2Do(evaluationPo, nil), false, nil
+	}
+
+	return evaluationPo2Do(newEvaluation, nil), true, nil
+}
+
+// requeue 评估失败或评估中超时时重新投递评估消息，其余状态原样返回
+func (e *interviewEvaluationImpl) requeue(ctx context.Context, session *entity.InterviewSession, evaluationPo *model.InterviewEvaluation) (*entity.InterviewEvaluation, bool, error) {
+	staleBefore := time.Now().Add(-evaluationPendingTimeout)
+	retryable := evaluationPo.Status == dal.EvaluationStatusFailed ||
+		(evaluationPo.Status == dal.EvaluationStatusPending && evaluationPo.UpdatedAt.Before(staleBefore))
+	if !retryable {
+		return evaluationPo2Do(evaluationPo, nil), false, nil
+	}
+
+	event, err := e.newEvaluationEvent(session)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 条件更新，并发获取评估报告时只有一个调用方重新投递
+	requeued, err := e.EvaluationRepo.Requeue(ctx, session.ID, staleBefore, event)
+	if err != nil {
+		return nil, false, err
+	}
+
+	evaluationPo, exist, err := e.EvaluationRepo.GetEvaluationBySessionID(ctx, session.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !exist {
+		return nil, false, errorx.New(errno.ErrInterviewEvaluationNotFoundCode, errorx.KV("msg", "Interview evaluation not found"))
+	}
+
+	return evaluationPo2Do(evaluationPo, nil), requeued, nil
+}
+
+// newEvaluationEvent 生成面试评估消息，以会话ID作为消息键
+func (e *interviewEvaluationImpl) newEvaluationEvent(session *entity.InterviewSession) (*model.OutboxEvent, error) {
+	payload, err := json.Marshal(&entity.EvaluationMsg{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OutboxEvent{
+		Topic:   e.EvaluationTopic,
+		MsgKey:  strconv.FormatInt(session.ID, 10),
+		Payload: string(payload),
+	}, nil
+}
+
+func (e *interviewEvaluationImpl) Get(ctx context.Context, sessionID int64) (evaluation *entity.InterviewEvaluation, err error) {
+	evaluationPo, exist, err := e.EvaluationRepo.GetEvaluationBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewEvaluationNotFoundCode, errorx.KV("msg", "Interview evaluation not found"))
+	}
+
+	scorePos, err := e.EvaluationRepo.ListAnswerScores(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluationPo2Do(evaluationPo, scorePos), nil
+}
+
+func evaluationPo2Do(model *model.InterviewEvaluation, scorePos []*model.InterviewAnswerScore) *entity.InterviewEvaluation {
+	evaluation := &entity.InterviewEvaluation{
+		SessionID:    model.SessionID,
+		UserID:       model.UserID,
+		Status:       model.Status,
+		OverallScore: model.OverallScore,
+		Summary:      model.Summary,
+		Strengths:    model.Strengths,
+		Gaps:         model.Gaps,
+		Suggestions:  model.Suggestions,
+		ErrorMsg:     model.ErrorMsg,
+		EvaluatedAt:  model.EvaluatedAt,
+		AnswerScores: make([]*entity.AnswerScore, 0, len(scorePos)),
+	}
+
+	for _, scorePo := range scorePos {
+		evaluation.AnswerScores = append(evaluation.AnswerScores, &entity.AnswerScore{
+			TurnID:        scorePo.TurnID,
+			TurnNo:        scorePo.TurnNo,
+			Correctness:   scorePo.Correctness,
+			Depth:         scorePo.Depth,
+			Communication: scorePo.Communication,
+			Relevance:     scorePo.Relevance,
+			Comment:       scorePo.Comment,
+		})
+	}
+
+	return evaluation
+}
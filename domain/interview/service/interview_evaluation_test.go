@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryEvaluationRepo struct {
+	evaluations map[int64]*model.InterviewEvaluation
+	outbox      *memoryOutboxRepo
+	events      []*model.OutboxEvent
+}
+
+func (m *memoryEvaluationRepo) CreateEvaluation(ctx context.Context, evaluation *model.InterviewEvaluation) error {
+	m.evaluations[evaluation.SessionID] = evaluation
+	return nil
+}
+
+func (m *memoryEvaluationRepo) CreateQueued(ctx context.Context, evaluation *model.InterviewEvaluation, event *model.OutboxEvent) error {
+	m.evaluations[evaluation.SessionID] = evaluation
+	m.addEvent(event)
+	return nil
+}
+
+func (m *memoryEvaluationRepo) Requeue(ctx context.Context, sessionID int64, staleBefore time.Time, event *model.OutboxEvent) (bool, error) {
+	evaluation, ok := m.evaluations[sessionID]
+	if !ok {
+		return false, nil
+	}
+	for _, queued := range m.events {
+		if queued.Topic == event.Topic && queued.MsgKey == event.MsgKey && !slices.Contains(m.outbox.sent, queued.ID) {
+			return false, nil
+		}
+	}
+	if evaluation.Status != dal.EvaluationStatusFailed &&
+		(evaluation.Status != dal.EvaluationStatusPending || !evaluation.UpdatedAt.Before(staleBefore)) {
+		return false, nil
+	}
+
+	evaluation.Status = dal.EvaluationStatusPending
+	evaluation.ErrorMsg = ""
+	evaluation.UpdatedAt = time.Now()
+	m.addEvent(event)
+	return true, nil
+}
+
+func (m *memoryEvaluationRepo) GetEvaluationBySessionID(ctx context.Context, sessionID int64) (*model.InterviewEvaluation, bool, error) {
+	evaluation, ok := m.evaluations[sessionID]
+	return evaluation, ok, nil
+}
+
+func (m *memoryEvaluationRepo) SaveEvaluationResult(ctx context.Context, sessionID int64, evaluation *model.InterviewEvaluation, scores []*model.InterviewAnswerScore) error {
+	m.evaluations[sessionID].Status = dal.EvaluationStatusFinished
+	return nil
+}
+
+func (m *memoryEvaluationRepo) FailEvaluation(ctx context.Context, sessionID int64, errMsg string) error {
+	m.evaluations[sessionID].Status = dal.EvaluationStatusFailed
+	m.evaluations[sessionID].ErrorMsg = errMsg
+	return nil
+}
+
+func (m *memoryEvaluationRepo) ListAnswerScores(ctx context.Context, sessionID int64) ([]*model.InterviewAnswerScore, error) {
+	return nil, nil
+}
+
+func (m *memoryEvaluationRepo) addEvent(event *model.OutboxEvent) {
+	event.ID = int64(len(m.events) + 1)
+	m.outbox.pending = append(m.outbox.pending, event)
+	m.events = append(m.events, event)
+}
+
+type sequenceIDGen struct {
+	next int64
+}
+
+func (g *sequenceIDGen) GenID(ctx context.Context) (int64, error) {
+	g.next++
+	return g.next, nil
+}
+
+func (g *sequenceIDGen) GenMultiIDs(ctx context.Context, counts int) ([]int64, error) {
+	ids := make([]int64, 0, counts)
+	for range counts {
+		id, _ := g.GenID(ctx)
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func TestInterviewEvaluationPrepare(t *testing.T) {
+	ctx := context.Background()
+	outbox := &memoryOutboxRepo{retries: map[int64]time.Time{}}
+	repo := &memoryEvaluationRepo{evaluations: map[int64]*model.InterviewEvaluation{}, outbox: outbox}
+	evaluationSVC := NewInterviewEvaluationDomain(ctx, &InterviewEvaluationComponents{
+		IDGen:           &sequenceIDGen{},
+		EvaluationRepo:  repo,
+		EvaluationTopic: "interview_evaluation",
+	})
+	session := &entity.InterviewSession{ID: 7, UserID: 3, EndedAt: time.Now().UnixMilli()}
+
+	// 面试结束时评估记录和评估消息一起写入
+	evaluation, queued, err := evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.True(t, queued)
+	assert.Equal(t, int32(dal.EvaluationStatusPending), evaluation.Status)
+	assert.Len(t, outbox.pending, 1)
+	assert.Equal(t, "interview_evaluation", outbox.pending[0].Topic)
+	assert.Equal(t, "7", outbox.pending[0].MsgKey)
+	assert.JSONEq(t, `{"session_id":7,"user_id":3}`, outbox.pending[0].Payload)
+
+	// 评估中未超时，不重复投递
+	_, queued, err = evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.False(t, queued)
+	assert.Len(t, outbox.pending, 1)
+
+	// 消息发送失败，留在事务消息表等待重试
+	relay := &outboxRelayImpl{&OutboxRelayComponents{OutboxRepo: outbox, KafkaProducer: &flakyProducer{failTopic: "interview_evaluation"}}}
+	assert.Equal(t, 1, relay.relayBatch(ctx))
+	assert.Contains(t, outbox.retries, int64(1))
+
+	// 评估中超时但评估消息仍未投递，由投递任务继续重试，不再写入新的消息
+	repo.evaluations[session.ID].UpdatedAt = time.Now().Add(-evaluationPendingTimeout - time.Minute)
+	_, queued, err = evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.False(t, queued)
+	assert.Empty(t, outbox.pending)
+
+	// 重试投递成功
+	outbox.pending = append(outbox.pending, repo.events[0])
+	delete(outbox.retries, repo.events[0].ID)
+	producer := &flakyProducer{}
+	relay = &outboxRelayImpl{&OutboxRelayComponents{OutboxRepo: outbox, KafkaProducer: producer}}
+	assert.Equal(t, 1, relay.relayBatch(ctx))
+	assert.Equal(t, []string{`{"session_id":7,"user_id":3}`}, producer.messages)
+
+	// 消息已投递但评估中超时，视为消息丢失，重新投递评估消息
+	evaluation, queued, err = evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.True(t, queued)
+	assert.Equal(t, int32(dal.EvaluationStatusPending), evaluation.Status)
+	assert.Len(t, outbox.pending, 1)
+	assert.Equal(t, 1, relay.relayBatch(ctx))
+
+	// 评估失败后获取评估报告，重新投递并清空失败原因
+	assert.NoError(t, repo.FailEvaluation(ctx, session.ID, "model unavailable"))
+	evaluation, queued, err = evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.True(t, queued)
+	assert.Equal(t, int32(dal.EvaluationStatusPending), evaluation.Status)
+	assert.Empty(t, evaluation.ErrorMsg)
+	assert.Len(t, outbox.pending, 1)
+
+	// 评估完成后不再投递
+	assert.NoError(t, repo.SaveEvaluationResult(ctx, session.ID, &model.InterviewEvaluation{}, nil))
+	evaluation, queued, err = evaluationSVC.Prepare(ctx, session)
+	assert.NoError(t, err)
+	assert.False(t, queued)
+	assert.Equal(t, int32(dal.EvaluationStatusFinished), evaluation.Status)
+	assert.Len(t, outbox.pending, 1)
+}
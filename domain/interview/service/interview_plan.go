@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/interview/entity"
+)
+
+const (
+	MaxPlanRounds        = 10  // 面试计划的最大环节数
+	MaxRoundQuestions    = 20  // 单个环节的最大提问数
+	MaxRoundDurationMins = 180 // 单个环节的最大时长（分钟）
+)
+
+// SavePlanRequest 创建或修改面试计划，环节按切片顺序重新编号
+type SavePlanRequest struct {
+	Name        string
+	Description string
+	Rounds      []*entity.PlanRound
+}
+
+type InterviewPlan interface {
+	// List 内置计划在前，之后为用户创建的计划
+	List(ctx context.Context, userID int64) (plans []*entity.InterviewPlan, err error)
+	// Get 获取内置计划或用户本人的计划
+	Get(ctx context.Context, userID int64, id int64) (plan *entity.InterviewPlan, err error)
+	Create(ctx context.Context, userID int64, req *SavePlanRequest) (plan *entity.InterviewPlan, err error)
+	// Update/Delete 只允许创建人操作，内置计划不可修改
+	Update(ctx context.Context, userID int64, id int64, req *SavePlanRequest) (plan *entity.InterviewPlan, err error)
+	Delete(ctx context.Context, userID int64, id int64) error
+}
@@ -0,0 +1,52 @@
+package service
+
+import "mianshiba/domain/interview/entity"
+
+// builtinPlans 内置面试计划，所有用户可见且不可修改；ID 固定，不会与 IDGen 生成的 ID 冲突
+var builtinPlans = []*entity.InterviewPlan{
+	{
+		ID:          1,
+		Name:        "标准技术面试",
+		Description: "技术基础、项目深挖、算法和行为面试，适合大多数开发岗位",
+		Builtin:     true,
+		Rounds: []*entity.PlanRound{
+			{RoundNo: 1, Type: entity.RoundTypeBasics, Name: "技术基础", DurationMinutes: 15, QuestionCount: 3, Persona: entity.PersonaInterviewer},
+			{RoundNo: 2, Type: entity.RoundTypeProject, Name: "项目深挖", DurationMinutes: 20, QuestionCount: 3, Persona: entity.PersonaTechLead},
+			{RoundNo: 3, Type: entity.RoundTypeAlgorithm, Name: "算法", DurationMinutes: 20, QuestionCount: 1, Persona: entity.PersonaInterviewer},
+			{RoundNo: 4, Type: entity.RoundTypeBehavioral, Name: "行为面试", DurationMinutes: 10, QuestionCount: 2, Persona: entity.PersonaHR},
+		},
+	},
+	{
+		ID:          2,
+		Name:        "后端综合面试",
+		Description: "在标准技术面试基础上增加系统设计，适合中高级后端岗位",
+		Builtin:     true,
+		Rounds: []*entity.PlanRound{
+			{RoundNo: 1, Type: entity.RoundTypeBasics, Name: "技术基础", DurationMinutes: 15, QuestionCount: 3, Persona: entity.PersonaInterviewer},
+			{RoundNo: 2, Type: entity.RoundTypeProject, Name: "项目深挖", DurationMinutes: 20, QuestionCount: 3, Persona: entity.PersonaTechLead},
+			{RoundNo: 3, Type: entity.RoundTypeAlgorithm, Name: "算法", DurationMinutes: 25, QuestionCount: 2, Persona: entity.PersonaInterviewer},
+			{RoundNo: 4, Type: entity.RoundTypeSystemDesign, Name: "系统设计", DurationMinutes: 30, QuestionCount: 2, Persona: entity.PersonaArchitect},
+			{RoundNo: 5, Type: entity.RoundTypeBehavioral, Name: "行为面试", DurationMinutes: 10, QuestionCount: 2, Persona: entity.PersonaHR},
+		},
+	},
+	{
+		ID:          3,
+		Name:        "快速面试",
+		Description: "技术基础、项目经历和行为面试各问几题，适合碎片时间练习",
+		Builtin:     true,
+		Rounds: []*entity.PlanRound{
+			{RoundNo: 1, Type: entity.RoundTypeBasics, Name: "技术基础", DurationMinutes: 10, QuestionCount: 2, Persona: entity.PersonaInterviewer},
+			{RoundNo: 2, Type: entity.RoundTypeProject, Name: "项目经历", DurationMinutes: 10, QuestionCount: 2, Persona: entity.PersonaTechLead},
+			{RoundNo: 3, Type: entity.RoundTypeBehavioral, Name: "行为面试", DurationMinutes: 5, QuestionCount: 1, Persona: entity.PersonaHR},
+		},
+	},
+}
+
+func getBuiltinPlan(id int64) (*entity.InterviewPlan, bool) {
+	for _, plan := range builtinPlans {
+		if plan.ID == id {
+			return plan, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/repository"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxPlanNameLength        = 128 // 与 interview_plan.name 列长度一致
+	maxPlanDescriptionLength = 512 // 与 interview_plan.description 列长度一致
+	maxRoundNameLength       = 64  // 与 interview_plan_round.name 列长度一致
+)
+
+// roundTypeNames 环节名称为空时使用的默认名称
+var roundTypeNames = map[string]string{
+	entity.RoundTypeBasics:       "技术基础",
+	entity.RoundTypeProject:      "项目深挖",
+	entity.RoundTypeAlgorithm:    "算法",
+	entity.RoundTypeSystemDesign: "系统设计",
+	entity.RoundTypeBehavioral:   "行为面试",
+}
+
+type InterviewPlanComponents struct {
+	IDGen    idgen.IDGenerator
+	PlanRepo repository.InterviewPlanRepository
+}
+
+func NewInterviewPlanDomain(ctx context.Context, c *InterviewPlanComponents) InterviewPlan {
+	return &interviewPlanImpl{
+		InterviewPlanComponents: c,
+	}
+}
+
+type interviewPlanImpl struct {
+	*InterviewPlanComponents
+}
+
+func (p *interviewPlanImpl) List(ctx context.Context, userID int64) (plans []*entity.InterviewPlan, err error) {
+	pos, err := p.PlanRepo.ListPlans(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(pos))
+	for _, po := range pos {
+		ids = append(ids, po.ID)
+	}
+	rounds, err := p.PlanRepo.ListPlanRounds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	plans = make([]*entity.InterviewPlan, 0, len(builtinPlans)+len(pos))
+	plans = append(plans, builtinPlans...)
+	for _, po := range pos {
+		plans = append(plans, planPo2Do(po, rounds[po.ID]))
+	}
+
+	return plans, nil
+}
+
+func (p *interviewPlanImpl) Get(ctx context.Context, userID int64, id int64) (plan *entity.InterviewPlan, err error) {
+	if plan, ok := getBuiltinPlan(id); ok {
+		return plan, nil
+	}
+
+	po, exist, err := p.PlanRepo.GetPlanByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewPlanNotFoundCode, errorx.KV("msg", "Interview plan not found"))
+	}
+
+	if po.UserID != userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this interview plan"))
+	}
+
+	rounds, err := p.PlanRepo.ListPlanRounds(ctx, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return planPo2Do(po, rounds[id]), nil
+}
+
+func (p *interviewPlanImpl) Create(ctx context.Context, userID int64, req *SavePlanRequest) (plan *entity.InterviewPlan, err error) {
+	plan = normalizePlan(req)
+	if msg := validatePlan(plan); msg != "" {
+		return nil, errorx.New(errno.ErrInterviewPlanInvalidCode, errorx.KV("msg", msg))
+	}
+
+	planID, err := p.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("generate id error: %w", err)
+	}
+
+	now := time.Now()
+	po := &model.InterviewPlan{
+		ID:          planID,
+		UserID:      userID,
+		Name:        plan.Name,
+		Description: plan.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err = p.PlanRepo.CreatePlan(ctx, po, planRoundDo2Po(planID, plan.Rounds)); err != nil {
+		return nil, err
+	}
+
+	return p.Get(ctx, userID, planID)
+}
+
+func (p *interviewPlanImpl) Update(ctx context.Context, userID int64, id int64, req *SavePlanRequest) (plan *entity.InterviewPlan, err error) {
+	if _, err = p.getOwnedPlan(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	plan = normalizePlan(req)
+	if msg := validatePlan(plan); msg != "" {
+		return nil, errorx.New(errno.ErrInterviewPlanInvalidCode, errorx.KV("msg", msg))
+	}
+
+	updates := map[string]any{
+		"name":        plan.Name,
+		"description": plan.Description,
+	}
+	if err = p.PlanRepo.UpdatePlan(ctx, id, updates, planRoundDo2Po(id, plan.Rounds)); err != nil {
+		return nil, err
+	}
+
+	return p.Get(ctx, userID, id)
+}
+
+func (p *interviewPlanImpl) Delete(ctx context.Context, userID int64, id int64) error {
+	if _, err := p.getOwnedPlan(ctx, userID, id); err != nil {
+		return err
+	}
+
+	deleted, err := p.PlanRepo.DeletePlan(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errorx.New(errno.ErrInterviewPlanNotFoundCode, errorx.KV("msg", "Interview plan not found"))
+	}
+
+	return nil
+}
+
+// getOwnedPlan 获取面试计划并校验是否为 userID 创建，内置计划不可修改
+func (p *interviewPlanImpl) getOwnedPlan(ctx context.Context, userID int64, id int64) (*entity.InterviewPlan, error) {
+	if _, ok := getBuiltinPlan(id); ok {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "Built-in interview plan cannot be modified"))
+	}
+
+	return p.Get(ctx, userID, id)
+}
+
+// normalizePlan 去除首尾空白、补全默认值，并按顺序为环节重新编号
+func normalizePlan(req *SavePlanRequest) *entity.InterviewPlan {
+	plan := &entity.InterviewPlan{
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Rounds:      make([]*entity.PlanRound, 0, len(req.Rounds)),
+	}
+
+	for i, r := range req.Rounds {
+		if r == nil {
+			continue
+		}
+
+		round := &entity.PlanRound{
+			RoundNo:         int32(i + 1),
+			Type:            strings.TrimSpace(r.Type),
+			Name:            strings.TrimSpace(r.Name),
+			DurationMinutes: r.DurationMinutes,
+			QuestionCount:   r.QuestionCount,
+			Difficulty:      strings.TrimSpace(r.Difficulty),
+			Persona:         strings.TrimSpace(r.Persona),
+		}
+		if round.Name == "" {
+			round.Name = roundTypeNames[round.Type]
+		}
+		if round.Persona == "" {
+			round.Persona = entity.PersonaInterviewer
+		}
+		plan.Rounds = append(plan.Rounds, round)
+	}
+
+	return plan
+}
+
+// validatePlan 校验面试计划，不合法时返回原因
+func validatePlan(plan *entity.InterviewPlan) string {
+	switch {
+	case plan.Name == "":
+		return "name is required"
+	case utf8.RuneCountInString(plan.Name) > maxPlanNameLength:
+		return fmt.Sprintf("name exceeds %d characters", maxPlanNameLength)
+	case utf8.RuneCountInString(plan.Description) > maxPlanDescriptionLength:
+		return fmt.Sprintf("description exceeds %d characters", maxPlanDescriptionLength)
+	case len(plan.Rounds) == 0:
+		return "at least one round is required"
+	case len(plan.Rounds) > MaxPlanRounds:
+		return fmt.Sprintf("at most %d rounds", MaxPlanRounds)
+	}
+
+	for _, round := range plan.Rounds {
+		switch {
+		case !entity.IsValidRoundType(round.Type):
+			return fmt.Sprintf("round %d: invalid type %q, must be one of %v", round.RoundNo, round.Type, entity.RoundTypes)
+		case utf8.RuneCountInString(round.Name) > maxRoundNameLength:
+			return fmt.Sprintf("round %d: name exceeds %d characters", round.RoundNo, maxRoundNameLength)
+		case round.QuestionCount < 1 || round.QuestionCount > MaxRoundQuestions:
+			return fmt.Sprintf("round %d: question count must be between 1 and %d", round.RoundNo, MaxRoundQuestions)
+		case round.DurationMinutes < 0 || round.DurationMinutes > MaxRoundDurationMins:
+			return fmt.Sprintf("round %d: duration must be between 0 and %d minutes", round.RoundNo, MaxRoundDurationMins)
+		case round.Difficulty != "" && !slices.Contains(entity.Difficulties, round.Difficulty):
+			return fmt.Sprintf("round %d: invalid difficulty %q, must be one of %v", round.RoundNo, round.Difficulty, entity.Difficulties)
+		case !entity.IsValidPersona(round.Persona):
+			return fmt.Sprintf("round %d: invalid persona %q, must be one of %v", round.RoundNo, round.Persona, entity.Personas)
+		}
+	}
+
+	return ""
+}
+
+func planRoundDo2Po(planID int64, rounds []*entity.PlanRound) []*model.InterviewPlanRound {
+	pos := make([]*model.InterviewPlanRound, 0, len(rounds))
+	for _, round := range rounds {
+		pos = append(pos, &model.InterviewPlanRound{
+			PlanID:          planID,
+			RoundNo:         round.RoundNo,
+			RoundType:       round.Type,
+			Name:            round.Name,
+			DurationMinutes: round.DurationMinutes,
+			QuestionCount:   round.QuestionCount,
+			Difficulty:      round.Difficulty,
+			Persona:         round.Persona,
+			CreatedAt:       time.Now(),
+		})
+	}
+	return pos
+}
+
+func planPo2Do(po *model.InterviewPlan, roundPos []*model.InterviewPlanRound) *entity.InterviewPlan {
+	rounds := make([]*entity.PlanRound, 0, len(roundPos))
+	for _, r := range roundPos {
+		rounds = append(rounds, &entity.PlanRound{
+			RoundNo:         r.RoundNo,
+			Type:            r.RoundType,
+			Name:            r.Name,
+			DurationMinutes: r.DurationMinutes,
+			QuestionCount:   r.QuestionCount,
+			Difficulty:      r.Difficulty,
+			Persona:         r.Persona,
+		})
+	}
+
+	return &entity.InterviewPlan{
+		ID:          po.ID,
+		UserID:      po.UserID,
+		Name:        po.Name,
+		Description: po.Description,
+		Rounds:      rounds,
+		CreatedAt:   po.CreatedAt.UnixMilli(),
+		UpdatedAt:   po.UpdatedAt.UnixMilli(),
+	}
+}
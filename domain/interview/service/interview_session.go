@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/interview/entity"
+)
+
+const DefaultMaxTurns = 10 // 默认最大提问轮次
+
+type StartSessionRequest struct {
+	UserID             int64
+	ResumeID           int64
+	JobDescriptionID   int64    // 目标岗位描述ID，可为0
+	PlanID             int64    // 面试计划ID，可为0
+	PanelPersonas      []string // 面试官小组成员的角色，可为空
+	Difficulty         string
+	FocusAreas         []string
+	QuestionDirections []string
+	TechStack          []string
+	MaxTurns           int32
+	Rounds             []*entity.PlanRound // 面试计划的环节，不为空时最大提问轮次为各环节提问数之和
+}
+
+type InterviewSession interface {
+	Start(ctx context.Context, req *StartSessionRequest) (session *entity.InterviewSession, err error)
+	Get(ctx context.Context, userID int64, sessionID int64) (session *entity.InterviewSession, err error)
+	ListTurns(ctx context.Context, sessionID int64) (turns []*entity.InterviewTurn, err error)
+	// AddQuestion persona 为提问的面试官角色，未区分角色时为空
+	AddQuestion(ctx context.Context, session *entity.InterviewSession, turnNo int32, question string, persona string) (turn *entity.InterviewTurn, err error)
+	SubmitAnswer(ctx context.Context, turn *entity.InterviewTurn, answer string) error
+	End(ctx context.Context, userID int64, sessionID int64) (session *entity.InterviewSession, err error)
+
+	// ListRounds 获取会话的面试环节，不使用面试计划时为空
+	ListRounds(ctx context.Context, sessionID int64) (rounds []*entity.InterviewSessionRound, err error)
+	// StartRound 进入会话的某个环节，之前的环节标记为已完成，并更新 session 的当前环节
+	StartRound(ctx context.Context, session *entity.InterviewSession, roundNo int32) error
+}
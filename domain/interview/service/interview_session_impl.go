@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/repository"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"time"
+)
+
+type InterviewSessionComponents struct {
+	IDGen       idgen.IDGenerator
+	SessionRepo repository.InterviewSessionRepository
+}
+
+func NewInterviewSessionDomain(ctx context.Context, c *InterviewSessionComponents) InterviewSession {
+	return &interviewSessionImpl{
+		InterviewSessionComponents: c,
+	}
+}
+
+type interviewSessionImpl struct {
+	*InterviewSessionComponents
+}
+
+func (s *interviewSessionImpl) Start(ctx context.Context, req *StartSessionRequest) (session *entity.InterviewSession, err error) {
+	sessionID, err := s.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("generate id error: %w", err)
+	}
+
+	maxTurns := req.MaxTurns
+	if maxTurns <= 0 {
+		maxTurns = DefaultMaxTurns
+	}
+
+	// 使用面试计划时复制计划的环节，之后修改计划不影响已开始的面试
+	rounds := make([]*model.InterviewSessionRound, 0, len(req.Rounds))
+	if len(req.Rounds) > 0 {
+		maxTurns = 0
+		for _, round := range req.Rounds {
+			maxTurns += round.QuestionCount
+			rounds = append(rounds, &model.InterviewSessionRound{
+				SessionID:       sessionID,
+				UserID:          req.UserID,
+				RoundNo:         round.RoundNo,
+				RoundType:       round.Type,
+				Name:            round.Name,
+				DurationMinutes: round.DurationMinutes,
+				QuestionCount:   round.QuestionCount,
+				Difficulty:      round.Difficulty,
+				Persona:         round.Persona,
+				Status:          dal.RoundStatusPending,
+				CreatedAt:       time.Now(),
+				UpdatedAt:       time.Now(),
+			})
+		}
+	}
+
+	newSession := &model.InterviewSession{
+		ID:                 sessionID,
+		UserID:             req.UserID,
+		ResumeID:           req.ResumeID,
+		JobDescriptionID:   req.JobDescriptionID,
+		PlanID:             req.PlanID,
+		PanelPersonas:      req.PanelPersonas,
+		Status:             dal.SessionStatusInProgress,
+		Difficulty:         req.Difficulty,
+		FocusAreas:         req.FocusAreas,
+		QuestionDirections: req.QuestionDirections,
+		TechStack:          req.TechStack,
+		MaxTurns:           maxTurns,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	err = s.SessionRepo.CreateSession(ctx, newSession, rounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionPo2Do(newSession), nil
+}
+
+func (s *interviewSessionImpl) Get(ctx context.Context, userID int64, sessionID int64) (session *entity.InterviewSession, err error) {
+	sessionPo, exist, err := s.SessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewSessionNotFoundCode, errorx.KV("msg", "Interview session not found"))
+	}
+
+	if sessionPo.UserID != userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this interview session"))
+	}
+
+	return sessionPo2Do(sessionPo), nil
+}
+
+func (s *interviewSessionImpl) ListTurns(ctx context.Context, sessionID int64) (turns []*entity.InterviewTurn, err error) {
+	turnPos, err := s.SessionRepo.ListTurns(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	turns = make([]*entity.InterviewTurn, 0, len(turnPos))
+	for _, turnPo := range turnPos {
+		turns = append(turns, turnPo2Do(turnPo))
+	}
+
+	return turns, nil
+}
+
+func (s *interviewSessionImpl) AddQuestion(ctx context.Context, session *entity.InterviewSession, turnNo int32, question string, persona string) (turn *entity.InterviewTurn, err error) {
+	if session.Status != dal.SessionStatusInProgress {
+		return nil, errorx.New(errno.ErrInterviewSessionEndedCode, errorx.KV("msg", "Interview session has ended"))
+	}
+
+	turnID, err := s.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("generate id error: %w", err)
+	}
+
+	newTurn := &model.InterviewTurn{
+		ID:        turnID,
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		TurnNo:    turnNo,
+		RoundNo:   session.CurrentRound,
+		Persona:   persona,
+		Question:  question,
+		Status:    dal.TurnStatusPending,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// (session_id, turn_no) 唯一，避免并发重复生成同一轮问题
+	err = s.SessionRepo.CreateTurn(ctx, newTurn)
+	if err != nil {
+		return nil, err
+	}
+
+	return turnPo2Do(newTurn), nil
+}
+
+func (s *interviewSessionImpl) SubmitAnswer(ctx context.Context, turn *entity.InterviewTurn, answer string) error {
+	answered, err := s.SessionRepo.AnswerTurn(ctx, turn.ID, answer, time.Now().UnixMilli())
+	if err != nil {
+		return err
+	}
+
+	if !answered {
+		return errorx.New(errno.ErrInterviewInvalidParamCode, errorx.KV("msg", "Question has already been answered"))
+	}
+
+	return nil
+}
+
+func (s *interviewSessionImpl) End(ctx context.Context, userID int64, sessionID int64) (session *entity.InterviewSession, err error) {
+	session, err = s.Get(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.Status == dal.SessionStatusEnded {
+		return session, nil
+	}
+
+	_, err = s.SessionRepo.EndSession(ctx, sessionID, time.Now().UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, userID, sessionID)
+}
+
+func (s *interviewSessionImpl) ListRounds(ctx context.Context, sessionID int64) (rounds []*entity.InterviewSessionRound, err error) {
+	roundPos, err := s.SessionRepo.ListRounds(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds = make([]*entity.InterviewSessionRound, 0, len(roundPos))
+	for _, roundPo := range roundPos {
+		rounds = append(rounds, roundPo2Do(roundPo))
+	}
+
+	return rounds, nil
+}
+
+func (s *interviewSessionImpl) StartRound(ctx context.Context, session *entity.InterviewSession, roundNo int32) error {
+	if session.Status != dal.SessionStatusInProgress {
+		return errorx.New(errno.ErrInterviewSessionEndedCode, errorx.KV("msg", "Interview session has ended"))
+	}
+
+	// 并发请求已开始该环节时同样视为成功
+	if _, err := s.SessionRepo.StartRound(ctx, session.ID, roundNo, time.Now().UnixMilli()); err != nil {
+		return err
+	}
+
+	session.CurrentRound = roundNo
+	return nil
+}
+
+func sessionPo2Do(model *model.InterviewSession) *entity.InterviewSession {
+	return &entity.InterviewSession{
+		ID:                 model.ID,
+		UserID:             model.UserID,
+		ResumeID:           model.ResumeID,
+		JobDescriptionID:   model.JobDescriptionID,
+		PlanID:             model.PlanID,
+		PanelPersonas:      model.PanelPersonas,
+		Status:             model.Status,
+		Difficulty:         model.Difficulty,
+		FocusAreas:         model.FocusAreas,
+		QuestionDirections: model.QuestionDirections,
+		TechStack:          model.TechStack,
+		TurnCount:          model.TurnCount,
+		MaxTurns:           model.MaxTurns,
+		CurrentRound:       model.CurrentRound,
+		StartedAt:          model.CreatedAt.UnixMilli(),
+		EndedAt:            model.EndedAt,
+	}
+}
+
+func turnPo2Do(model *model.InterviewTurn) *entity.InterviewTurn {
+	return &entity.InterviewTurn{
+		ID:         model.ID,
+		SessionID:  model.SessionID,
+		TurnNo:     model.TurnNo,
+		RoundNo:    model.RoundNo,
+		Persona:    model.Persona,
+		Question:   model.Question,
+		Answer:     model.Answer,
+		Status:     model.Status,
+		AnsweredAt: model.AnsweredAt,
+		CreatedAt:  model.CreatedAt.UnixMilli(),
+	}
+}
+
+func roundPo2Do(model *model.InterviewSessionRound) *entity.InterviewSessionRound {
+	return &entity.InterviewSessionRound{
+		ID:              model.ID,
+		SessionID:       model.SessionID,
+		RoundNo:         model.RoundNo,
+		Type:            model.RoundType,
+		Name:            model.Name,
+		DurationMinutes: model.DurationMinutes,
+		QuestionCount:   model.QuestionCount,
+		Difficulty:      model.Difficulty,
+		Persona:         model.Persona,
+		Status:          model.Status,
+		StartedAt:       model.StartedAt,
+		EndedAt:         model.EndedAt,
+	}
+}
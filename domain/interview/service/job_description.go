@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/interview/entity"
+)
+
+const MaxJobDescriptionRunes = 20000 // 岗位描述原文的最大字数
+
+// CreateJobDescriptionRequest Content 与 FileKey 二选一，同时提供时以粘贴的文本为准
+type CreateJobDescriptionRequest struct {
+	UserID   int64
+	Title    string
+	Company  string
+	Content  string // 粘贴的岗位描述文本
+	FileKey  string // 通过上传链接上传的文件标识
+	Filename string
+	Filetype string
+}
+
+type ListJobDescriptionsRequest struct {
+	UserID  int64
+	Keyword string
+	Page    int
+	Size    int
+}
+
+type JobDescription interface {
+	GetUploadURL(ctx context.Context, userID int64, fileName string, fileType string) (fileKey string, url string, err error)
+	// Create 保存岗位描述，上传文件时提取文件中的文本；新建的岗位描述处于解析中，需调用方触发解析
+	Create(ctx context.Context, req *CreateJobDescriptionRequest) (jd *entity.JobDescription, err error)
+	Get(ctx context.Context, userID int64, id int64) (jd *entity.JobDescription, err error)
+	List(ctx context.Context, req *ListJobDescriptionsRequest) (jds []*entity.JobDescription, total int64, err error)
+	Delete(ctx context.Context, userID int64, id int64) error
+	// StartParse 重新解析：解析完成、失败或解析中断的岗位描述重新进入解析中
+	StartParse(ctx context.Context, userID int64, id int64) (jd *entity.JobDescription, err error)
+}
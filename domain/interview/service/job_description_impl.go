@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/domain/interview/repository"
+	"mianshiba/infra/contract/document"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/infra/contract/storage"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// jobDescriptionParseStale 解析中超过该时长视为已中断，允许重新解析
+const jobDescriptionParseStale = 5 * time.Minute
+
+type JobDescriptionComponents struct {
+	OSSClient  storage.Storage
+	Extractors document.Factory
+	IDGen      idgen.IDGenerator
+	JDRepo     repository.JobDescriptionRepository
+}
+
+func NewJobDescriptionDomain(ctx context.Context, c *JobDescriptionComponents) JobDescription {
+	return &jobDescriptionImpl{
+		JobDescriptionComponents: c,
+	}
+}
+
+type jobDescriptionImpl struct {
+	*JobDescriptionComponents
+}
+
+func (j *jobDescriptionImpl) GetUploadURL(ctx context.Context, userID int64, fileName string, fileType string) (fileKey string, url string, err error) {
+	if _, err = j.resolveFileType(fileName, fileType); err != nil {
+		return "", "", err
+	}
+
+	fileID, err := j.IDGen.GenID(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	ext := ""
+	if idx := strings.LastIndex(fileName, "."); idx != -1 {
+		ext = fileName[idx:]
+	}
+
+	// 与简历相同的上传方式，按用户划分目录，创建时据此校验文件归属
+	fileKey = fmt.Sprintf("%s%d%s", jobDescriptionKeyPrefix(userID), fileID, ext)
+
+	url, err = j.OSSClient.GetUploadUrl(ctx, fileKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return fileKey, url, nil
+}
+
+func (j *jobDescriptionImpl) Create(ctx context.Context, req *CreateJobDescriptionRequest) (jd *entity.JobDescription, err error) {
+	newJD := &model.JobDescription{
+		UserID:      req.UserID,
+		Title:       strings.TrimSpace(req.Title),
+		Company:     strings.TrimSpace(req.Company),
+		Source:      dal.JobDescriptionSourceText,
+		Content:     strings.TrimSpace(req.Content),
+		ParseStatus: dal.JobDescriptionParsing,
+	}
+
+	if newJD.Content == "" && req.FileKey != "" {
+		newJD.Source = dal.JobDescriptionSourceFile
+		newJD.FileKey = req.FileKey
+		newJD.Filename = req.Filename
+		newJD.Content, newJD.Filetype, err = j.extractFile(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newJD.Content == "" {
+		return nil, errorx.New(errno.ErrInterviewJobDescriptionContentCode, errorx.KV("msg", "Job description content is empty"))
+	}
+	if utf8.RuneCountInString(newJD.Content) > MaxJobDescriptionRunes {
+		return nil, errorx.New(errno.ErrInterviewJobDescriptionContentCode,
+			errorx.KV("msg", fmt.Sprintf("Job description is too long, at most %d characters", MaxJobDescriptionRunes)))
+	}
+
+	newJD.ID, err = j.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("generate id error: %w", err)
+	}
+	newJD.CreatedAt = time.Now()
+	newJD.UpdatedAt = time.Now()
+
+	if err = j.JDRepo.CreateJobDescription(ctx, newJD); err != nil {
+		return nil, err
+	}
+
+	return jobDescriptionPo2Do(newJD), nil
+}
+
+func (j *jobDescriptionImpl) Get(ctx context.Context, userID int64, id int64) (jd *entity.JobDescription, err error) {
+	jdPo, err := j.getOwnedJobDescription(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobDescriptionPo2Do(jdPo), nil
+}
+
+func (j *jobDescriptionImpl) List(ctx context.Context, req *ListJobDescriptionsRequest) (jds []*entity.JobDescription, total int64, err error) {
+	jdPos, total, err := j.JDRepo.ListJobDescriptions(ctx, req.UserID, &dal.JobDescriptionFilter{
+		Keyword: req.Keyword,
+		Offset:  (req.Page - 1) * req.Size,
+		Limit:   req.Size,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	jds = make([]*entity.JobDescription, 0, len(jdPos))
+	for _, jdPo := range jdPos {
+		jds = append(jds, jobDescriptionPo2Do(jdPo))
+	}
+
+	return jds, total, nil
+}
+
+// Delete 软删除岗位描述并移除上传的文件
+func (j *jobDescriptionImpl) Delete(ctx context.Context, userID int64, id int64) error {
+	jdPo, err := j.getOwnedJobDescription(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := j.JDRepo.DeleteJobDescription(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errorx.New(errno.ErrInterviewJobDescriptionNotFoundCode, errorx.KV("msg", "Job description not found"))
+	}
+
+	if jdPo.FileKey == "" {
+		return nil
+	}
+
+	// 记录已软删除，文件删除失败只记录日志
+	if err = j.OSSClient.DeleteObject(ctx, jdPo.FileKey); err != nil {
+		logs.Errorf("Failed to delete job description object, fileKey: %s, err: %v", jdPo.FileKey, err)
+	}
+
+	return nil
+}
+
+func (j *jobDescriptionImpl) StartParse(ctx context.Context, userID int64, id int64) (jd *entity.JobDescription, err error) {
+	jdPo, err := j.getOwnedJobDescription(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := j.JDRepo.StartJobDescriptionParse(ctx, id, time.Now().Add(-jobDescriptionParseStale))
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, errorx.New(errno.ErrInterviewJobDescriptionParseStatusCode, errorx.KV("msg", "Job description is being parsed"))
+	}
+
+	jdPo.ParseStatus = dal.JobDescriptionParsing
+	jdPo.ParseError = ""
+	return jobDescriptionPo2Do(jdPo), nil
+}
+
+// extractFile 读取上传的文件并提取文本，返回文本和识别出的文件类型
+func (j *jobDescriptionImpl) extractFile(ctx context.Context, req *CreateJobDescriptionRequest) (string, string, error) {
+	if !strings.HasPrefix(req.FileKey, jobDescriptionKeyPrefix(req.UserID)) {
+		return "", "", errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this file"))
+	}
+
+	if _, err := j.resolveFileType(req.Filename, req.Filetype); err != nil {
+		return "", "", err
+	}
+
+	data, err := j.OSSClient.GetObject(ctx, req.FileKey)
+	if err != nil {
+		return "", "", fmt.Errorf("get job description object failed: %w", err)
+	}
+
+	fileType := document.DetectFileType(req.Filetype, data)
+	extractor, err := j.Extractors.GetExtractor(fileType)
+	if err != nil {
+		return "", "", errorx.New(errno.ErrInterviewJobDescriptionContentCode,
+			errorx.KV("msg", fmt.Sprintf("Unsupported job description file type: %s", fileType)))
+	}
+
+	content, err := extractor.Extract(ctx, data)
+	if err != nil {
+		logs.Errorf("Failed to extract job description, fileKey: %s, err: %v", req.FileKey, err)
+		return "", "", errorx.New(errno.ErrInterviewJobDescriptionContentCode, errorx.KV("msg", "Failed to read text from the file"))
+	}
+
+	return strings.TrimSpace(content), string(fileType), nil
+}
+
+// resolveFileType 根据声明的文件类型（缺省时取文件扩展名）确定文件类型，不支持时返回错误
+func (j *jobDescriptionImpl) resolveFileType(fileName string, fileType string) (document.FileType, error) {
+	resolved := document.ParseFileType(fileType)
+	if resolved == document.FileTypeUnknown {
+		if idx := strings.LastIndex(fileName, "."); idx != -1 {
+			resolved = document.ParseFileType(fileName[idx+1:])
+		}
+	}
+
+	if !j.Extractors.SupportFileType(resolved) {
+		return document.FileTypeUnknown, errorx.New(errno.ErrInterviewJobDescriptionContentCode,
+			errorx.KV("msg", fmt.Sprintf("Unsupported job description file type: %s, supported: pdf/docx/md/txt/html", fileType)))
+	}
+
+	return resolved, nil
+}
+
+// getOwnedJobDescription 获取当前用户的岗位描述，不属于该用户时返回无权限
+func (j *jobDescriptionImpl) getOwnedJobDescription(ctx context.Context, userID int64, id int64) (*model.JobDescription, error) {
+	jdPo, exist, err := j.JDRepo.GetJobDescriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewJobDescriptionNotFoundCode, errorx.KV("msg", "Job description not found"))
+	}
+
+	if jdPo.UserID != userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this job description"))
+	}
+
+	return jdPo, nil
+}
+
+func jobDescriptionKeyPrefix(userID int64) string {
+	return fmt.Sprintf("jd/%d/", userID)
+}
+
+func jobDescriptionPo2Do(model *model.JobDescription) *entity.JobDescription {
+	return &entity.JobDescription{
+		ID:            model.ID,
+		UserID:        model.UserID,
+		Title:         model.Title,
+		Company:       model.Company,
+		Source:        model.Source,
+		FileKey:       model.FileKey,
+		Filename:      model.Filename,
+		Filetype:      model.Filetype,
+		Content:       model.Content,
+		ParseStatus:   model.ParseStatus,
+		ParseError:    model.ParseError,
+		ParsedContent: model.ParsedContent,
+		CreatedAt:     model.CreatedAt.UnixMilli(),
+	}
+}
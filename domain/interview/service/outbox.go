@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"mianshiba/domain/interview/repository"
+	cmq "mianshiba/infra/contract/mq"
+	"mianshiba/pkg/logs"
+)
+
+const (
+	outboxPollInterval = time.Second
+	outboxBatchSize    = 100
+	outboxLease        = time.Minute     // 领取后未标记结果（如进程退出）的消息，租期过后重新投递
+	outboxRetryBackoff = 5 * time.Second // 投递失败后的首次重试间隔，之后每次翻倍
+	outboxMaxBackoff   = 5 * time.Minute
+	maxOutboxErrorLen  = 1024 // 与 last_error 列长度一致
+)
+
+type OutboxRelayComponents struct {
+	OutboxRepo    repository.OutboxRepository
+	KafkaProducer cmq.KafkaProducer
+}
+
+// OutboxRelay 将事务消息表中的待投递消息发送到 Kafka，投递成功后标记为已投递
+// 进程重启后未标记的消息会被重新投递，保证至少投递一次，消费端需要幂等处理
+type OutboxRelay interface {
+	// Run 持续投递，直到 ctx 取消
+	Run(ctx context.Context)
+}
+
+func NewOutboxRelay(ctx context.Context, c *OutboxRelayComponents) OutboxRelay {
+	return &outboxRelayImpl{
+		OutboxRelayComponents: c,
+	}
+}
+
+type outboxRelayImpl struct {
+	*OutboxRelayComponents
+}
+
+func (r *outboxRelayImpl) Run(ctx context.Context) {
+	logs.Infof("Outbox relay started")
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// 整批领满说明还有积压，立即领取下一批
+		if r.relayBatch(ctx) < outboxBatchSize {
+			select {
+			case <-ctx.Done():
+				logs.Infof("Outbox relay stopped")
+				return
+			case <-ticker.C:
+			}
+		} else if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// relayBatch 领取并投递一批消息，返回领取的条数
+func (r *outboxRelayImpl) relayBatch(ctx context.Context) int {
+	events, err := r.OutboxRepo.ClaimPending(ctx, outboxBatchSize, outboxLease)
+	if err != nil {
+		logs.Errorf("Failed to claim outbox events: %v", err)
+		return 0
+	}
+
+	for _, event := range events {
+		err := r.KafkaProducer.SendMessage(ctx, event.Topic, []byte(event.MsgKey), []byte(event.Payload))
+		if err != nil {
+			next := time.Now().Add(outboxBackoff(event.Attempts))
+			logs.Errorf("Failed to relay outbox event %d to topic %s (attempt %d), retry at %s: %v",
+				event.ID, event.Topic, event.Attempts, next.Format(time.DateTime), err)
+
+			lastError := err.Error()
+			if len(lastError) > maxOutboxErrorLen {
+				lastError = lastError[:maxOutboxErrorLen]
+			}
+			if err := r.OutboxRepo.MarkRetry(ctx, event.ID, lastError, next); err != nil {
+				logs.Errorf("Failed to record outbox event %d failure: %v", event.ID, err)
+			}
+			continue
+		}
+
+		// 标记失败时消息会在租期过后重复投递
+		if err := r.OutboxRepo.MarkSent(ctx, event.ID); err != nil {
+			logs.Errorf("Failed to mark outbox event %d as sent: %v", event.ID, err)
+		}
+	}
+
+	return len(events)
+}
+
+// outboxBackoff 第 attempts 次投递失败后的等待时间
+func outboxBackoff(attempts int32) time.Duration {
+	backoff := outboxRetryBackoff
+	for i := int32(1); i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"mianshiba/domain/interview/dal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryOutboxRepo struct {
+	pending []*model.OutboxEvent
+	sent    []int64
+	retries map[int64]time.Time
+}
+
+func (m *memoryOutboxRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxEvent, error) {
+	claimed := m.pending[:min(limit, len(m.pending))]
+	m.pending = m.pending[len(claimed):]
+	for _, event := range claimed {
+		event.Attempts++
+	}
+	return claimed, nil
+}
+
+func (m *memoryOutboxRepo) MarkSent(ctx context.Context, id int64) error {
+	m.sent = append(m.sent, id)
+	return nil
+}
+
+func (m *memoryOutboxRepo) MarkRetry(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	m.retries[id] = nextAttemptAt
+	return nil
+}
+
+type flakyProducer struct {
+	failTopic string
+	messages  []string
+}
+
+func (p *flakyProducer) SendMessage(ctx context.Context, topic string, key []byte, value []byte) error {
+	if topic == p.failTopic {
+		return errors.New("broker not available")
+	}
+	p.messages = append(p.messages, string(value))
+	return nil
+}
+
+func (p *flakyProducer) Close() error {
+	return nil
+}
+
+func TestOutboxRelayBatch(t *testing.T) {
+	repo := &memoryOutboxRepo{
+		pending: []*model.OutboxEvent{
+			{ID: 1, Topic: "resume_parser", MsgKey: "resume/1/1.pdf", Payload: `{"file_id":1}`},
+			{ID: 2, Topic: "offline", MsgKey: "resume/1/2.pdf", Payload: `{"file_id":2}`},
+		},
+		retries: map[int64]time.Time{},
+	}
+	producer := &flakyProducer{failTopic: "offline"}
+	relay := &outboxRelayImpl{&OutboxRelayComponents{OutboxRepo: repo, KafkaProducer: producer}}
+
+	assert.Equal(t, 2, relay.relayBatch(context.Background()))
+	assert.Equal(t, []int64{1}, repo.sent)
+	assert.Equal(t, []string{`{"file_id":1}`}, producer.messages)
+	assert.Contains(t, repo.retries, int64(2))
+	assert.WithinDuration(t, time.Now().Add(outboxRetryBackoff), repo.retries[2], time.Second)
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	assert.Equal(t, outboxRetryBackoff, outboxBackoff(1))
+	assert.Equal(t, 2*outboxRetryBackoff, outboxBackoff(2))
+	assert.Equal(t, outboxMaxBackoff, outboxBackoff(20))
+}
@@ -2,9 +2,39 @@ package service
 
 import (
 	"context"
+	"mianshiba/domain/interview/dal"
 	"mianshiba/domain/interview/entity"
+	"strconv"
 )
 
+// resumeStatusNames 列表筛选支持的状态名称
+var resumeStatusNames = map[string]int32{
+	"uploaded": dal.StatusUploaded,
+	"parsing":  dal.StatusParsing,
+	"parsed":   dal.StatusParseSuccess,
+	"failed":   dal.StatusParseFailed,
+}
+
+// ParseResumeStatus 将状态名称或状态值转换为简历状态，已删除的简历不可筛选
+func ParseResumeStatus(status string) (int32, bool) {
+	if v, ok := resumeStatusNames[status]; ok {
+		return v, true
+	}
+
+	v, err := strconv.ParseInt(status, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	for _, known := range resumeStatusNames {
+		if int32(v) == known {
+			return known, true
+		}
+	}
+
+	return 0, false
+}
+
 type ResumeCreateRequest struct {
 	ID       int64
 	UserID   int64
@@ -14,7 +44,28 @@ type ResumeCreateRequest struct {
 	Filesize int64
 }
 
+type ListResumesRequest struct {
+	UserID  int64
+	Status  *int32
+	Keyword string
+	Page    int
+	Size    int
+}
+
 type Resume interface {
 	GetUploadURL(ctx context.Context, userID int64, fileName string, fileType string) (fileID int64, fileKey string, url string, err error)
 	Create(ctx context.Context, req *ResumeCreateRequest) (resume *entity.Resume, err error)
+	GetByID(ctx context.Context, id int64) (resume *entity.Resume, err error)
+	List(ctx context.Context, req *ListResumesRequest) (resumes []*entity.Resume, total int64, err error)
+	GetByFileKey(ctx context.Context, userID int64, fileKey string) (resume *entity.Resume, err error)
+	GetDownloadURL(ctx context.Context, userID int64, fileKey string) (url string, err error)
+	Delete(ctx context.Context, userID int64, fileKey string, reason string) error
+	UpdateProfile(ctx context.Context, userID int64, fileKey string, content string) (resume *entity.Resume, err error)
+	ListProfileVersions(ctx context.Context, userID int64, fileKey string) (versions []*entity.ResumeProfileVersion, err error)
+
+	// 解析状态流转：uploaded → queued → parsing → parsed/failed；Create 直接进入 queued 并写入解析消息
+	MarkParseQueued(ctx context.Context, id int64) (resume *entity.Resume, err error)
+	StartParse(ctx context.Context, id int64, attempt int32, owned bool) (started bool, err error)
+	FailParse(ctx context.Context, id int64, reason entity.ParseFailReason, detail string) error
+	ListParseAttempts(ctx context.Context, userID int64, fileKey string) (attempts []*entity.ResumeParseAttempt, err error)
 }
@@ -7,15 +7,25 @@ import (
 	"mianshiba/domain/interview/dal/model"
 	"mianshiba/domain/interview/entity"
 	"mianshiba/domain/interview/repository"
+	"mianshiba/infra/contract/document"
 	"mianshiba/infra/contract/idgen"
 	"mianshiba/infra/contract/storage"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+	"path"
+	"strconv"
 	"strings"
 )
 
+const downloadURLExpire = 3600 // 下载链接有效期（秒）
+
 type ResumeComponents struct {
 	OSSClient  storage.Storage
+	Extractors document.Factory
 	IDGen      idgen.IDGenerator
 	ResumeRepo repository.ResumeRepository
+	ParseTopic string // 简历解析消息的 Kafka 主题
 }
 
 func NewResumeDomain(ctx context.Context, c *ResumeComponents) Resume {
@@ -29,6 +39,10 @@ type resumeImpl struct {
 }
 
 func (r *resumeImpl) GetUploadURL(ctx context.Context, userID int64, fileName string, fileType string) (fileID int64, fileKey string, url string, err error) {
+	if _, err = r.resolveFileType(fileName, fileType); err != nil {
+		return 0, "", "", err
+	}
+
 	// 生成唯一ID作为文件标识
 	fileID, err = r.IDGen.GenID(ctx)
 	if err != nil {
@@ -41,8 +55,8 @@ func (r *resumeImpl) GetUploadURL(ctx context.Context, userID int64, fileName st
 		ext = fileName[idx:]
 	}
 
-	// 构建文件路径（userID/文件标识.扩展名的形式）
-	fileKey = fmt.Sprintf("resume/%d/%d%s", userID, fileID, ext)
+	// 构建文件路径（userID/文件标识.扩展名的形式），创建时据此校验文件归属
+	fileKey = fmt.Sprintf("%s%d%s", resumeKeyPrefix(userID), fileID, ext)
 
 	// 生成上传URL
 	url, err = r.OSSClient.GetUploadUrl(ctx, fileKey)
@@ -54,21 +68,35 @@ func (r *resumeImpl) GetUploadURL(ctx context.Context, userID int64, fileName st
 }
 
 func (r *resumeImpl) Create(ctx context.Context, req *ResumeCreateRequest) (resume *entity.Resume, err error) {
-	// TODO: 检查文件是否已存在
+	// 只能登记为当前用户签发的上传路径，避免通过他人的 file_key 下载或删除其文件
+	if !isIssuedResumeKey(req.FileKey, req.UserID, req.ID) {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this file"))
+	}
+
+	// 不支持的文件类型在此拒绝，避免消费端解析失败
+	fileType, err := r.resolveFileType(req.Filename, req.Filetype)
+	if err != nil {
+		return nil, err
+	}
 
 	// 创建简历实体
 	newResume := &model.Resume{
-		ID:       req.ID,
-		UserID:   req.UserID,
-		FileKey:  req.FileKey,
-		Filename: req.Filename,
-		Filetype: req.Filetype,
-		Filesize: req.Filesize,
-		Status:   dal.StatusParsing,
+		ID:            req.ID,
+		UserID:        req.UserID,
+		FileKey:       req.FileKey,
+		Filename:      req.Filename,
+		Filetype:      string(fileType),
+		Filesize:      req.Filesize,
+		ParseAttempts: 1,
+	}
+
+	event, err := r.newParseEvent(newResume)
+	if err != nil {
+		return nil, err
 	}
 
-	// 保存到数据库
-	err = r.ResumeRepo.Create(ctx, newResume)
+	// 简历与解析消息在同一事务中保存，由投递任务发送到 Kafka
+	err = r.ResumeRepo.CreateQueued(ctx, newResume, event)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +104,179 @@ func (r *resumeImpl) Create(ctx context.Context, req *ResumeCreateRequest) (resu
 	return userPo2Do(newResume), nil
 }
 
+func (r *resumeImpl) GetByID(ctx context.Context, id int64) (resume *entity.Resume, err error) {
+	resumePo, exist, err := r.ResumeRepo.GetResumeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewResumeNotFoundCode, errorx.KV("msg", "Resume not found"))
+	}
+
+	return userPo2Do(resumePo), nil
+}
+
+func (r *resumeImpl) List(ctx context.Context, req *ListResumesRequest) (resumes []*entity.Resume, total int64, err error) {
+	resumePos, total, err := r.ResumeRepo.ListResumes(ctx, req.UserID, &dal.ResumeFilter{
+		Status:  req.Status,
+		Keyword: req.Keyword,
+		Offset:  (req.Page - 1) * req.Size,
+		Limit:   req.Size,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resumes = make([]*entity.Resume, 0, len(resumePos))
+	for _, resumePo := range resumePos {
+		resumes = append(resumes, userPo2Do(resumePo))
+	}
+
+	return resumes, total, nil
+}
+
+func (r *resumeImpl) GetByFileKey(ctx context.Context, userID int64, fileKey string) (resume *entity.Resume, err error) {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return userPo2Do(resumePo), nil
+}
+
+func (r *resumeImpl) GetDownloadURL(ctx context.Context, userID int64, fileKey string) (url string, err error) {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return "", err
+	}
+
+	return r.OSSClient.GetObjectUrl(ctx, resumePo.FileKey, storage.WithExpire(downloadURLExpire))
+}
+
+// Delete 软删除简历并移除对象存储中的文件
+func (r *resumeImpl) Delete(ctx context.Context, userID int64, fileKey string, reason string) error {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := r.ResumeRepo.DeleteResume(ctx, userID, resumePo.ID, reason)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errorx.New(errno.ErrInterviewResumeNotFoundCode, errorx.KV("msg", "Resume not found"))
+	}
+
+	// 记录已软删除，文件删除失败只记录日志，避免重复删除请求
+	if err = r.OSSClient.DeleteObject(ctx, resumePo.FileKey); err != nil {
+		logs.Errorf("Failed to delete resume object, fileKey: %s, err: %v", resumePo.FileKey, err)
+	}
+
+	return nil
+}
+
+// UpdateProfile 保存用户修改后的结构化简历信息，作为新版本并替换当前内容；简历需已解析
+func (r *resumeImpl) UpdateProfile(ctx context.Context, userID int64, fileKey string, content string) (resume *entity.Resume, err error) {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if resumePo.LlmParseContent == "" {
+		return nil, errorx.New(errno.ErrInterviewResumeNotParsedCode, errorx.KV("msg", "Resume has not been parsed yet"))
+	}
+
+	version, err := r.ResumeRepo.SaveProfile(ctx, &model.Resume{
+		ID:              resumePo.ID,
+		LlmParseContent: content,
+	}, dal.ProfileSourceUser)
+	if err != nil {
+		return nil, err
+	}
+
+	resumePo.LlmParseContent = content
+	resumePo.ProfileVersion = version
+	return userPo2Do(resumePo), nil
+}
+
+func (r *resumeImpl) ListProfileVersions(ctx context.Context, userID int64, fileKey string) (versions []*entity.ResumeProfileVersion, err error) {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	versionPos, err := r.ResumeRepo.ListProfileVersions(ctx, resumePo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions = make([]*entity.ResumeProfileVersion, 0, len(versionPos))
+	for _, versionPo := range versionPos {
+		versions = append(versions, &entity.ResumeProfileVersion{
+			ID:        versionPo.ID,
+			ResumeID:  versionPo.ResumeID,
+			Version:   versionPo.Version,
+			Source:    versionPo.Source,
+			Content:   versionPo.Content,
+			CreatedAt: versionPo.CreatedAt.UnixMilli(),
+		})
+	}
+
+	return versions, nil
+}
+
+// resolveFileType 根据声明的文件类型（缺省时取文件扩展名）确定简历类型，不支持时返回错误
+func (r *resumeImpl) resolveFileType(fileName string, fileType string) (document.FileType, error) {
+	resolved := document.ParseFileType(fileType)
+	if resolved == document.FileTypeUnknown {
+		if idx := strings.LastIndex(fileName, "."); idx != -1 {
+			resolved = document.ParseFileType(fileName[idx+1:])
+		}
+	}
+
+	if !r.Extractors.SupportFileType(resolved) {
+		return document.FileTypeUnknown, errorx.New(errno.ErrInterviewResumeFileTypeCode,
+			errorx.KV("msg", fmt.Sprintf("Unsupported resume file type: %s, supported: pdf/docx/md/txt/html", fileType)))
+	}
+
+	return resolved, nil
+}
+
+// getOwnedResume 获取当前用户的简历，不属于该用户时返回无权限
+func (r *resumeImpl) getOwnedResume(ctx context.Context, userID int64, fileKey string) (*model.Resume, error) {
+	resumePo, exist, err := r.ResumeRepo.GetResumeByFileKey(ctx, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrInterviewResumeNotFoundCode, errorx.KV("msg", "Resume not found"))
+	}
+
+	if resumePo.UserID != userID {
+		return nil, errorx.New(errno.ErrInterviewPermissionCode, errorx.KV("msg", "No permission to access this resume"))
+	}
+
+	return resumePo, nil
+}
+
+func resumeKeyPrefix(userID int64) string {
+	return fmt.Sprintf("resume/%d/", userID)
+}
+
+// isIssuedResumeKey 判断 fileKey 是否为 GetUploadURL 为该用户和文件标识生成的路径
+func isIssuedResumeKey(fileKey string, userID int64, fileID int64) bool {
+	name, ok := strings.CutPrefix(fileKey, resumeKeyPrefix(userID))
+	if !ok {
+		return false
+	}
+
+	return strings.TrimSuffix(name, path.Ext(name)) == strconv.FormatInt(fileID, 10)
+}
+
 func userPo2Do(model *model.Resume) *entity.Resume {
 	return &entity.Resume{
 		ID:          model.ID,
@@ -88,5 +289,10 @@ func userPo2Do(model *model.Resume) *entity.Resume {
 		ParseStatus: model.ParseStatus,
 		ParseError:  model.ParseError,
 		UploadAt:    model.UploadAt.UnixMilli(),
+
+		ParseAttempts: model.ParseAttempts,
+
+		LlmParseContent: model.LlmParseContent,
+		ProfileVersion:  model.ProfileVersion,
 	}
 }
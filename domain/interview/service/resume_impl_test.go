@@ -0,0 +1,20 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIssuedResumeKey(t *testing.T) {
+	assert.True(t, isIssuedResumeKey("resume/3/42.pdf", 3, 42))
+	assert.True(t, isIssuedResumeKey("resume/3/42", 3, 42))
+
+	// 他人的文件
+	assert.False(t, isIssuedResumeKey("resume/4/42.pdf", 3, 42))
+	// 与签发的文件标识不一致
+	assert.False(t, isIssuedResumeKey("resume/3/43.pdf", 3, 42))
+	// 路径穿越和其他目录
+	assert.False(t, isIssuedResumeKey("resume/3/../4/42.pdf", 3, 42))
+	assert.False(t, isIssuedResumeKey("jd/3/42.pdf", 3, 42))
+}
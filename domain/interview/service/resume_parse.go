@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/entity"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"slices"
+	"time"
+)
+
+const maxParseErrorLength = 1024 // 与 parse_error 列长度一致
+
+// ResumeParseTimeout 解析中超过该时长视为已中断（消费者中途退出），允许重新投递的消息接管或用户重新解析；
+// 需长于含重试在内的解析耗时
+const ResumeParseTimeout = 10 * time.Minute
+
+// parseTransitions 解析状态机：目标状态 → 允许的当前状态
+// 解析成功由 ResumeRepository.CompleteParse 写入，同样要求当前处于解析中
+var parseTransitions = map[int32][]int32{
+	dal.ParseStatusQueued:  {dal.ParseStatusUploaded, dal.ParseStatusParsed, dal.ParseStatusFailed}, // 解析中超时的简历同样允许重新解析
+	dal.ParseStatusParsing: {dal.ParseStatusQueued, dal.ParseStatusParsing},                         // 解析中 → 解析中：消费者中途退出后由重新投递的同一消息接管
+	dal.ParseStatusFailed:  {dal.ParseStatusUploaded, dal.ParseStatusQueued, dal.ParseStatusParsing},
+}
+
+// MarkParseQueued 重新解析：简历进入待解析状态，并在同一事务中写入解析消息
+func (r *resumeImpl) MarkParseQueued(ctx context.Context, id int64) (resume *entity.Resume, err error) {
+	staleBefore := time.Now().Add(-ResumeParseTimeout)
+	canQueue := func(current *model.Resume) bool {
+		return slices.Contains(parseTransitions[dal.ParseStatusQueued], current.ParseStatus) ||
+			(current.ParseStatus == dal.ParseStatusParsing && isParseStale(current, staleBefore))
+	}
+
+	resumePo, ok, err := r.ResumeRepo.QueueParse(ctx, id, canQueue, r.newParseEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, errorx.New(errno.ErrInterviewResumeParseStatusCode, errorx.KV("msg", "Resume is being parsed"))
+	}
+
+	return userPo2Do(resumePo), nil
+}
+
+// StartParse 消费端开始第 attempt 次解析；返回 false 表示该消息不应处理（已解析完成、已被重新解析取代、已删除、其他消费者正在解析），应跳过
+// owned 为 true 表示调用方持有该次投递的处理权，没有其他消费者在处理，可直接接管解析中的简历；否则只接管解析中超时的简历
+func (r *resumeImpl) StartParse(ctx context.Context, id int64, attempt int32, owned bool) (bool, error) {
+	staleBefore := time.Now().Add(-ResumeParseTimeout)
+	return r.ResumeRepo.StartParse(ctx, id, func(current *model.Resume) bool {
+		return canStartParse(current, attempt, owned, staleBefore)
+	})
+}
+
+// canStartParse 简历处于可开始解析的状态且投递次数等于 attempt 时才能开始解析，
+// 被重新解析取代的旧消息因投递次数不一致而不会生效
+func canStartParse(current *model.Resume, attempt int32, owned bool, staleBefore time.Time) bool {
+	if current.ParseAttempts != attempt || !slices.Contains(parseTransitions[dal.ParseStatusParsing], current.ParseStatus) {
+		return false
+	}
+
+	return current.ParseStatus != dal.ParseStatusParsing || owned || isParseStale(current, staleBefore)
+}
+
+// isParseStale 简历在 staleBefore 之前开始解析，视为解析已中断
+func isParseStale(current *model.Resume, staleBefore time.Time) bool {
+	return current.ParseStartedAt < staleBefore.UnixMilli()
+}
+
+// FailParse 记录解析失败及原因分类
+func (r *resumeImpl) FailParse(ctx context.Context, id int64, reason entity.ParseFailReason, detail string) error {
+	parseError := []rune(entity.FormatParseError(reason, detail))
+	if len(parseError) > maxParseErrorLength {
+		parseError = parseError[:maxParseErrorLength]
+	}
+
+	ok, err := r.transitParseStatus(ctx, id, dal.ParseStatusFailed, string(parseError))
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorx.New(errno.ErrInterviewResumeParseStatusCode,
+			errorx.KV("msg", fmt.Sprintf("Resume %d is not waiting for parse result", id)))
+	}
+
+	return nil
+}
+
+// ListParseAttempts 按时间顺序获取简历的全部解析记录
+func (r *resumeImpl) ListParseAttempts(ctx context.Context, userID int64, fileKey string) (attempts []*entity.ResumeParseAttempt, err error) {
+	resumePo, err := r.getOwnedResume(ctx, userID, fileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	attemptPos, err := r.ResumeRepo.ListParseAttempts(ctx, resumePo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts = make([]*entity.ResumeParseAttempt, 0, len(attemptPos))
+	for _, attemptPo := range attemptPos {
+		attempts = append(attempts, &entity.ResumeParseAttempt{
+			ID:         attemptPo.ID,
+			ResumeID:   attemptPo.ResumeID,
+			Attempt:    attemptPo.Attempt,
+			Retry:      attemptPo.Retry,
+			Model:      attemptPo.Model,
+			DurationMs: attemptPo.DurationMs,
+			Success:    attemptPo.Outcome == dal.ParseOutcomeSuccess,
+			FailReason: entity.ParseFailReason(attemptPo.FailReason),
+			Error:      attemptPo.Error,
+			CreatedAt:  attemptPo.CreatedAt.UnixMilli(),
+		})
+	}
+
+	return attempts, nil
+}
+
+// newParseEvent 生成简历解析消息，投递次数取简历当前的 parse_attempts
+func (r *resumeImpl) newParseEvent(resume *model.Resume) (*model.OutboxEvent, error) {
+	payload, err := json.Marshal(&entity.ResumeMsg{
+		FileKey:  resume.FileKey,
+		FileID:   resume.ID,
+		Filename: resume.Filename,
+		Filetype: resume.Filetype,
+		Filesize: resume.Filesize,
+		UserID:   resume.UserID,
+		Attempt:  resume.ParseAttempts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OutboxEvent{
+		Topic:   r.ParseTopic,
+		MsgKey:  resume.FileKey,
+		Payload: string(payload),
+	}, nil
+}
+
+func (r *resumeImpl) transitParseStatus(ctx context.Context, id int64, to int32, parseError string) (bool, error) {
+	return r.ResumeRepo.UpdateParseStatus(ctx, id, parseTransitions[to], to, parseError)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mianshiba/domain/interview/dal"
+	"mianshiba/domain/interview/dal/model"
+	"mianshiba/domain/interview/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// parseRepo 只实现 StartParse 与 QueueParse，按锁定读到的状态判断，与 ResumeDAO 一致
+type parseRepo struct {
+	repository.ResumeRepository
+	resumes map[int64]*model.Resume
+}
+
+func (m *parseRepo) StartParse(ctx context.Context, id int64, canStart func(current *model.Resume) bool) (bool, error) {
+	current, ok := m.resumes[id]
+	if !ok || current.Deleted || !canStart(current) {
+		return false, nil
+	}
+
+	current.ParseStatus = dal.ParseStatusParsing
+	current.ParseError = ""
+	current.ParseStartedAt = time.Now().UnixMilli()
+	return true, nil
+}
+
+func (m *parseRepo) QueueParse(ctx context.Context, id int64, canQueue func(current *model.Resume) bool,
+	newEvent func(resume *model.Resume) (*model.OutboxEvent, error)) (*model.Resume, bool, error) {
+	current, ok := m.resumes[id]
+	if !ok || current.Deleted || !canQueue(current) {
+		return nil, false, nil
+	}
+
+	current.ParseStatus = dal.ParseStatusQueued
+	current.ParseAttempts++
+	if _, err := newEvent(current); err != nil {
+		return nil, false, err
+	}
+
+	return current, true, nil
+}
+
+func TestResumeStartParse(t *testing.T) {
+	ctx := context.Background()
+	repo := &parseRepo{resumes: map[int64]*model.Resume{
+		1: {ID: 1, ParseStatus: dal.ParseStatusQueued, ParseAttempts: 1},
+	}}
+	resumeSVC := NewResumeDomain(ctx, &ResumeComponents{ResumeRepo: repo})
+
+	started, err := resumeSVC.StartParse(ctx, 1, 1, true)
+	assert.NoError(t, err)
+	assert.True(t, started)
+	assert.Equal(t, int32(dal.ParseStatusParsing), repo.resumes[1].ParseStatus)
+
+	// 消费者中途退出，重新投递的同一消息接管解析中的简历
+	started, err = resumeSVC.StartParse(ctx, 1, 1, true)
+	assert.NoError(t, err)
+	assert.True(t, started)
+
+	// 处理权被其他消费者持有时，不接管仍在租期内的解析
+	started, err = resumeSVC.StartParse(ctx, 1, 1, false)
+	assert.NoError(t, err)
+	assert.False(t, started)
+
+	// 解析中超时，视为持有处理权的消费者已退出，重新投递的消息接管
+	repo.resumes[1].ParseStartedAt = time.Now().Add(-ResumeParseTimeout - time.Minute).UnixMilli()
+	started, err = resumeSVC.StartParse(ctx, 1, 1, false)
+	assert.NoError(t, err)
+	assert.True(t, started)
+
+	// 用户重新解析后，旧的消息不再生效
+	repo.resumes[1].ParseAttempts = 2
+	started, err = resumeSVC.StartParse(ctx, 1, 1, true)
+	assert.NoError(t, err)
+	assert.False(t, started)
+
+	// 已解析完成的简历不再解析
+	repo.resumes[1].ParseStatus = dal.ParseStatusParsed
+	started, err = resumeSVC.StartParse(ctx, 1, 2, true)
+	assert.NoError(t, err)
+	assert.False(t, started)
+
+	// 已删除或不存在的简历跳过
+	started, err = resumeSVC.StartParse(ctx, 2, 1, true)
+	assert.NoError(t, err)
+	assert.False(t, started)
+}
+
+func TestResumeMarkParseQueued(t *testing.T) {
+	ctx := context.Background()
+	repo := &parseRepo{resumes: map[int64]*model.Resume{
+		1: {ID: 1, FileKey: "resume/1", ParseStatus: dal.ParseStatusParsing, ParseAttempts: 1, ParseStartedAt: time.Now().UnixMilli()},
+	}}
+	resumeSVC := NewResumeDomain(ctx, &ResumeComponents{ResumeRepo: repo, ParseTopic: "resume_parse"})
+
+	// 解析进行中，不允许重新解析
+	_, err := resumeSVC.MarkParseQueued(ctx, 1)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), repo.resumes[1].ParseAttempts)
+
+	// 解析中超时，允许重新解析
+	repo.resumes[1].ParseStartedAt = time.Now().Add(-ResumeParseTimeout - time.Minute).UnixMilli()
+	resume, err := resumeSVC.MarkParseQueued(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(dal.ParseStatusQueued), resume.ParseStatus)
+	assert.Equal(t, int32(2), resume.ParseAttempts)
+}
@@ -0,0 +1,217 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"mianshiba/domain/question/dal/model"
+	"mianshiba/domain/question/dal/query"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// QuestionFilter 题目列表筛选条件
+type QuestionFilter struct {
+	IDs          []int64  // 不为空时只返回这些题目
+	Tags         []string // 包含任一标签
+	QuestionType string
+	Difficulty   string
+	CreatorID    int64  // 大于 0 时只返回该用户创建的题目
+	Keyword      string // 匹配题目
+	Offset       int
+	Limit        int // 不大于 0 时不分页
+}
+
+func NewQuestionDAO(db *gorm.DB) *QuestionDAO {
+	return &QuestionDAO{
+		query: query.Use(db),
+	}
+}
+
+type QuestionDAO struct {
+	query *query.Query
+}
+
+// CreateQuestions 在同一事务中批量创建题目及其标签，tags 的 key 为题目ID
+func (d *QuestionDAO) CreateQuestions(ctx context.Context, questions []*model.Question, tags map[int64][]string) error {
+	return d.query.Transaction(func(tx *query.Query) error {
+		if err := tx.Question.WithContext(ctx).CreateInBatches(questions, 100); err != nil {
+			return err
+		}
+
+		questionTags := make([]*model.QuestionTag, 0, len(questions))
+		for _, q := range questions {
+			for _, tag := range tags[q.ID] {
+				questionTags = append(questionTags, &model.QuestionTag{QuestionID: q.ID, Tag: tag})
+			}
+		}
+		if len(questionTags) == 0 {
+			return nil
+		}
+
+		return tx.QuestionTag.WithContext(ctx).CreateInBatches(questionTags, 500)
+	})
+}
+
+func (d *QuestionDAO) GetQuestionByID(ctx context.Context, id int64) (*model.Question, bool, error) {
+	question, err := d.query.Question.WithContext(ctx).Where(
+		d.query.Question.ID.Eq(id),
+		d.query.Question.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return question, true, nil
+}
+
+func (d *QuestionDAO) ListQuestions(ctx context.Context, filter *QuestionFilter) ([]*model.Question, int64, error) {
+	q := d.query.Question
+	do := q.WithContext(ctx).Where(q.Deleted.Is(false))
+
+	if len(filter.IDs) > 0 {
+		do = do.Where(q.ID.In(filter.IDs...))
+	}
+	if len(filter.Tags) > 0 {
+		qt := d.query.QuestionTag
+		do = do.Where(q.Columns(q.ID).In(qt.WithContext(ctx).Select(qt.QuestionID).Where(qt.Tag.In(filter.Tags...))))
+	}
+	if filter.QuestionType != "" {
+		do = do.Where(q.QuestionType.Eq(filter.QuestionType))
+	}
+	if filter.Difficulty != "" {
+		do = do.Where(q.Difficulty.Eq(filter.Difficulty))
+	}
+	if filter.CreatorID > 0 {
+		do = do.Where(q.CreatorID.Eq(filter.CreatorID))
+	}
+	if filter.Keyword != "" {
+		do = do.Where(q.Title.Like("%" + filter.Keyword + "%"))
+	}
+
+	do = do.Order(q.ID.Desc())
+	if filter.Limit <= 0 {
+		questions, err := do.Find()
+		return questions, int64(len(questions)), err
+	}
+
+	return do.FindByPage(filter.Offset, filter.Limit)
+}
+
+// ListTagsByQuestionIDs 题目的标签，key 为题目ID，标签按添加顺序排列
+func (d *QuestionDAO) ListTagsByQuestionIDs(ctx context.Context, questionIDs []int64) (map[int64][]string, error) {
+	tags := make(map[int64][]string, len(questionIDs))
+	if len(questionIDs) == 0 {
+		return tags, nil
+	}
+
+	qt := d.query.QuestionTag
+	questionTags, err := qt.WithContext(ctx).Where(qt.QuestionID.In(questionIDs...)).Order(qt.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range questionTags {
+		tags[t.QuestionID] = append(tags[t.QuestionID], t.Tag)
+	}
+
+	return tags, nil
+}
+
+// ListTagCounts 所有未删除题目使用的标签及题目数，按题目数倒序
+func (d *QuestionDAO) ListTagCounts(ctx context.Context) ([]*TagCount, error) {
+	q := d.query.Question
+	qt := d.query.QuestionTag
+
+	var counts []*TagCount
+	err := qt.WithContext(ctx).
+		Select(qt.Tag, qt.QuestionID.Count().As("count")).
+		Join(q, q.ID.EqCol(qt.QuestionID)).
+		Where(q.Deleted.Is(false)).
+		Group(qt.Tag).
+		Order(qt.QuestionID.Count().Desc(), qt.Tag).
+		Scan(&counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// TagCount 标签及使用该标签的题目数
+type TagCount struct {
+	Tag   string
+	Count int64
+}
+
+// ListExistingTitles 返回 titles 中已存在（未删除）的题目
+func (d *QuestionDAO) ListExistingTitles(ctx context.Context, titles []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(titles) == 0 {
+		return existing, nil
+	}
+
+	q := d.query.Question
+	var found []string
+	if err := q.WithContext(ctx).Where(q.Title.In(titles...), q.Deleted.Is(false)).Pluck(q.Title, &found); err != nil {
+		return nil, err
+	}
+
+	for _, title := range found {
+		existing[title] = true
+	}
+
+	return existing, nil
+}
+
+// UpdateQuestion 更新题目；tags 不为 nil 时同时替换题目的标签
+func (d *QuestionDAO) UpdateQuestion(ctx context.Context, id int64, updates map[string]interface{}, tags []string) error {
+	return d.query.Transaction(func(tx *query.Query) error {
+		if len(updates) > 0 {
+			_, err := tx.Question.WithContext(ctx).Where(
+				tx.Question.ID.Eq(id),
+				tx.Question.Deleted.Is(false),
+			).Updates(updates)
+			if err != nil {
+				return err
+			}
+		}
+
+		if tags == nil {
+			return nil
+		}
+
+		if _, err := tx.QuestionTag.WithContext(ctx).Where(tx.QuestionTag.QuestionID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		questionTags := make([]*model.QuestionTag, 0, len(tags))
+		for _, tag := range tags {
+			questionTags = append(questionTags, &model.QuestionTag{QuestionID: id, Tag: tag})
+		}
+		return tx.QuestionTag.WithContext(ctx).Create(questionTags...)
+	})
+}
+
+// DeleteQuestion 软删除题目，返回是否实际删除；标签保留，随题目一起不再出现在列表中
+func (d *QuestionDAO) DeleteQuestion(ctx context.Context, id int64) (bool, error) {
+	info, err := d.query.Question.WithContext(ctx).Where(
+		d.query.Question.ID.Eq(id),
+		d.query.Question.Deleted.Is(false),
+	).Updates(map[string]any{
+		"deleted":    true,
+		"deleted_at": time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return info.RowsAffected > 0, nil
+}
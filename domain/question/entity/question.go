@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+)
+
+// QuestionType 题型
+type QuestionType string
+
+const (
+	QuestionTypeConcept      QuestionType = "concept"       // 概念/原理
+	QuestionTypeCoding       QuestionType = "coding"        // 编程
+	QuestionTypeSystemDesign QuestionType = "system_design" // 系统设计
+	QuestionTypeBehavioral   QuestionType = "behavioral"    // 行为面试
+)
+
+// QuestionTypes 所有题型
+var QuestionTypes = []QuestionType{QuestionTypeConcept, QuestionTypeCoding, QuestionTypeSystemDesign, QuestionTypeBehavioral}
+
+// IsValid 是否为已知题型
+func (t QuestionType) IsValid() bool {
+	return slices.Contains(QuestionTypes, t)
+}
+
+// 题目难度，与简历解析推荐的面试难度一致
+const (
+	DifficultyJunior = "初级"
+	DifficultyMiddle = "中级"
+	DifficultySenior = "高级"
+)
+
+// Difficulties 所有难度，由易到难
+var Difficulties = []string{DifficultyJunior, DifficultyMiddle, DifficultySenior}
+
+type Question struct {
+	ID              int64        // 主键ID
+	CreatorID       int64        // 创建人用户ID
+	Title           string       // 题目
+	Content         string       // 题目详细描述（Markdown）
+	Type            QuestionType // 题型
+	Difficulty      string       // 难度：初级/中级/高级，为空表示未分级
+	Tags            []string     // 标签（小写）
+	ReferenceAnswer string       // 参考答案（Markdown）
+	Source          string       // 来源
+	CreatedAt       int64        // 创建时间（毫秒时间戳）
+	UpdatedAt       int64        // 更新时间（毫秒时间戳）
+}
+
+// TagCount 标签及使用该标签的题目数
+type TagCount struct {
+	Tag   string
+	Count int64
+}
+
+// NormalizeTags 标签去除首尾空白并转为小写，去掉空标签和重复标签，保持原有顺序
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"mianshiba/domain/question/dal"
+	"mianshiba/domain/question/dal/model"
+
+	"gorm.io/gorm"
+)
+
+func NewQuestionRepo(db *gorm.DB) QuestionRepository {
+	return dal.NewQuestionDAO(db)
+}
+
+type QuestionRepository interface {
+	CreateQuestions(ctx context.Context, questions []*model.Question, tags map[int64][]string) error
+	GetQuestionByID(ctx context.Context, id int64) (*model.Question, bool, error)
+	ListQuestions(ctx context.Context, filter *dal.QuestionFilter) ([]*model.Question, int64, error)
+	ListTagsByQuestionIDs(ctx context.Context, questionIDs []int64) (map[int64][]string, error)
+	ListTagCounts(ctx context.Context) ([]*dal.TagCount, error)
+	ListExistingTitles(ctx context.Context, titles []string) (map[string]bool, error)
+	UpdateQuestion(ctx context.Context, id int64, updates map[string]interface{}, tags []string) error
+	DeleteQuestion(ctx context.Context, id int64) (bool, error)
+}
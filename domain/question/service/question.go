@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/question/entity"
+)
+
+type CreateQuestionRequest struct {
+	Title           string
+	Content         string
+	Type            entity.QuestionType
+	Difficulty      string
+	Tags            []string
+	ReferenceAnswer string
+	Source          string
+}
+
+type UpdateQuestionRequest struct {
+	ID              int64
+	Title           *string
+	Content         *string
+	Type            *entity.QuestionType
+	Difficulty      *string
+	Tags            []string // 为 nil 时不更新标签，为空切片时清空标签
+	ReferenceAnswer *string
+	Source          *string
+}
+
+type ListQuestionsRequest struct {
+	Tags       []string
+	Type       entity.QuestionType
+	Difficulty string
+	CreatorID  int64 // 大于 0 时只返回该用户创建的题目
+	Keyword    string
+	Page       int
+	Size       int // 不大于 0 时返回全部
+}
+
+// ImportResult 批量导入结果：格式错误的题目不导入，题目与已有题目重复时跳过
+type ImportResult struct {
+	Total   int
+	Created int
+	Skipped []*ImportItemError // 已存在相同题目
+	Failed  []*ImportItemError // 校验失败
+}
+
+// ImportItemError 导入文件中某道题的错误
+type ImportItemError struct {
+	Index int // 在文件中的序号，从 1 开始
+	Title string
+	Msg   string
+}
+
+type Question interface {
+	Create(ctx context.Context, creatorID int64, req *CreateQuestionRequest) (question *entity.Question, err error)
+	Get(ctx context.Context, id int64) (question *entity.Question, err error)
+	List(ctx context.Context, req *ListQuestionsRequest) (questions []*entity.Question, total int64, err error)
+	// Update/Delete 只允许创建人操作
+	Update(ctx context.Context, userID int64, req *UpdateQuestionRequest) (question *entity.Question, err error)
+	Delete(ctx context.Context, userID int64, id int64) error
+	ListTags(ctx context.Context) (tags []*entity.TagCount, err error)
+
+	// Import 批量导入题目，文件整体无法解析时返回 ErrQuestionImportFormatCode
+	Import(ctx context.Context, creatorID int64, format Format, data []byte) (result *ImportResult, err error)
+	// Export 按筛选条件导出题目
+	Export(ctx context.Context, format Format, req *ListQuestionsRequest) (data []byte, err error)
+}
@@ -0,0 +1,317 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mianshiba/domain/question/entity"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format 题目导入导出格式
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "markdown" // 每道题一段 YAML front matter，正文为题目描述，"## 参考答案" 之后为参考答案
+)
+
+// ParseFormat 解析格式名称，md 视为 markdown
+func ParseFormat(name string) (Format, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "json":
+		return FormatJSON, true
+	case "csv":
+		return FormatCSV, true
+	case "markdown", "md":
+		return FormatMarkdown, true
+	default:
+		return "", false
+	}
+}
+
+// Ext 导出文件的扩展名
+func (f Format) Ext() string {
+	if f == FormatMarkdown {
+		return "md"
+	}
+	return string(f)
+}
+
+// ContentType 导出文件的 MIME 类型
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json; charset=utf-8"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	default:
+		return "text/markdown; charset=utf-8"
+	}
+}
+
+const (
+	markdownDelimiter      = "---"
+	markdownRule           = "***" // 导出时替换正文中只有 --- 的分隔线，渲染效果相同
+	markdownAnswerHeading  = "## 参考答案"
+	utf8BOM                = "\xef\xbb\xbf"
+	csvTagSeparators       = ",;"
+	csvExportTagsSeparator = ","
+)
+
+// csvHeader CSV 的列，导入时按表头名称匹配，列顺序和缺少的可选列不影响导入
+var csvHeader = []string{"title", "content", "type", "difficulty", "tags", "reference_answer", "source"}
+
+// questionRecord 导入导出的一道题，JSON 和 Markdown front matter 使用相同的字段名
+type questionRecord struct {
+	Title           string   `json:"title" yaml:"title"`
+	Content         string   `json:"content,omitempty" yaml:"-"`
+	Type            string   `json:"type" yaml:"type"`
+	Difficulty      string   `json:"difficulty,omitempty" yaml:"difficulty,omitempty"`
+	Tags            []string `json:"tags,omitempty" yaml:"tags,flow,omitempty"`
+	ReferenceAnswer string   `json:"reference_answer,omitempty" yaml:"-"`
+	Source          string   `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+func (r *questionRecord) toEntity() *entity.Question {
+	return &entity.Question{
+		Title:           strings.TrimSpace(r.Title),
+		Content:         strings.TrimSpace(r.Content),
+		Type:            entity.QuestionType(strings.TrimSpace(r.Type)),
+		Difficulty:      strings.TrimSpace(r.Difficulty),
+		Tags:            entity.NormalizeTags(r.Tags),
+		ReferenceAnswer: strings.TrimSpace(r.ReferenceAnswer),
+		Source:          strings.TrimSpace(r.Source),
+	}
+}
+
+func recordOf(q *entity.Question) *questionRecord {
+	return &questionRecord{
+		Title:           q.Title,
+		Content:         q.Content,
+		Type:            string(q.Type),
+		Difficulty:      q.Difficulty,
+		Tags:            q.Tags,
+		ReferenceAnswer: q.ReferenceAnswer,
+		Source:          q.Source,
+	}
+}
+
+// DecodeQuestions 按格式解析导入文件，只做格式解析，不校验题目内容
+func DecodeQuestions(format Format, data []byte) ([]*entity.Question, error) {
+	data = bytes.TrimPrefix(data, []byte(utf8BOM))
+
+	var records []*questionRecord
+	var err error
+	switch format {
+	case FormatJSON:
+		err = json.Unmarshal(data, &records)
+	case FormatCSV:
+		records, err = decodeCSV(data)
+	case FormatMarkdown:
+		records, err = decodeMarkdown(data)
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]*entity.Question, 0, len(records))
+	for _, r := range records {
+		if r == nil {
+			r = &questionRecord{}
+		}
+		questions = append(questions, r.toEntity())
+	}
+	return questions, nil
+}
+
+// EncodeQuestions 按格式导出题目，导出的文件可以直接再导入
+func EncodeQuestions(format Format, questions []*entity.Question) ([]byte, error) {
+	records := make([]*questionRecord, 0, len(questions))
+	for _, q := range questions {
+		records = append(records, recordOf(q))
+	}
+
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(records, "", "  ")
+	case FormatCSV:
+		return encodeCSV(records)
+	case FormatMarkdown:
+		return encodeMarkdown(records)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func decodeCSV(data []byte) ([]*questionRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("csv header must contain a title column")
+	}
+
+	var records []*questionRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		}
+		records = append(records, &questionRecord{
+			Title:      field("title"),
+			Content:    field("content"),
+			Type:       field("type"),
+			Difficulty: field("difficulty"),
+			Tags: strings.FieldsFunc(field("tags"), func(r rune) bool {
+				return strings.ContainsRune(csvTagSeparators, r)
+			}),
+			ReferenceAnswer: field("reference_answer"),
+			Source:          field("source"),
+		})
+	}
+}
+
+func encodeCSV(records []*questionRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	// Excel 需要 BOM 才能识别 UTF-8
+	buf.WriteString(utf8BOM)
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		err := writer.Write([]string{r.Title, r.Content, r.Type, r.Difficulty,
+			strings.Join(r.Tags, csvExportTagsSeparator), r.ReferenceAnswer, r.Source})
+		if err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// decodeMarkdown 解析多道题：每道题以 --- 开始的 front matter 开头，正文直到下一行 --- 为止，
+// 因此正文中不能出现只有 --- 的行（分隔线请使用 ***，导出时由 escapeMarkdownDelimiter 改写）
+func decodeMarkdown(data []byte) ([]*questionRecord, error) {
+	var records []*questionRecord
+	var current *questionRecord
+	var frontMatter, body []string
+	inFrontMatter := false
+
+	finish := func() {
+		if current == nil {
+			return
+		}
+		content, answer, _ := strings.Cut(strings.Join(body, "\n"), markdownAnswerHeading)
+		current.Content = content
+		current.ReferenceAnswer = answer
+		records = append(records, current)
+		current, body = nil, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		switch {
+		case strings.TrimSpace(line) == markdownDelimiter && !inFrontMatter:
+			finish()
+			inFrontMatter = true
+			frontMatter = frontMatter[:0]
+		case strings.TrimSpace(line) == markdownDelimiter:
+			current = &questionRecord{}
+			if err := yaml.Unmarshal([]byte(strings.Join(frontMatter, "\n")), current); err != nil {
+				return nil, fmt.Errorf("invalid front matter before line %d: %w", lineNo, err)
+			}
+			inFrontMatter = false
+		case inFrontMatter:
+			frontMatter = append(frontMatter, line)
+		case current != nil:
+			body = append(body, line)
+		case strings.TrimSpace(line) != "":
+			return nil, fmt.Errorf("line %d: content before the first front matter", lineNo)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if inFrontMatter {
+		return nil, fmt.Errorf("unterminated front matter at end of file")
+	}
+	finish()
+
+	return records, nil
+}
+
+func encodeMarkdown(records []*questionRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, r := range records {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		frontMatter, err := yaml.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(markdownDelimiter + "\n")
+		buf.Write(frontMatter)
+		buf.WriteString(markdownDelimiter + "\n")
+
+		if r.Content != "" {
+			buf.WriteString(escapeMarkdownDelimiter(r.Content) + "\n")
+		}
+		if r.ReferenceAnswer != "" {
+			buf.WriteString("\n" + markdownAnswerHeading + "\n\n" + escapeMarkdownDelimiter(r.ReferenceAnswer) + "\n")
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// escapeMarkdownDelimiter 将正文中只有 --- 的行改写为 ***，避免再导入时被当作下一道题的 front matter
+func escapeMarkdownDelimiter(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(strings.TrimRight(line, "\r")) == markdownDelimiter {
+			lines[i] = strings.Replace(line, markdownDelimiter, markdownRule, 1)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
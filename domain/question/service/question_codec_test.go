@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+
+	"mianshiba/domain/question/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecodeQuestions(t *testing.T) {
+	questions := []*entity.Question{
+		{
+			Title:           "Go 中 channel 的底层实现是怎样的？",
+			Content:         "请结合 hchan 结构说明发送和接收的过程。\n\n- 有缓冲\n- 无缓冲",
+			Type:            entity.QuestionTypeConcept,
+			Difficulty:      entity.DifficultyMiddle,
+			Tags:            []string{"go", "concurrency"},
+			ReferenceAnswer: "hchan 包含环形缓冲区、sendq 和 recvq，\"发送\"时……",
+			Source:          "内部整理",
+		},
+		{
+			Title: "设计一个延迟消息队列",
+			Type:  entity.QuestionTypeSystemDesign,
+			Tags:  []string{"kafka", "redis"},
+		},
+	}
+
+	for _, format := range []Format{FormatJSON, FormatCSV, FormatMarkdown} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := EncodeQuestions(format, questions)
+			assert.NoError(t, err)
+
+			decoded, err := DecodeQuestions(format, data)
+			assert.NoError(t, err)
+			assert.Equal(t, questions, decoded)
+		})
+	}
+}
+
+func TestEncodeMarkdownDelimiterInBody(t *testing.T) {
+	questions := []*entity.Question{
+		{
+			Title:           "说说 TCP 的三次握手",
+			Content:         "第一部分\n---\n第二部分",
+			ReferenceAnswer: "SYN\n  ---\nSYN+ACK",
+		},
+		{Title: "说说 TCP 的四次挥手"},
+	}
+
+	data, err := EncodeQuestions(FormatMarkdown, questions)
+	assert.NoError(t, err)
+
+	// 正文中的 --- 导出为 ***，再导入时题目数量和内容不变
+	decoded, err := DecodeQuestions(FormatMarkdown, data)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, 2)
+	assert.Equal(t, "第一部分\n***\n第二部分", decoded[0].Content)
+	assert.Equal(t, "SYN\n  ***\nSYN+ACK", decoded[0].ReferenceAnswer)
+	assert.Equal(t, "说说 TCP 的四次挥手", decoded[1].Title)
+}
+
+func TestDecodeQuestions(t *testing.T) {
+	markdown := `---
+title: Redis 持久化方式有哪些？
+type: concept
+difficulty: 初级
+tags: [Redis, 持久化, redis]
+---
+对比 RDB 和 AOF。
+
+## 参考答案
+
+RDB 为快照，AOF 记录写命令。
+---
+title: 实现 LRU 缓存
+type: coding
+---
+`
+	questions, err := DecodeQuestions(FormatMarkdown, []byte(markdown))
+	assert.NoError(t, err)
+	assert.Len(t, questions, 2)
+	assert.Equal(t, []string{"redis", "持久化"}, questions[0].Tags)
+	assert.Equal(t, "对比 RDB 和 AOF。", questions[0].Content)
+	assert.Equal(t, "RDB 为快照，AOF 记录写命令。", questions[0].ReferenceAnswer)
+	assert.Equal(t, entity.QuestionTypeCoding, questions[1].Type)
+
+	_, err = DecodeQuestions(FormatMarkdown, []byte("no front matter"))
+	assert.Error(t, err)
+
+	// 按表头匹配列，标签可用逗号或分号分隔
+	csv := "\xef\xbb\xbfType,Title,Tags\nbehavioral,介绍一个你主导的项目,\"项目; 沟通\"\n"
+	questions, err = DecodeQuestions(FormatCSV, []byte(csv))
+	assert.NoError(t, err)
+	assert.Equal(t, []*entity.Question{{Title: "介绍一个你主导的项目", Type: entity.QuestionTypeBehavioral, Tags: []string{"项目", "沟通"}}}, questions)
+
+	_, err = DecodeQuestions(FormatCSV, []byte("type,tags\nconcept,go\n"))
+	assert.Error(t, err)
+}
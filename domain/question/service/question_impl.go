@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/domain/question/dal"
+	"mianshiba/domain/question/dal/model"
+	"mianshiba/domain/question/entity"
+	"mianshiba/domain/question/repository"
+	"mianshiba/infra/contract/idgen"
+	"mianshiba/pkg/errorx"
+	"mianshiba/pkg/logs"
+	"mianshiba/types/errno"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxImportQuestions = 2000 // 单次导入的最大题目数
+
+	maxTitleLength  = 512 // 与 question.title 列长度一致
+	maxSourceLength = 255 // 与 question.source 列长度一致
+	maxTagLength    = 64  // 与 question_tag.tag 列长度一致
+	maxTags         = 20
+	idBatchSize     = 200
+)
+
+type QuestionComponents struct {
+	IDGen        idgen.IDGenerator
+	QuestionRepo repository.QuestionRepository
+	Index        QuestionIndex // 题库向量索引，为空时不启用检索
+}
+
+func NewQuestionDomain(ctx context.Context, c *QuestionComponents) Question {
+	return &questionImpl{
+		QuestionComponents: c,
+	}
+}
+
+type questionImpl struct {
+	*QuestionComponents
+}
+
+func (q *questionImpl) Create(ctx context.Context, creatorID int64, req *CreateQuestionRequest) (question *entity.Question, err error) {
+	question = &entity.Question{
+		CreatorID:       creatorID,
+		Title:           strings.TrimSpace(req.Title),
+		Content:         req.Content,
+		Type:            req.Type,
+		Difficulty:      req.Difficulty,
+		Tags:            entity.NormalizeTags(req.Tags),
+		ReferenceAnswer: req.ReferenceAnswer,
+		Source:          strings.TrimSpace(req.Source),
+	}
+	if msg := validateQuestion(question); msg != "" {
+		return nil, errorx.New(errno.ErrQuestionInvalidParamCode, errorx.KV("msg", msg))
+	}
+
+	question.ID, err = q.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("generate id error: %w", err)
+	}
+
+	po := questionDo2Po(question)
+	if err = q.QuestionRepo.CreateQuestions(ctx, []*model.Question{po}, map[int64][]string{po.ID: question.Tags}); err != nil {
+		return nil, err
+	}
+	q.syncIndex(ctx, po.ID)
+
+	return questionPo2Do(po, question.Tags), nil
+}
+
+func (q *questionImpl) Get(ctx context.Context, id int64) (question *entity.Question, err error) {
+	po, err := q.getQuestion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := q.QuestionRepo.ListTagsByQuestionIDs(ctx, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return questionPo2Do(po, tags[id]), nil
+}
+
+func (q *questionImpl) List(ctx context.Context, req *ListQuestionsRequest) (questions []*entity.Question, total int64, err error) {
+	filter := &dal.QuestionFilter{
+		Tags:         entity.NormalizeTags(req.Tags),
+		QuestionType: string(req.Type),
+		Difficulty:   req.Difficulty,
+		CreatorID:    req.CreatorID,
+		Keyword:      req.Keyword,
+	}
+	if req.Size > 0 {
+		filter.Offset = (req.Page - 1) * req.Size
+		filter.Limit = req.Size
+	}
+
+	pos, total, err := q.QuestionRepo.ListQuestions(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(pos))
+	for _, po := range pos {
+		ids = append(ids, po.ID)
+	}
+	tags, err := q.QuestionRepo.ListTagsByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	questions = make([]*entity.Question, 0, len(pos))
+	for _, po := range pos {
+		questions = append(questions, questionPo2Do(po, tags[po.ID]))
+	}
+
+	return questions, total, nil
+}
+
+func (q *questionImpl) Update(ctx context.Context, userID int64, req *UpdateQuestionRequest) (question *entity.Question, err error) {
+	question, err = q.getOwnedQuestion(ctx, userID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Title != nil {
+		question.Title = strings.TrimSpace(*req.Title)
+		updates["title"] = question.Title
+	}
+	if req.Content != nil {
+		question.Content = *req.Content
+		updates["content"] = question.Content
+	}
+	if req.Type != nil {
+		question.Type = *req.Type
+		updates["question_type"] = string(question.Type)
+	}
+	if req.Difficulty != nil {
+		question.Difficulty = *req.Difficulty
+		updates["difficulty"] = question.Difficulty
+	}
+	if req.ReferenceAnswer != nil {
+		question.ReferenceAnswer = *req.ReferenceAnswer
+		updates["reference_answer"] = question.ReferenceAnswer
+	}
+	if req.Source != nil {
+		question.Source = strings.TrimSpace(*req.Source)
+		updates["source"] = question.Source
+	}
+	var tags []string
+	if req.Tags != nil {
+		tags = entity.NormalizeTags(req.Tags)
+		question.Tags = tags
+	}
+
+	if msg := validateQuestion(question); msg != "" {
+		return nil, errorx.New(errno.ErrQuestionInvalidParamCode, errorx.KV("msg", msg))
+	}
+
+	if err = q.QuestionRepo.UpdateQuestion(ctx, req.ID, updates, tags); err != nil {
+		return nil, err
+	}
+	q.syncIndex(ctx, req.ID)
+
+	return q.Get(ctx, req.ID)
+}
+
+func (q *questionImpl) Delete(ctx context.Context, userID int64, id int64) error {
+	if _, err := q.getOwnedQuestion(ctx, userID, id); err != nil {
+		return err
+	}
+
+	deleted, err := q.QuestionRepo.DeleteQuestion(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errorx.New(errno.ErrQuestionNotFoundCode, errorx.KV("msg", "Question not found"))
+	}
+	q.syncIndex(ctx, id)
+
+	return nil
+}
+
+func (q *questionImpl) ListTags(ctx context.Context) (tags []*entity.TagCount, err error) {
+	counts, err := q.QuestionRepo.ListTagCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags = make([]*entity.TagCount, 0, len(counts))
+	for _, c := range counts {
+		tags = append(tags, &entity.TagCount{Tag: c.Tag, Count: c.Count})
+	}
+
+	return tags, nil
+}
+
+func (q *questionImpl) Import(ctx context.Context, creatorID int64, format Format, data []byte) (result *ImportResult, err error) {
+	questions, err := DecodeQuestions(format, data)
+	if err != nil {
+		return nil, errorx.New(errno.ErrQuestionImportFormatCode, errorx.KVf("msg", "Invalid %s file: %v", format, err))
+	}
+	if len(questions) > MaxImportQuestions {
+		return nil, errorx.New(errno.ErrQuestionInvalidParamCode,
+			errorx.KVf("msg", "Too many questions: %d, at most %d per import", len(questions), MaxImportQuestions))
+	}
+
+	result = &ImportResult{Total: len(questions)}
+
+	titles := make([]string, 0, len(questions))
+	for _, question := range questions {
+		titles = append(titles, question.Title)
+	}
+	existing, err := q.QuestionRepo.ListExistingTitles(ctx, titles)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := make([]*entity.Question, 0, len(questions))
+	for i, question := range questions {
+		itemErr := &ImportItemError{Index: i + 1, Title: question.Title}
+		if msg := validateQuestion(question); msg != "" {
+			itemErr.Msg = msg
+			result.Failed = append(result.Failed, itemErr)
+			continue
+		}
+		// 文件内重复的题目只导入第一道
+		if existing[question.Title] {
+			itemErr.Msg = "question already exists"
+			result.Skipped = append(result.Skipped, itemErr)
+			continue
+		}
+
+		existing[question.Title] = true
+		question.CreatorID = creatorID
+		valid = append(valid, question)
+	}
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	pos := make([]*model.Question, 0, len(valid))
+	ids := make([]int64, 0, len(valid))
+	tags := make(map[int64][]string, len(valid))
+	for batch := range slices.Chunk(valid, idBatchSize) {
+		batchIDs, err := q.IDGen.GenMultiIDs(ctx, len(batch))
+		if err != nil {
+			return nil, fmt.Errorf("generate ids error: %w", err)
+		}
+		for i, question := range batch {
+			question.ID = batchIDs[i]
+			pos = append(pos, questionDo2Po(question))
+			ids = append(ids, question.ID)
+			tags[question.ID] = question.Tags
+		}
+	}
+
+	if err = q.QuestionRepo.CreateQuestions(ctx, pos, tags); err != nil {
+		return nil, err
+	}
+	result.Created = len(pos)
+	q.syncIndex(ctx, ids...)
+
+	return result, nil
+}
+
+func (q *questionImpl) Export(ctx context.Context, format Format, req *ListQuestionsRequest) (data []byte, err error) {
+	questions, _, err := q.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeQuestions(format, questions)
+}
+
+// syncIndex 后台更新题目的向量索引，失败时由定时对账补齐
+func (q *questionImpl) syncIndex(ctx context.Context, ids ...int64) {
+	if q.Index == nil {
+		return
+	}
+
+	go func() {
+		if err := q.Index.Sync(context.WithoutCancel(ctx), ids...); err != nil {
+			logs.Errorf("Failed to sync question index, ids: %v, err: %v", ids, err)
+		}
+	}()
+}
+
+func (q *questionImpl) getQuestion(ctx context.Context, id int64) (*model.Question, error) {
+	po, exist, err := q.QuestionRepo.GetQuestionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrQuestionNotFoundCode, errorx.KV("msg", "Question not found"))
+	}
+
+	return po, nil
+}
+
+// getOwnedQuestion 获取题目并校验是否为 userID 创建
+func (q *questionImpl) getOwnedQuestion(ctx context.Context, userID int64, id int64) (*entity.Question, error) {
+	question, err := q.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if question.CreatorID != userID {
+		return nil, errorx.New(errno.ErrQuestionPermissionCode, errorx.KV("msg", "Only the creator can modify this question"))
+	}
+
+	return question, nil
+}
+
+// validateQuestion 校验题目内容，不合法时返回原因
+func validateQuestion(q *entity.Question) string {
+	switch {
+	case q.Title == "":
+		return "title is required"
+	case utf8.RuneCountInString(q.Title) > maxTitleLength:
+		return fmt.Sprintf("title exceeds %d characters", maxTitleLength)
+	case !q.Type.IsValid():
+		return fmt.Sprintf("invalid type %q, must be one of %v", q.Type, entity.QuestionTypes)
+	case q.Difficulty != "" && !slices.Contains(entity.Difficulties, q.Difficulty):
+		return fmt.Sprintf("invalid difficulty %q, must be one of %v", q.Difficulty, entity.Difficulties)
+	case utf8.RuneCountInString(q.Source) > maxSourceLength:
+		return fmt.Sprintf("source exceeds %d characters", maxSourceLength)
+	case len(q.Tags) > maxTags:
+		return fmt.Sprintf("at most %d tags", maxTags)
+	}
+
+	for _, tag := range q.Tags {
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return fmt.Sprintf("tag %q exceeds %d characters", tag, maxTagLength)
+		}
+	}
+
+	return ""
+}
+
+func questionDo2Po(q *entity.Question) *model.Question {
+	now := time.Now()
+	return &model.Question{
+		ID:              q.ID,
+		CreatorID:       q.CreatorID,
+		Title:           q.Title,
+		Content:         q.Content,
+		QuestionType:    string(q.Type),
+		Difficulty:      q.Difficulty,
+		ReferenceAnswer: q.ReferenceAnswer,
+		Source:          q.Source,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+func questionPo2Do(po *model.Question, tags []string) *entity.Question {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &entity.Question{
+		ID:              po.ID,
+		CreatorID:       po.CreatorID,
+		Title:           po.Title,
+		Content:         po.Content,
+		Type:            entity.QuestionType(po.QuestionType),
+		Difficulty:      po.Difficulty,
+		Tags:            tags,
+		ReferenceAnswer: po.ReferenceAnswer,
+		Source:          po.Source,
+		CreatedAt:       po.CreatedAt.UnixMilli(),
+		UpdatedAt:       po.UpdatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"mianshiba/domain/question/dal"
+	"mianshiba/domain/question/dal/model"
+	"mianshiba/domain/question/entity"
+	"mianshiba/domain/question/repository"
+	cembedding "mianshiba/infra/contract/embedding"
+	"mianshiba/infra/contract/vectorstore"
+	"mianshiba/pkg/lang/ptr"
+	"mianshiba/pkg/logs"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultIndexSyncInterval = 10 * time.Minute
+	defaultRetrieveTopK      = 5
+	embedBatchSize           = 32
+	maxEmbedContentRunes     = 2000 // 向量化时题目描述最多取的字数
+)
+
+// 检索结果 schema.Document 的 MetaData 字段
+const (
+	DocMetaTitle      = "title"
+	DocMetaType       = "type"
+	DocMetaDifficulty = "difficulty"
+	DocMetaTags       = "tags"
+)
+
+type QuestionIndexComponents struct {
+	Embedder     cembedding.Embedder
+	Store        vectorstore.Store
+	QuestionRepo repository.QuestionRepository
+	Model        string        // 向量模型名称，计入摘要，更换模型后全部重新向量化
+	SyncInterval time.Duration // 与数据库全量对账的间隔，默认 10 分钟
+}
+
+// QuestionIndex 题库的向量索引：题目变更时同步更新，并定时与数据库对账，
+// 多实例部署时各实例的本地索引也能收敛到一致；实现 eino Retriever，按语义相似度检索题目
+type QuestionIndex interface {
+	retriever.Retriever
+	// Sync 重新索引指定题目，内容未变化的跳过，已删除的从索引移除
+	Sync(ctx context.Context, ids ...int64) error
+	// Rebuild 与数据库全量对账
+	Rebuild(ctx context.Context) error
+	// Run 先全量对账，之后定时对账，直到 ctx 取消
+	Run(ctx context.Context)
+}
+
+func NewQuestionIndex(ctx context.Context, c *QuestionIndexComponents) QuestionIndex {
+	return &questionIndexImpl{
+		QuestionIndexComponents: c,
+	}
+}
+
+type questionIndexImpl struct {
+	*QuestionIndexComponents
+}
+
+func (x *questionIndexImpl) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	options := retriever.GetCommonOptions(&retriever.Options{TopK: ptr.Of(defaultRetrieveTopK)}, opts...)
+	topK := ptr.From(options.TopK)
+	if topK <= 0 {
+		topK = defaultRetrieveTopK
+	}
+
+	vectors, err := x.Embedder.EmbedStrings(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("embed query failed: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embed query failed: expect 1 vector, got %d", len(vectors))
+	}
+
+	// 索引中可能残留已删除的题目，多取一些
+	hits, err := x.Store.Search(ctx, vectors[0], topK*2)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		if options.ScoreThreshold != nil && hit.Score < *options.ScoreThreshold {
+			break
+		}
+		id, err := strconv.ParseInt(hit.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	questions, err := x.listQuestions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]*schema.Document, 0, topK)
+	for _, hit := range hits {
+		id, _ := strconv.ParseInt(hit.ID, 10, 64)
+		question, ok := questions[id]
+		if !ok {
+			continue
+		}
+
+		doc := &schema.Document{
+			ID:      hit.ID,
+			Content: question.Content,
+			MetaData: map[string]any{
+				DocMetaTitle:      question.Title,
+				DocMetaType:       string(question.Type),
+				DocMetaDifficulty: question.Difficulty,
+				DocMetaTags:       question.Tags,
+			},
+		}
+		docs = append(docs, doc.WithScore(hit.Score))
+		if len(docs) == topK {
+			break
+		}
+	}
+
+	return docs, nil
+}
+
+func (x *questionIndexImpl) Sync(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	questions, err := x.listQuestions(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	for _, id := range ids {
+		if _, ok := questions[id]; !ok {
+			removed = append(removed, strconv.FormatInt(id, 10))
+		}
+	}
+	if err = x.Store.Delete(ctx, removed...); err != nil {
+		return err
+	}
+
+	digests, err := x.Store.Digests(ctx)
+	if err != nil {
+		return err
+	}
+
+	return x.embed(ctx, mapValues(questions), digests)
+}
+
+func (x *questionIndexImpl) Rebuild(ctx context.Context) error {
+	pos, _, err := x.QuestionRepo.ListQuestions(ctx, &dal.QuestionFilter{})
+	if err != nil {
+		return err
+	}
+	questions, err := x.withTags(ctx, pos)
+	if err != nil {
+		return err
+	}
+
+	digests, err := x.Store.Digests(ctx)
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	for id := range digests {
+		qid, err := strconv.ParseInt(id, 10, 64)
+		if err != nil || questions[qid] == nil {
+			removed = append(removed, id)
+		}
+	}
+	if err = x.Store.Delete(ctx, removed...); err != nil {
+		return err
+	}
+
+	return x.embed(ctx, mapValues(questions), digests)
+}
+
+func (x *questionIndexImpl) Run(ctx context.Context) {
+	interval := x.SyncInterval
+	if interval <= 0 {
+		interval = defaultIndexSyncInterval
+	}
+	logs.Infof("Question index sync started, interval: %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if err := x.Rebuild(ctx); err != nil {
+			logs.Errorf("Failed to rebuild question index: %v", err)
+		} else {
+			logs.Infof("Question index rebuilt in %s", time.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			logs.Infof("Question index sync stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// embed 向量化摘要有变化的题目并写入索引
+func (x *questionIndexImpl) embed(ctx context.Context, questions []*entity.Question, digests map[string]string) error {
+	type pending struct {
+		id     string
+		text   string
+		digest string
+	}
+
+	var changed []*pending
+	for _, question := range questions {
+		text := embeddingText(question)
+		p := &pending{id: strconv.FormatInt(question.ID, 10), text: text, digest: x.digest(text)}
+		if digests[p.id] != p.digest {
+			changed = append(changed, p)
+		}
+	}
+
+	for batch := range slices.Chunk(changed, embedBatchSize) {
+		texts := make([]string, 0, len(batch))
+		for _, p := range batch {
+			texts = append(texts, p.text)
+		}
+
+		vectors, err := x.Embedder.EmbedStrings(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("embed questions failed: %w", err)
+		}
+		if len(vectors) != len(batch) {
+			return fmt.Errorf("embed questions failed: expect %d vectors, got %d", len(batch), len(vectors))
+		}
+
+		records := make([]*vectorstore.Vector, 0, len(batch))
+		for i, p := range batch {
+			records = append(records, &vectorstore.Vector{ID: p.id, Vector: vectors[i], Digest: p.digest})
+		}
+		if err = x.Store.Upsert(ctx, records...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// digest 向量化文本和模型的摘要
+func (x *questionIndexImpl) digest(text string) string {
+	sum := sha256.Sum256([]byte(x.Model + "\n" + text))
+	return hex.EncodeToString(sum[:16])
+}
+
+// listQuestions 按ID查询未删除的题目，key 为题目ID
+func (x *questionIndexImpl) listQuestions(ctx context.Context, ids []int64) (map[int64]*entity.Question, error) {
+	if len(ids) == 0 {
+		return map[int64]*entity.Question{}, nil
+	}
+
+	pos, _, err := x.QuestionRepo.ListQuestions(ctx, &dal.QuestionFilter{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	return x.withTags(ctx, pos)
+}
+
+func (x *questionIndexImpl) withTags(ctx context.Context, pos []*model.Question) (map[int64]*entity.Question, error) {
+	ids := make([]int64, 0, len(pos))
+	for _, po := range pos {
+		ids = append(ids, po.ID)
+	}
+	tags, err := x.QuestionRepo.ListTagsByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make(map[int64]*entity.Question, len(pos))
+	for _, po := range pos {
+		questions[po.ID] = questionPo2Do(po, tags[po.ID])
+	}
+	return questions, nil
+}
+
+// embeddingText 用于向量化的题目文本：题目、标签和截断后的描述
+func embeddingText(q *entity.Question) string {
+	var sb strings.Builder
+	sb.WriteString(q.Title)
+	if len(q.Tags) > 0 {
+		sb.WriteString("\n标签：")
+		sb.WriteString(strings.Join(q.Tags, "、"))
+	}
+	if content := strings.TrimSpace(q.Content); content != "" {
+		if runes := []rune(content); len(runes) > maxEmbedContentRunes {
+			content = string(runes[:maxEmbedContentRunes])
+		}
+		sb.WriteString("\n")
+		sb.WriteString(content)
+	}
+	return sb.String()
+}
+
+// mapValues 按ID排序，保证向量化顺序稳定
+func mapValues(questions map[int64]*entity.Question) []*entity.Question {
+	values := make([]*entity.Question, 0, len(questions))
+	for _, q := range questions {
+		values = append(values, q)
+	}
+	slices.SortFunc(values, func(a, b *entity.Question) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return values
+}
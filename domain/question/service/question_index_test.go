@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	"mianshiba/domain/question/dal"
+	"mianshiba/domain/question/dal/model"
+	"mianshiba/domain/question/repository"
+	"mianshiba/infra/contract/vectorstore"
+	implVectorstore "mianshiba/infra/impl/vectorstore"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEmbedder 确定性的向量化：词袋哈希到固定维度，汉字按单字计，英文按单词计
+type fakeEmbedder struct {
+	texts []string // 收到的全部文本
+}
+
+func (e *fakeEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	e.texts = append(e.texts, texts...)
+
+	vectors := make([][]float64, 0, len(texts))
+	for _, text := range texts {
+		v := make([]float64, 64)
+		for _, token := range tokenize(text) {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(token))
+			v[h.Sum32()%64]++
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
+
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// fakeQuestionRepo 只实现索引用到的查询
+type fakeQuestionRepo struct {
+	repository.QuestionRepository
+	questions map[int64]*model.Question
+	tags      map[int64][]string
+}
+
+func (r *fakeQuestionRepo) ListQuestions(ctx context.Context, filter *dal.QuestionFilter) ([]*model.Question, int64, error) {
+	var questions []*model.Question
+	for id, q := range r.questions {
+		if len(filter.IDs) == 0 || slices.Contains(filter.IDs, id) {
+			questions = append(questions, q)
+		}
+	}
+	return questions, int64(len(questions)), nil
+}
+
+func (r *fakeQuestionRepo) ListTagsByQuestionIDs(ctx context.Context, questionIDs []int64) (map[int64][]string, error) {
+	tags := make(map[int64][]string)
+	for _, id := range questionIDs {
+		tags[id] = r.tags[id]
+	}
+	return tags, nil
+}
+
+func TestQuestionIndex(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeQuestionRepo{
+		questions: map[int64]*model.Question{
+			1: {ID: 1, Title: "Redis 的持久化机制有哪些", Content: "比较 RDB 和 AOF", QuestionType: "concept", Difficulty: "中级"},
+			2: {ID: 2, Title: "Goroutine 是如何调度的", Content: "说明 GMP 模型", QuestionType: "concept", Difficulty: "高级"},
+			3: {ID: 3, Title: "MySQL 索引为什么使用 B+ 树", Content: "", QuestionType: "concept", Difficulty: "中级"},
+		},
+		tags: map[int64][]string{1: {"redis"}, 2: {"go"}, 3: {"mysql"}},
+	}
+	embedder := &fakeEmbedder{}
+	store, err := implVectorstore.NewLocalStore("")
+	assert.NoError(t, err)
+	// 数据库中不存在的题目在全量对账时移除
+	assert.NoError(t, store.Upsert(ctx, &vectorstore.Vector{ID: "99", Vector: []float64{1}}))
+
+	index := NewQuestionIndex(ctx, &QuestionIndexComponents{
+		Embedder:     embedder,
+		Store:        store,
+		QuestionRepo: repo,
+		Model:        "fake",
+	})
+
+	assert.NoError(t, index.Rebuild(ctx))
+	assert.Len(t, embedder.texts, 3)
+	digests, err := store.Digests(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, digests, 3)
+	assert.NotContains(t, digests, "99")
+
+	docs, err := index.Retrieve(ctx, "熟悉 Redis 缓存和 AOF 持久化", retriever.WithTopK(2))
+	assert.NoError(t, err)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "1", docs[0].ID)
+	assert.Equal(t, "Redis 的持久化机制有哪些", docs[0].MetaData[DocMetaTitle])
+	assert.Equal(t, []string{"redis"}, docs[0].MetaData[DocMetaTags])
+	assert.Greater(t, docs[0].Score(), docs[1].Score())
+
+	// 内容未变化时不重新向量化
+	embedder.texts = nil
+	assert.NoError(t, index.Sync(ctx, 1, 2, 3))
+	assert.Empty(t, embedder.texts)
+
+	// 修改后只向量化变化的题目，删除的题目从索引移除
+	repo.questions[2].Content = "说明 GMP 模型和抢占式调度"
+	delete(repo.questions, 3)
+	assert.NoError(t, index.Sync(ctx, 2, 3))
+	assert.Len(t, embedder.texts, 1)
+	assert.Contains(t, embedder.texts[0], "抢占式调度")
+
+	docs, err = index.Retrieve(ctx, "MySQL 索引", retriever.WithTopK(5))
+	assert.NoError(t, err)
+	assert.Len(t, docs, 2)
+	for _, doc := range docs {
+		assert.NotEqual(t, "3", doc.ID)
+	}
+}
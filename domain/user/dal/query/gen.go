@@ -16,39 +16,44 @@ import (
 )
 
 var (
-	Q         = new(Query)
-	User      *user
-	UserModel *userModel
+	Q              = new(Query)
+	User           *user
+	UserModel      *userModel
+	UserTokenUsage *userTokenUsage
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	User = &Q.User
 	UserModel = &Q.UserModel
+	UserTokenUsage = &Q.UserTokenUsage
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:        db,
-		User:      newUser(db, opts...),
-		UserModel: newUserModel(db, opts...),
+		db:             db,
+		User:           newUser(db, opts...),
+		UserModel:      newUserModel(db, opts...),
+		UserTokenUsage: newUserTokenUsage(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User      user
-	UserModel userModel
+	User           user
+	UserModel      userModel
+	UserTokenUsage userTokenUsage
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		User:      q.User.clone(db),
-		UserModel: q.UserModel.clone(db),
+		db:             db,
+		User:           q.User.clone(db),
+		UserModel:      q.UserModel.clone(db),
+		UserTokenUsage: q.UserTokenUsage.clone(db),
 	}
 }
 
@@ -62,21 +67,24 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		User:      q.User.replaceDB(db),
-		UserModel: q.UserModel.replaceDB(db),
+		db:             db,
+		User:           q.User.replaceDB(db),
+		UserModel:      q.UserModel.replaceDB(db),
+		UserTokenUsage: q.UserTokenUsage.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User      IUserDo
-	UserModel IUserModelDo
+	User           IUserDo
+	UserModel      IUserModelDo
+	UserTokenUsage IUserTokenUsageDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User:      q.User.WithContext(ctx),
-		UserModel: q.UserModel.WithContext(ctx),
+		User:           q.User.WithContext(ctx),
+		UserModel:      q.UserModel.WithContext(ctx),
+		UserTokenUsage: q.UserTokenUsage.WithContext(ctx),
 	}
 }
 
@@ -0,0 +1,69 @@
+package dal
+
+import (
+	"context"
+	"mianshiba/domain/user/dal/model"
+	"mianshiba/domain/user/dal/query"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	TokenUsageUserModel = 0 // 用户自有模型
+	TokenUsageSharedKey = 1 // 平台共享密钥
+)
+
+func NewTokenUsageDAO(db *gorm.DB) *TokenUsageDAO {
+	return &TokenUsageDAO{
+		query: query.Use(db),
+	}
+}
+
+type TokenUsageDAO struct {
+	query *query.Query
+}
+
+// AddTokenUsage 累加用户当天在某功能、模型上的用量，当天首次使用时插入
+func (dao *TokenUsageDAO) AddTokenUsage(ctx context.Context, usage *model.UserTokenUsage) error {
+	return dao.query.UserTokenUsage.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"requests":          gorm.Expr("requests + ?", usage.Requests),
+			"prompt_tokens":     gorm.Expr("prompt_tokens + ?", usage.PromptTokens),
+			"completion_tokens": gorm.Expr("completion_tokens + ?", usage.CompletionTokens),
+			"total_tokens":      gorm.Expr("total_tokens + ?", usage.TotalTokens),
+		}),
+	}).Create(usage)
+}
+
+// SumTotalTokens 用户当天使用共享密钥或自有模型的总 token 数；feature 为空时统计所有功能
+func (dao *TokenUsageDAO) SumTotalTokens(ctx context.Context, userID int64, usageDate string, sharedKey int32, feature string) (int64, error) {
+	tu := dao.query.UserTokenUsage
+	do := tu.WithContext(ctx).Where(
+		tu.UserID.Eq(userID),
+		tu.UsageDate.Eq(usageDate),
+		tu.SharedKey.Eq(sharedKey),
+	)
+	if feature != "" {
+		do = do.Where(tu.Feature.Eq(feature))
+	}
+
+	var result struct {
+		Total int64
+	}
+	if err := do.Select(tu.TotalTokens.Sum().IfNull(0).As("total")).Scan(&result); err != nil {
+		return 0, err
+	}
+
+	return result.Total, nil
+}
+
+// ListTokenUsage 用户在 [fromDate, toDate] 内的用量记录，按日期倒序
+func (dao *TokenUsageDAO) ListTokenUsage(ctx context.Context, userID int64, fromDate, toDate string) ([]*model.UserTokenUsage, error) {
+	tu := dao.query.UserTokenUsage
+	return tu.WithContext(ctx).Where(
+		tu.UserID.Eq(userID),
+		tu.UsageDate.Gte(fromDate),
+		tu.UsageDate.Lte(toDate),
+	).Order(tu.UsageDate.Desc(), tu.Feature, tu.Model).Find()
+}
@@ -2,12 +2,25 @@ package dal
 
 import (
 	"context"
+	"errors"
 	"mianshiba/domain/user/dal/model"
 	"mianshiba/domain/user/dal/query"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+const (
+	UserModelScopeAgent    = 1 << 0 // 智能体
+	UserModelScopeApp      = 1 << 1 // 应用
+	UserModelScopeWorkflow = 1 << 2 // 工作流
+)
+
+const (
+	UserModelStatusDisabled = 0 // 禁用
+	UserModelStatusEnabled  = 1 // 启用
+)
+
 func NewUserModelDAO(db *gorm.DB) *UserModelDAO {
 	return &UserModelDAO{
 		query: query.Use(db),
@@ -18,10 +31,149 @@ type UserModelDAO struct {
 	query *query.Query
 }
 
+// UserModelFilter 用户模型列表筛选条件
+type UserModelFilter struct {
+	Status       *int32
+	Scope        *int32 // 按位匹配使用范围
+	Protocol     string
+	ProviderName string
+	Keyword      string // 匹配模型名称或模型标识
+	Offset       int
+	Limit        int
+}
+
+// CreateUserModel 创建用户模型；新模型为默认模型时，同时取消该用户其他模型的默认标记
 func (u *UserModelDAO) CreateUserModel(ctx context.Context, userModel *model.UserModel) error {
-	return u.query.UserModel.WithContext(ctx).Create(userModel)
+	return u.query.Transaction(func(tx *query.Query) error {
+		if userModel.IsDefault == 1 {
+			if err := resetDefaultUserModel(ctx, tx, userModel.UserID, userModel.ID); err != nil {
+				return err
+			}
+		}
+
+		return tx.UserModel.WithContext(ctx).Create(userModel)
+	})
+}
+
+func (u *UserModelDAO) GetUserModelByID(ctx context.Context, userModelID int64) (*model.UserModel, bool, error) {
+	userModel, err := u.query.UserModel.WithContext(ctx).Where(
+		u.query.UserModel.ID.Eq(userModelID),
+		u.query.UserModel.Deleted.Is(false),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return userModel, true, nil
+}
+
+func (u *UserModelDAO) ListUserModels(ctx context.Context, userID int64, filter *UserModelFilter) ([]*model.UserModel, int64, error) {
+	um := u.query.UserModel
+	do := um.WithContext(ctx).Where(
+		um.UserID.Eq(userID),
+		um.Deleted.Is(false),
+	)
+
+	if filter.Status != nil {
+		do = do.Where(um.Status.Eq(*filter.Status))
+	}
+	if filter.Scope != nil {
+		do = do.Where(um.Scope.BitAnd(*filter.Scope).Neq(0))
+	}
+	if filter.Protocol != "" {
+		do = do.Where(um.Protocol.Eq(filter.Protocol))
+	}
+	if filter.ProviderName != "" {
+		do = do.Where(um.ProviderName.Eq(filter.ProviderName))
+	}
+	if filter.Keyword != "" {
+		keyword := "%" + filter.Keyword + "%"
+		do = do.Where(um.Where(um.Name.Like(keyword)).Or(um.ModelKey.Like(keyword)))
+	}
+
+	return do.Order(um.IsDefault.Desc(), um.ID.Desc()).FindByPage(filter.Offset, filter.Limit)
+}
+
+// UpdateUserModel 更新用户模型；设为默认模型时，同时取消该用户其他模型的默认标记
+func (u *UserModelDAO) UpdateUserModel(ctx context.Context, userID int64, userModelID int64, updates map[string]interface{}) error {
+	return u.query.Transaction(func(tx *query.Query) error {
+		if isDefault, ok := updates["is_default"].(int32); ok && isDefault == 1 {
+			if err := resetDefaultUserModel(ctx, tx, userID, userModelID); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.UserModel.WithContext(ctx).Where(
+			tx.UserModel.ID.Eq(userModelID),
+			tx.UserModel.UserID.Eq(userID),
+			tx.UserModel.Deleted.Is(false),
+		).Updates(updates)
+
+		return err
+	})
+}
+
+// DeleteUserModel 软删除用户模型，同时清除默认标记
+func (u *UserModelDAO) DeleteUserModel(ctx context.Context, userID int64, userModelID int64) error {
+	_, err := u.query.UserModel.WithContext(ctx).Where(
+		u.query.UserModel.ID.Eq(userModelID),
+		u.query.UserModel.UserID.Eq(userID),
+		u.query.UserModel.Deleted.Is(false),
+	).Updates(map[string]interface{}{
+		"deleted":    true,
+		"deleted_at": time.Now(),
+		"is_default": 0,
+	})
+
+	return err
+}
+
+// CheckUserModelNameExist 检查用户下是否已存在同名模型，excludeID 用于更新时排除自身
+func (u *UserModelDAO) CheckUserModelNameExist(ctx context.Context, userID int64, name string, excludeID int64) (bool, error) {
+	count, err := u.query.UserModel.WithContext(ctx).Where(
+		u.query.UserModel.UserID.Eq(userID),
+		u.query.UserModel.Name.Eq(name),
+		u.query.UserModel.ID.Neq(excludeID),
+		u.query.UserModel.Deleted.Is(false),
+	).Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// resetDefaultUserModel 取消用户除 exceptID 外所有模型的默认标记
+func resetDefaultUserModel(ctx context.Context, tx *query.Query, userID int64, exceptID int64) error {
+	_, err := tx.UserModel.WithContext(ctx).Where(
+		tx.UserModel.UserID.Eq(userID),
+		tx.UserModel.ID.Neq(exceptID),
+		tx.UserModel.IsDefault.Eq(1),
+	).UpdateSimple(tx.UserModel.IsDefault.Value(0))
+
+	return err
 }
 
-func (u *UserModelDAO) GetUserModelByID(ctx context.Context, userModelID int64) (*model.UserModel, error) {
-	return u.query.UserModel.WithContext(ctx).Where(u.query.UserModel.ID.Eq(userModelID)).First()
+// GetDefaultUserModel 获取用户在指定使用范围内启用的默认模型
+func (u *UserModelDAO) GetDefaultUserModel(ctx context.Context, userID int64, scope int32) (*model.UserModel, bool, error) {
+	userModel, err := u.query.UserModel.WithContext(ctx).Where(
+		u.query.UserModel.UserID.Eq(userID),
+		u.query.UserModel.IsDefault.Eq(1),
+		u.query.UserModel.Status.Eq(UserModelStatusEnabled),
+		u.query.UserModel.Deleted.Is(false),
+		u.query.UserModel.Scope.BitAnd(scope).Neq(0),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return userModel, true, nil
 }
@@ -0,0 +1,44 @@
+package entity
+
+// UsageFeature 调用模型的功能，用量按功能分别统计
+type UsageFeature string
+
+const (
+	UsageFeatureResumeParse UsageFeature = "resume_parse" // 简历解析
+	UsageFeatureInterview   UsageFeature = "interview"    // 模拟面试
+	UsageFeatureEvaluation  UsageFeature = "evaluation"   // 面试评估
+	UsageFeatureJDParse     UsageFeature = "jd_parse"     // 岗位描述解析
+)
+
+// TokenUsage 一次模型调用的用量
+type TokenUsage struct {
+	UserID           int64
+	Feature          UsageFeature
+	Model            string // 使用的模型（协议/模型）
+	SharedKey        bool   // 是否使用平台共享密钥
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
+
+// DailyUsage 用户一天的用量
+type DailyUsage struct {
+	Date             string // YYYY-MM-DD
+	Requests         int64
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+	SharedKeyTokens  int64 // 使用平台共享密钥的 token 数，计入配额
+	Items            []*UsageItem
+}
+
+// UsageItem 一天内某功能、模型的用量
+type UsageItem struct {
+	Feature          UsageFeature
+	Model            string
+	SharedKey        bool
+	Requests         int64
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
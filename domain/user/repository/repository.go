@@ -29,5 +29,21 @@ func NewUserModelRepo(db *gorm.DB) UserModelRepository {
 
 type UserModelRepository interface {
 	CreateUserModel(ctx context.Context, userModel *model.UserModel) error
-	GetUserModelByID(ctx context.Context, userModelID int64) (*model.UserModel, error)
+	GetUserModelByID(ctx context.Context, userModelID int64) (*model.UserModel, bool, error)
+	GetDefaultUserModel(ctx context.Context, userID int64, scope int32) (*model.UserModel, bool, error)
+	ListUserModels(ctx context.Context, userID int64, filter *dal.UserModelFilter) ([]*model.UserModel, int64, error)
+	UpdateUserModel(ctx context.Context, userID int64, userModelID int64, updates map[string]interface{}) error
+	DeleteUserModel(ctx context.Context, userID int64, userModelID int64) error
+	CheckUserModelNameExist(ctx context.Context, userID int64, name string, excludeID int64) (bool, error)
+}
+
+// TokenUsage
+func NewTokenUsageRepo(db *gorm.DB) TokenUsageRepository {
+	return dal.NewTokenUsageDAO(db)
+}
+
+type TokenUsageRepository interface {
+	AddTokenUsage(ctx context.Context, usage *model.UserTokenUsage) error
+	SumTotalTokens(ctx context.Context, userID int64, usageDate string, sharedKey int32, feature string) (int64, error)
+	ListTokenUsage(ctx context.Context, userID int64, fromDate, toDate string) ([]*model.UserTokenUsage, error)
 }
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"mianshiba/domain/user/entity"
+)
+
+type TokenUsage interface {
+	// Record 累加一次模型调用的用量
+	Record(ctx context.Context, usage *entity.TokenUsage) error
+	// CheckQuota 检查用户当天使用平台共享密钥的用量是否已达配额，已达配额时返回 ErrUserTokenQuotaExceededCode
+	CheckQuota(ctx context.Context, userID int64, feature entity.UsageFeature) error
+	// ListDaily 用户最近 days 天（含今天）每天的用量，按日期倒序，没有用量的日期也会返回
+	ListDaily(ctx context.Context, userID int64, days int) ([]*entity.DailyUsage, error)
+}
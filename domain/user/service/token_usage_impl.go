@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/domain/user/dal"
+	"mianshiba/domain/user/dal/model"
+	"mianshiba/domain/user/entity"
+	"mianshiba/domain/user/repository"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
+	"time"
+)
+
+const usageDateLayout = "2006-01-02"
+
+type TokenUsageComponents struct {
+	TokenUsageRepo     repository.TokenUsageRepository
+	DailyTokens        int64            // 每个用户每天使用共享密钥的 token 上限，不大于 0 时不限制
+	FeatureDailyTokens map[string]int64 // 各功能每天使用共享密钥的 token 上限，不大于 0 时不限制
+}
+
+func NewTokenUsageDomain(ctx context.Context, c *TokenUsageComponents) TokenUsage {
+	return &tokenUsageImpl{
+		TokenUsageComponents: c,
+	}
+}
+
+type tokenUsageImpl struct {
+	*TokenUsageComponents
+}
+
+func (t *tokenUsageImpl) Record(ctx context.Context, usage *entity.TokenUsage) error {
+	sharedKey := int32(dal.TokenUsageUserModel)
+	if usage.SharedKey {
+		sharedKey = dal.TokenUsageSharedKey
+	}
+
+	err := t.TokenUsageRepo.AddTokenUsage(ctx, &model.UserTokenUsage{
+		UserID:           usage.UserID,
+		UsageDate:        time.Now().Format(usageDateLayout),
+		Feature:          string(usage.Feature),
+		Model:            usage.Model,
+		SharedKey:        sharedKey,
+		Requests:         1,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.TotalTokens,
+	})
+	if err != nil {
+		return fmt.Errorf("add token usage failed: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tokenUsageImpl) CheckQuota(ctx context.Context, userID int64, feature entity.UsageFeature) error {
+	today := time.Now().Format(usageDateLayout)
+
+	if t.DailyTokens > 0 {
+		used, err := t.TokenUsageRepo.SumTotalTokens(ctx, userID, today, dal.TokenUsageSharedKey, "")
+		if err != nil {
+			return fmt.Errorf("sum token usage failed: %w", err)
+		}
+		if used >= t.DailyTokens {
+			return errorx.New(errno.ErrUserTokenQuotaExceededCode,
+				errorx.KVf("msg", "daily token quota exceeded: used %d of %d", used, t.DailyTokens))
+		}
+	}
+
+	if limit := t.FeatureDailyTokens[string(feature)]; limit > 0 {
+		used, err := t.TokenUsageRepo.SumTotalTokens(ctx, userID, today, dal.TokenUsageSharedKey, string(feature))
+		if err != nil {
+			return fmt.Errorf("sum token usage of %s failed: %w", feature, err)
+		}
+		if used >= limit {
+			return errorx.New(errno.ErrUserTokenQuotaExceededCode,
+				errorx.KVf("msg", "daily token quota of %s exceeded: used %d of %d", feature, used, limit))
+		}
+	}
+
+	return nil
+}
+
+func (t *tokenUsageImpl) ListDaily(ctx context.Context, userID int64, days int) ([]*entity.DailyUsage, error) {
+	now := time.Now()
+	toDate := now.Format(usageDateLayout)
+	fromDate := now.AddDate(0, 0, 1-days).Format(usageDateLayout)
+
+	records, err := t.TokenUsageRepo.ListTokenUsage(ctx, userID, fromDate, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("list token usage failed: %w", err)
+	}
+
+	daily := make([]*entity.DailyUsage, 0, days)
+	byDate := make(map[string]*entity.DailyUsage, days)
+	for i := 0; i < days; i++ {
+		date := now.AddDate(0, 0, -i).Format(usageDateLayout)
+		d := &entity.DailyUsage{Date: date, Items: []*entity.UsageItem{}}
+		daily = append(daily, d)
+		byDate[date] = d
+	}
+
+	for _, r := range records {
+		d, ok := byDate[r.UsageDate]
+		if !ok {
+			continue
+		}
+
+		d.Requests += int64(r.Requests)
+		d.PromptTokens += r.PromptTokens
+		d.CompletionTokens += r.CompletionTokens
+		d.TotalTokens += r.TotalTokens
+		if r.SharedKey == dal.TokenUsageSharedKey {
+			d.SharedKeyTokens += r.TotalTokens
+		}
+		d.Items = append(d.Items, &entity.UsageItem{
+			Feature:          entity.UsageFeature(r.Feature),
+			Model:            r.Model,
+			SharedKey:        r.SharedKey == dal.TokenUsageSharedKey,
+			Requests:         int64(r.Requests),
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+			TotalTokens:      r.TotalTokens,
+		})
+	}
+
+	return daily, nil
+}
@@ -20,6 +20,38 @@ type CreateUserModelRequest struct {
 	IsDefault     int32  `json:"is_default"`
 }
 
+type ListUserModelsRequest struct {
+	Status       *int32
+	Scope        *int32
+	Protocol     string
+	ProviderName string
+	Keyword      string
+	Page         int
+	Size         int
+}
+
+type UpdateUserModelRequest struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	ModelKey      string  `json:"model_key"`
+	Protocol      string  `json:"protocol"`
+	BaseURL       string  `json:"base_url"`
+	APIKey        *string `json:"api_key"` // 为空时不更新密钥
+	ProviderName  string  `json:"provider_name"`
+	MetaID        *int64  `json:"meta_id"`
+	DefaultParams *string `json:"default_params"`
+	ConfigJSON    *string `json:"config_json"`
+	Scope         *int32  `json:"scope"`
+	Status        *int32  `json:"status"`
+	IsDefault     *int32  `json:"is_default"`
+}
+
 type UserModel interface {
 	Create(ctx context.Context, userID int64, req *CreateUserModelRequest) (userModel *entity.UserModel, err error)
+	List(ctx context.Context, userID int64, req *ListUserModelsRequest) (userModels []*entity.UserModel, total int64, err error)
+	Get(ctx context.Context, userID int64, userModelID int64) (userModel *entity.UserModel, err error)
+	Update(ctx context.Context, userID int64, req *UpdateUserModelRequest) (userModel *entity.UserModel, err error)
+	Delete(ctx context.Context, userID int64, userModelID int64) error
+	CheckConfigured(ctx context.Context, userID int64) (configured bool, err error)
+	GetAPIKey(ctx context.Context, userID int64, userModelID int64) (apiKey string, err error)
 }
@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 	"fmt"
+	"mianshiba/domain/user/dal"
 	"mianshiba/domain/user/dal/model"
 	"mianshiba/domain/user/entity"
 	"mianshiba/domain/user/repository"
 	"mianshiba/infra/contract/cache"
 	"mianshiba/infra/contract/idgen"
 	"mianshiba/pkg/encrypt"
+	"mianshiba/pkg/errorx"
+	"mianshiba/types/errno"
 	"time"
 )
 
@@ -29,17 +32,21 @@ type userModelImpl struct {
 }
 
 func (u *userModelImpl) Create(ctx context.Context, userID int64, req *CreateUserModelRequest) (userModel *entity.UserModel, err error) {
+	exist, err := u.UserModelRepo.CheckUserModelNameExist(ctx, userID, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if exist {
+		return nil, errorx.New(errno.ErrUserModelNameExistCode, errorx.KV("name", req.Name))
+	}
+
 	apiKey, err := encrypt.EncryptAPIKey(req.APIKey)
 	if err != nil {
 		return nil, fmt.Errorf("encrypt api key failed: %w", err)
 	}
 
-	secretHint := ""
-	if len(req.APIKey) > 4 {
-		secretHint = "***" + req.APIKey[len(req.APIKey)-4:]
-	} else {
-		secretHint = "***" + req.APIKey
-	}
+	secretHint := maskAPIKey(req.APIKey)
 
 	modelID, err := u.IDGen.GenID(ctx)
 	if err != nil {
@@ -75,6 +82,155 @@ func (u *userModelImpl) Create(ctx context.Context, userID int64, req *CreateUse
 	return userModelPo2Do(newModel), nil
 }
 
+func (u *userModelImpl) List(ctx context.Context, userID int64, req *ListUserModelsRequest) (userModels []*entity.UserModel, total int64, err error) {
+	userModelPos, total, err := u.UserModelRepo.ListUserModels(ctx, userID, &dal.UserModelFilter{
+		Status:       req.Status,
+		Scope:        req.Scope,
+		Protocol:     req.Protocol,
+		ProviderName: req.ProviderName,
+		Keyword:      req.Keyword,
+		Offset:       (req.Page - 1) * req.Size,
+		Limit:        req.Size,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	userModels = make([]*entity.UserModel, 0, len(userModelPos))
+	for _, userModelPo := range userModelPos {
+		userModels = append(userModels, userModelPo2Do(userModelPo))
+	}
+
+	return userModels, total, nil
+}
+
+func (u *userModelImpl) Get(ctx context.Context, userID int64, userModelID int64) (userModel *entity.UserModel, err error) {
+	userModelPo, err := u.getOwnedUserModel(ctx, userID, userModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userModelPo2Do(userModelPo), nil
+}
+
+func (u *userModelImpl) Update(ctx context.Context, userID int64, req *UpdateUserModelRequest) (userModel *entity.UserModel, err error) {
+	if _, err = u.getOwnedUserModel(ctx, userID, req.ID); err != nil {
+		return nil, err
+	}
+
+	exist, err := u.UserModelRepo.CheckUserModelNameExist(ctx, userID, req.Name, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exist {
+		return nil, errorx.New(errno.ErrUserModelNameExistCode, errorx.KV("name", req.Name))
+	}
+
+	updates := map[string]interface{}{
+		"name":          req.Name,
+		"model_key":     req.ModelKey,
+		"protocol":      req.Protocol,
+		"base_url":      req.BaseURL,
+		"provider_name": req.ProviderName,
+		"updated_at":    time.Now(),
+	}
+
+	// 传入新密钥时轮换密钥，重新加密并更新脱敏提示
+	if req.APIKey != nil && *req.APIKey != "" {
+		apiKey, err := encrypt.EncryptAPIKey(*req.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("encrypt api key failed: %w", err)
+		}
+		updates["api_key_encrypted"] = apiKey
+		updates["secret_hint"] = maskAPIKey(*req.APIKey)
+	}
+
+	if req.MetaID != nil {
+		updates["meta_id"] = *req.MetaID
+	}
+	if req.DefaultParams != nil {
+		updates["default_params"] = *req.DefaultParams
+	}
+	if req.ConfigJSON != nil {
+		updates["config_json"] = *req.ConfigJSON
+	}
+	if req.Scope != nil {
+		updates["scope"] = *req.Scope
+	}
+	if req.Status != nil {
+		updates["status"] = *req.Status
+	}
+	if req.IsDefault != nil {
+		updates["is_default"] = *req.IsDefault
+	}
+
+	err = u.UserModelRepo.UpdateUserModel(ctx, userID, req.ID, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Get(ctx, userID, req.ID)
+}
+
+func (u *userModelImpl) Delete(ctx context.Context, userID int64, userModelID int64) error {
+	if _, err := u.getOwnedUserModel(ctx, userID, userModelID); err != nil {
+		return err
+	}
+
+	return u.UserModelRepo.DeleteUserModel(ctx, userID, userModelID)
+}
+
+func (u *userModelImpl) CheckConfigured(ctx context.Context, userID int64) (configured bool, err error) {
+	_, exist, err := u.UserModelRepo.GetDefaultUserModel(ctx, userID, dal.UserModelScopeAgent)
+	if err != nil {
+		return false, err
+	}
+
+	return exist, nil
+}
+
+// GetAPIKey 获取解密后的模型密钥，仅用于服务端调用模型
+func (u *userModelImpl) GetAPIKey(ctx context.Context, userID int64, userModelID int64) (apiKey string, err error) {
+	userModelPo, err := u.getOwnedUserModel(ctx, userID, userModelID)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey, err = encrypt.DecryptAPIKey(userModelPo.APIKeyEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("decrypt api key failed: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+// getOwnedUserModel 获取用户模型并校验归属
+func (u *userModelImpl) getOwnedUserModel(ctx context.Context, userID int64, userModelID int64) (*model.UserModel, error) {
+	userModelPo, exist, err := u.UserModelRepo.GetUserModelByID(ctx, userModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exist {
+		return nil, errorx.New(errno.ErrUserModelNotFoundCode, errorx.KV("msg", "User model not found"))
+	}
+
+	if userModelPo.UserID != userID {
+		return nil, errorx.New(errno.ErrUserPermissionCode, errorx.KV("msg", "No permission to access this user model"))
+	}
+
+	return userModelPo, nil
+}
+
+// maskAPIKey 生成密钥脱敏提示，只保留末尾4位
+func maskAPIKey(apiKey string) string {
+	if len(apiKey) > 4 {
+		return "***" + apiKey[len(apiKey)-4:]
+	}
+	return "***" + apiKey
+}
+
 func userModelPo2Do(model *model.UserModel) *entity.UserModel {
 	return &entity.UserModel{
 		ID:            model.ID,
@@ -4,15 +4,35 @@ go 1.24.3
 
 require (
 	github.com/apache/thrift v0.22.0
+	github.com/cloudwego/eino v0.7.18
+	github.com/cloudwego/eino-ext/components/document/parser/pdf v0.0.0-20251105133430-149843ccfe5d
+	github.com/cloudwego/eino-ext/components/model/ark v0.1.62
+	github.com/cloudwego/eino-ext/components/model/claude v0.1.13
+	github.com/cloudwego/eino-ext/components/model/deepseek v0.1.2
+	github.com/cloudwego/eino-ext/components/model/gemini v0.1.25
+	github.com/cloudwego/eino-ext/components/model/ollama v0.1.3
+	github.com/cloudwego/eino-ext/components/model/openai v0.1.7
+	github.com/cloudwego/eino-ext/components/model/qwen v0.1.4
+	github.com/cloudwego/eino-ext/libs/acl/openai v0.1.12
 	github.com/cloudwego/hertz v0.10.3
+	github.com/confluentinc/confluent-kafka-go/v2 v2.13.0
+	github.com/coze-dev/coze-studio/backend v0.0.0-20260107082628-95d8ace66e7d
+	github.com/dslipak/pdf v0.0.2
+	github.com/eino-contrib/ollama v0.1.0
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/google/uuid v1.6.0
 	github.com/hertz-contrib/cors v0.1.0
 	github.com/joho/godotenv v1.5.1
+	github.com/minio/minio-go/v7 v7.0.97
 	github.com/onsi/gomega v1.39.0
 	github.com/redis/go-redis/v9 v9.7.3
 	github.com/stretchr/testify v1.11.1
+	github.com/unidoc/unipdf/v3 v3.0.1
+	github.com/volcengine/volcengine-go-sdk v1.1.49
 	golang.org/x/crypto v0.46.0
+	golang.org/x/net v0.47.0
+	golang.org/x/text v0.32.0
+	google.golang.org/genai v1.41.0
 	gopkg.in/yaml.v3 v3.0.1
 	gorm.io/driver/mysql v1.5.7
 	gorm.io/gen v0.3.27
@@ -49,27 +69,13 @@ require (
 	github.com/cenkalti/backoff/v4 v4.3.0 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/cloudwego/base64x v0.1.6 // indirect
-	github.com/cloudwego/eino v0.7.18 // indirect
-	github.com/cloudwego/eino-ext/components/document/parser/pdf v0.0.0-20251105133430-149843ccfe5d // indirect
-	github.com/cloudwego/eino-ext/components/model/ark v0.1.62 // indirect
-	github.com/cloudwego/eino-ext/components/model/claude v0.1.13 // indirect
-	github.com/cloudwego/eino-ext/components/model/deepseek v0.1.2 // indirect
-	github.com/cloudwego/eino-ext/components/model/gemini v0.1.25 // indirect
-	github.com/cloudwego/eino-ext/components/model/ollama v0.1.3 // indirect
-	github.com/cloudwego/eino-ext/components/model/openai v0.1.7 // indirect
-	github.com/cloudwego/eino-ext/components/model/qwen v0.1.4 // indirect
-	github.com/cloudwego/eino-ext/libs/acl/openai v0.1.12 // indirect
 	github.com/cloudwego/gopkg v0.1.4 // indirect
 	github.com/cloudwego/netpoll v0.7.0 // indirect
 	github.com/cohesion-org/deepseek-go v1.3.2 // indirect
-	github.com/confluentinc/confluent-kafka-go/v2 v2.13.0 // indirect
-	github.com/coze-dev/coze-studio/backend v0.0.0-20260107082628-95d8ace66e7d // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
-	github.com/dslipak/pdf v0.0.2 // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
 	github.com/eino-contrib/jsonschema v1.0.3 // indirect
-	github.com/eino-contrib/ollama v0.1.0 // indirect
 	github.com/evanphx/json-patch v4.12.0+incompatible // indirect
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/fsnotify/fsnotify v1.9.0 // indirect
@@ -95,7 +101,6 @@ require (
 	github.com/meguminnnnnnnnn/go-openai v0.1.1 // indirect
 	github.com/minio/crc64nvme v1.1.0 // indirect
 	github.com/minio/md5-simd v1.1.2 // indirect
-	github.com/minio/minio-go/v7 v7.0.97 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/nikolalohinski/gonja v1.5.3 // indirect
@@ -114,9 +119,7 @@ require (
 	github.com/tidwall/sjson v1.2.5 // indirect
 	github.com/tinylib/msgp v1.3.0 // indirect
 	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
-	github.com/unidoc/unipdf/v3 v3.0.1 // indirect
 	github.com/volcengine/volc-sdk-golang v1.0.211 // indirect
-	github.com/volcengine/volcengine-go-sdk v1.1.49 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
 	github.com/yargevad/filepathx v1.0.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
@@ -130,15 +133,12 @@ require (
 	golang.org/x/exp v0.0.0-20250305212735-054e65f0b394 // indirect
 	golang.org/x/image v0.22.0 // indirect
 	golang.org/x/mod v0.30.0 // indirect
-	golang.org/x/net v0.47.0 // indirect
 	golang.org/x/oauth2 v0.28.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
-	golang.org/x/text v0.32.0 // indirect
 	golang.org/x/time v0.11.0 // indirect
 	golang.org/x/tools v0.39.0 // indirect
 	google.golang.org/api v0.222.0 // indirect
-	google.golang.org/genai v1.41.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250311190419-81fb87f6b8bf // indirect
 	google.golang.org/grpc v1.71.0 // indirect
 	google.golang.org/protobuf v1.36.7 // indirect
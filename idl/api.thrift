@@ -1,8 +1,10 @@
 include "./user/user.thrift"
 include "./interview/interview.thrift"
+include "./question/question.thrift"
 include "./app/developer_api.thrift"
 
 namespace go mianshiba
 
 service UserService extends user.UserService {}
-service InterviewService extends interview.InterviewService {}
\ No newline at end of file
+service InterviewService extends interview.InterviewService {}
+service QuestionService extends question.QuestionService {}
\ No newline at end of file
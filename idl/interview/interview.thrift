@@ -87,13 +87,18 @@ struct ResumeInfo {
     4: required string filetype                            // 文件类型
     5: required i64 filesize                               // 文件大小（字节）
     6: required i64 upload_at                              // 上传时间戳
-    7: required i32 status                                 // 状态（uploading, uploaded, deleted）
+    7: required i32 status                                 // 状态（1已上传 2解析中 3已解析 4已删除 5失败）
     8: required i64 user_id                                // 用户ID
+    9: required i32 parse_status                           // 解析状态（0已上传 1已入队 2解析中 3成功 4失败）
+    10: optional string parse_error                        // 解析失败原因，格式为 分类: 详情
+    11: required i32 profile_version                       // 当前结构化信息版本号（0表示尚未解析）
+    12: optional string parse_fail_reason                  // 解析失败原因分类（unsupported_file/unreadable_file/model_timeout/model_error/quota_exceeded/invalid_json/empty_result/internal/queue_failed）
+    13: required i32 parse_attempts                        // 解析任务投递次数（首次上传及每次重新解析各计一次）
 }
 
 // 获取简历列表请求
 struct ResumeListRequest {
-    1: optional string status (api.query="status")         // 状态筛选
+    1: optional string status (api.query="status")         // 状态筛选（uploaded/parsing/parsed/failed 或状态值）
     2: optional string keyword (api.query="keyword")       // 关键词搜索
     3: optional i32 page (api.query="page", api.vd="$>=1") // 页码，默认 1
     4: optional i32 size (api.query="size", api.vd="$>=1&&$<=100")  // 每页数量，默认 20
@@ -118,12 +123,479 @@ struct ResumeDetailRequest {
 // 获取简历详情响应
 struct ResumeDetailResponse {
     1: required ResumeInfo data
+    2: optional ResumeProfile profile                      // 结构化简历信息，解析完成后返回
+    3: required list<ResumeParseAttempt> parse_attempts    // 解析记录，按时间升序
     
     253: required i32 code
     254: required string msg
 }
 
-// ==================== 5. 基础响应结构体 ====================
+// 简历解析记录，自动重试也各记一条
+struct ResumeParseAttempt {
+    1: required i32 attempt                                // 第几次投递
+    2: required i32 retry                                  // 同一次投递内的自动重试序号（0表示首次执行）
+    3: required string model                               // 使用的模型（协议/模型）
+    4: required i64 duration_ms                            // 耗时（毫秒）
+    5: required bool success                               // 是否成功
+    6: optional string fail_reason                         // 失败原因分类
+    7: optional string error                               // 失败详情
+    8: required i64 created_at                             // 时间戳
+}
+
+// 重新解析简历请求
+struct ReparseResumeRequest {
+    1: required string file_key (api.path="file_key")      // 文件标识（包含 / 时需 URL 编码）
+}
+
+// 重新解析简历响应
+struct ReparseResumeResponse {
+    1: required ResumeInfo data                            // 已入队的简历
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 候选人基本信息
+struct ResumeBasicInfo {
+    1: string name                                         // 姓名
+    2: string work_years                                   // 工作年限
+    3: string contact                                      // 联系方式
+}
+
+// 教育经历
+struct ResumeEducation {
+    1: string school                                       // 学校
+    2: string major                                        // 专业
+    3: string degree                                       // 学历
+    4: string graduation_year                              // 毕业年份
+}
+
+// 工作经历
+struct ResumeWorkExperience {
+    1: string company                                      // 公司
+    2: string position                                     // 职位
+    3: string duration                                     // 工作时间段
+    4: string responsibilities                             // 主要职责
+}
+
+// 项目经历
+struct ResumeProject {
+    1: string name                                         // 项目名称
+    2: string description                                  // 项目描述
+    3: list<string> tech_stack                             // 项目技术栈
+    4: string contribution                                 // 个人贡献
+}
+
+// 结构化简历信息（模型解析结果，可由用户修正）
+struct ResumeProfile {
+    1: ResumeBasicInfo basic_info                          // 基本信息
+    2: list<ResumeEducation> education                     // 教育经历
+    3: list<ResumeWorkExperience> work_experience          // 工作经历
+    4: list<string> tech_stack                             // 技术栈
+    5: list<ResumeProject> projects                        // 项目经历
+    6: list<string> skills                                 // 技能
+    7: list<string> certifications                         // 证书
+    8: string strengths                                    // 核心优势
+    9: string potential_weaknesses                         // 可能的不足
+    10: string recommended_difficulty                      // 推荐面试难度（初级/中级/高级）
+    11: list<string> interview_focus_areas                 // 面试关注领域
+    12: list<string> suggested_questions_directions        // 建议的提问方向
+}
+
+// 更新结构化简历信息请求
+struct UpdateResumeProfileRequest {
+    1: required string file_key (api.body="file_key")      // 文件标识
+    2: required ResumeProfile profile (api.body="profile") // 修改后的结构化简历信息
+}
+
+// 更新结构化简历信息响应
+struct UpdateResumeProfileResponse {
+    1: required ResumeInfo data
+    2: required ResumeProfile profile
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 结构化简历信息版本
+struct ResumeProfileVersion {
+    1: required i32 version                                // 版本号
+    2: required i32 source                                 // 来源（1模型解析 2用户修改）
+    3: required ResumeProfile profile                      // 该版本的结构化简历信息
+    4: required i64 created_at                             // 创建时间戳
+}
+
+// 获取结构化简历信息版本列表请求
+struct ResumeProfileVersionsRequest {
+    1: required string file_key (api.query="file_key")     // 文件标识
+}
+
+// 获取结构化简历信息版本列表响应
+struct ResumeProfileVersionsResponse {
+    1: required list<ResumeProfileVersion> list            // 按版本号升序
+    
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 5. 模拟面试相关 ====================
+
+// 面试会话信息
+struct InterviewSessionInfo {
+    1: required i64 id                                     // 会话ID
+    2: required i64 resume_id                              // 简历ID
+    3: required i32 status                                 // 会话状态（1进行中 2已结束）
+    4: required string difficulty                          // 面试难度
+    5: required i32 turn_count                             // 已回答轮次
+    6: required i32 max_turns                              // 最大提问轮次
+    7: required i64 started_at                             // 开始时间戳
+    8: required i64 ended_at                               // 结束时间戳（未结束为0）
+    9: required i64 user_id                                // 用户ID
+    10: required i64 job_description_id                    // 目标岗位描述ID（0表示不针对具体岗位）
+    11: required i64 plan_id                               // 面试计划ID（0表示不使用面试计划）
+    12: required i32 current_round                         // 当前面试环节序号（0表示尚未开始或不使用面试计划）
+    13: required list<string> panel_personas               // 面试官小组成员的角色（为空表示不使用面试官小组）
+}
+
+// 面试轮次信息
+struct InterviewTurnInfo {
+    1: required i64 id                                     // 轮次ID
+    2: required i32 turn_no                                // 轮次序号（从1开始）
+    3: required string question                            // 面试官提问
+    4: required string answer                              // 候选人回答
+    5: required i32 status                                 // 轮次状态（1待回答 2已回答）
+    6: required i64 created_at                             // 提问时间戳
+    7: required i32 round_no                               // 所属面试环节序号（0表示不使用面试计划）
+    8: required string persona                             // 提问的面试官角色（为空表示未区分角色）
+}
+
+// 开始面试请求
+struct StartInterviewSessionRequest {
+    1: required i64 resume_id (api.form="resume_id")       // 简历ID
+    2: optional i32 max_turns (api.form="max_turns", api.vd="$>=1&&$<=50") // 最大提问轮次，默认 10
+    3: optional bool stream (api.form="stream")            // 为 true 时不同步生成问题，由流式接口获取
+    4: optional i64 job_description_id (api.form="job_description_id") // 目标岗位描述ID，需已解析完成；面试官围绕岗位要求提问
+    5: optional i64 plan_id (api.form="plan_id")           // 面试计划ID，按计划的环节依次提问，此时 max_turns 不生效
+    6: optional string panel_personas (api.form="panel_personas") // 面试官小组成员的角色，多个用逗号分隔，由主持人决定每个问题由谁提出；不能与 plan_id 同时使用
+}
+
+// 开始面试响应
+struct StartInterviewSessionResponse {
+    1: required InterviewSessionInfo session
+    2: optional InterviewTurnInfo turn                     // 第一个问题（stream 为 true 时为空）
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 面试会话ID请求
+struct InterviewSessionIDRequest {
+    1: required i64 id (api.path="id")                     // 会话ID
+}
+
+// 提交回答请求
+struct SubmitInterviewAnswerRequest {
+    1: required i64 id (api.path="id")                     // 会话ID
+    2: required string answer (api.form="answer")          // 候选人回答
+    3: optional bool stream (api.form="stream")            // 为 true 时不同步生成下一个问题，由流式接口获取
+}
+
+// 提交回答响应
+struct SubmitInterviewAnswerResponse {
+    1: required InterviewSessionInfo session
+    2: optional InterviewTurnInfo next_turn                // 下一个问题（面试结束或 stream 为 true 时为空）
+    3: required bool finished                              // 是否已达到最大轮次
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 获取下一个问题响应
+struct NextInterviewQuestionResponse {
+    1: required InterviewSessionInfo session
+    2: optional InterviewTurnInfo turn                     // 当前待回答的问题（面试结束时为空）
+    3: required list<InterviewTurnInfo> history            // 已回答的历史轮次
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 结束面试响应
+struct EndInterviewSessionResponse {
+    1: required InterviewSessionInfo session
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 流式提问事件（SSE 的 data 字段）
+struct InterviewStreamEvent {
+    1: optional string delta                               // 增量内容（delta 事件）
+    2: optional InterviewTurnInfo turn                     // 持久化后的轮次（done 事件）
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 单题评分
+struct InterviewAnswerScore {
+    1: required i64 turn_id                                // 轮次ID
+    2: required i32 turn_no                                // 轮次序号
+    3: required i32 correctness                            // 正确性（0-10）
+    4: required i32 depth                                  // 深度（0-10）
+    5: required i32 communication                          // 表达（0-10）
+    6: required i32 relevance                              // 与候选人技术栈的相关度（0-10）
+    7: required string comment                             // 点评
+}
+
+// 面试评估报告
+struct InterviewEvaluationInfo {
+    1: required i64 session_id                             // 会话ID
+    2: required i32 status                                 // 评估状态（1评估中 2已完成 3失败）
+    3: required i32 overall_score                          // 综合得分（0-100）
+    4: required string summary                             // 总体评价
+    5: required list<string> strengths                     // 优势
+    6: required list<string> gaps                          // 不足
+    7: required list<string> suggestions                   // 学习建议
+    8: required list<InterviewAnswerScore> answer_scores   // 单题评分
+    9: required string error_msg                           // 评估失败原因
+    10: required i64 evaluated_at                          // 评估完成时间戳（未完成为0）
+}
+
+// 获取面试评估报告响应
+struct InterviewEvaluationResponse {
+    1: required InterviewEvaluationInfo data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 6. 岗位描述相关 ====================
+
+// 获取岗位描述文件上传URL请求
+struct JobDescriptionUploadUrlRequest {
+    1: string filename (api.query="filename")              // 文件名
+    2: string filetype (api.query="filetype")              // 文件类型
+}
+
+// 获取岗位描述文件上传URL响应
+struct JobDescriptionUploadUrlResponse {
+    1: required string upload_url                          // 上传URL
+    2: required string file_key                            // 文件标识，创建岗位描述时传入
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 创建岗位描述请求，content 与 file_key 二选一
+struct CreateJobDescriptionRequest {
+    1: optional string title (api.form="title")            // 岗位名称，为空时取解析结果
+    2: optional string company (api.form="company")        // 公司名称，为空时取解析结果
+    3: optional string content (api.form="content")        // 粘贴的岗位描述文本
+    4: optional string file_key (api.form="file_key")      // 上传的文件标识
+    5: optional string filename (api.form="filename")      // 上传的文件名
+    6: optional string filetype (api.form="filetype")      // 上传的文件类型
+}
+
+// 岗位描述信息
+struct JobDescriptionInfo {
+    1: required i64 id                                     // 岗位描述ID
+    2: required string title                               // 岗位名称
+    3: required string company                             // 公司名称
+    4: required i32 source                                 // 来源（1粘贴文本 2上传文件）
+    5: required string filename                            // 上传的文件名
+    6: required i32 parse_status                           // 解析状态（1解析中 2成功 3失败）
+    7: optional string parse_error                         // 解析失败原因
+    8: required i64 created_at                             // 创建时间戳
+    9: required i64 user_id                                // 用户ID
+}
+
+// 岗位描述解析结果
+struct JobDescriptionProfile {
+    1: string title                                        // 岗位名称
+    2: string company                                      // 公司名称
+    3: string seniority                                    // 职级（初级/中级/高级/专家）
+    4: i32 min_work_years                                  // 最少工作年限
+    5: list<string> required_skills                        // 必备技能
+    6: list<string> preferred_skills                       // 加分技能
+    7: list<string> responsibilities                       // 岗位职责
+    8: list<string> keywords                               // 其他关键词
+}
+
+// 岗位描述响应
+struct JobDescriptionResponse {
+    1: required JobDescriptionInfo data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 获取岗位描述列表请求
+struct JobDescriptionListRequest {
+    1: optional string keyword (api.query="keyword")       // 匹配岗位名称或公司名称
+    2: optional i32 page (api.query="page", api.vd="$>=1") // 页码，默认 1
+    3: optional i32 size (api.query="size", api.vd="$>=1&&$<=100")  // 每页数量，默认 20
+}
+
+// 获取岗位描述列表响应
+struct JobDescriptionListResponse {
+    1: required list<JobDescriptionInfo> list
+    2: required i64 total
+    3: required i32 page
+    4: required i32 size
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 岗位描述ID请求
+struct JobDescriptionIDRequest {
+    1: required i64 id (api.path="id")                     // 岗位描述ID
+}
+
+// 获取岗位描述详情响应
+struct JobDescriptionDetailResponse {
+    1: required JobDescriptionInfo data
+    2: required string content                             // 岗位描述原文
+    3: optional JobDescriptionProfile profile              // 解析结果，解析完成后返回
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 简历与岗位匹配分析请求
+struct JobMatchRequest {
+    1: required i64 id (api.path="id")                     // 岗位描述ID
+    2: required i64 resume_id (api.query="resume_id")      // 简历ID，需已解析完成
+}
+
+// 简历与岗位匹配报告
+struct JobMatchReport {
+    1: required i64 job_description_id                     // 岗位描述ID
+    2: required i64 resume_id                              // 简历ID
+    3: required i32 fit_score                              // 匹配度（0-100），必备技能占 70%，加分技能占 20%，关键词占 10%
+    4: required list<string> covered_skills               // 简历中已体现的技能
+    5: required list<string> missing_skills               // 简历中未体现的必备技能
+    6: required list<string> missing_preferred_skills     // 简历中未体现的加分技能
+    7: required list<string> keyword_gaps                 // 简历中未出现的岗位关键词
+    8: required string seniority                           // 岗位职级
+    9: required string candidate_work_years                // 候选人工作年限（来自简历）
+}
+
+// 简历与岗位匹配分析响应
+struct JobMatchResponse {
+    1: required JobMatchReport data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 7. 面试计划相关 ====================
+
+// 面试计划环节
+struct InterviewPlanRound {
+    1: i32 round_no                                        // 环节序号（从1开始，保存时按顺序重新编号）
+    2: string type                                         // 环节类型（basics/project/algorithm/system_design/behavioral）
+    3: string name                                         // 环节名称，为空时取环节类型的默认名称
+    4: i32 duration_minutes                                // 环节时长（分钟，0表示不限时），超时后进入下一环节
+    5: i32 question_count                                  // 环节提问数
+    6: string difficulty                                   // 环节难度（初级/中级/高级），为空时使用面试难度
+    7: string persona                                      // 面试官角色（interviewer/tech_lead/architect/hr），为空时为 interviewer
+}
+
+// 面试计划信息
+struct InterviewPlanInfo {
+    1: required i64 id                                     // 面试计划ID
+    2: required string name                                // 计划名称
+    3: required string description                         // 计划说明
+    4: required bool builtin                               // 是否为内置计划（内置计划不可修改）
+    5: required list<InterviewPlanRound> rounds            // 按顺序进行的环节
+    6: required i32 total_questions                        // 总提问数
+    7: required i64 created_at                             // 创建时间戳（内置计划为0）
+    8: required i64 updated_at                             // 更新时间戳（内置计划为0）
+}
+
+// 获取面试计划列表响应
+struct InterviewPlanListResponse {
+    1: required list<InterviewPlanInfo> list               // 内置计划在前，之后为用户创建的计划
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 面试计划ID请求
+struct InterviewPlanIDRequest {
+    1: required i64 id (api.path="id")                     // 面试计划ID
+}
+
+// 创建面试计划请求
+struct CreateInterviewPlanRequest {
+    1: required string name (api.body="name")              // 计划名称
+    2: optional string description (api.body="description") // 计划说明
+    3: required list<InterviewPlanRound> rounds (api.body="rounds") // 按顺序进行的环节
+}
+
+// 修改面试计划请求（整体替换，已开始的面试不受影响）
+struct UpdateInterviewPlanRequest {
+    1: required i64 id (api.path="id")                     // 面试计划ID
+    2: required string name (api.body="name")              // 计划名称
+    3: optional string description (api.body="description") // 计划说明
+    4: required list<InterviewPlanRound> rounds (api.body="rounds") // 按顺序进行的环节
+}
+
+// 面试计划响应
+struct InterviewPlanResponse {
+    1: required InterviewPlanInfo data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 面试会话的环节进度
+struct InterviewRoundInfo {
+    1: required i32 round_no                               // 环节序号
+    2: required string type                                // 环节类型
+    3: required string name                                // 环节名称
+    4: required i32 duration_minutes                       // 环节时长（分钟，0表示不限时）
+    5: required i32 question_count                         // 环节提问数
+    6: required string difficulty                          // 环节难度（为空时使用面试难度）
+    7: required string persona                             // 面试官角色
+    8: required i32 status                                 // 环节状态（1未开始 2进行中 3已完成）
+    9: required i32 asked_count                            // 已提问数
+    10: required i32 answered_count                        // 已回答数
+    11: required i64 started_at                            // 开始时间戳（未开始为0）
+    12: required i64 ended_at                              // 结束时间戳（未结束为0）
+}
+
+// 获取面试会话环节进度响应
+struct InterviewSessionRoundsResponse {
+    1: required list<InterviewRoundInfo> list              // 按环节顺序排列，不使用面试计划时为空
+    2: required i32 current_round                          // 当前环节序号
+
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 8. 面试官小组相关 ====================
+
+// 面试官角色
+struct InterviewPersonaInfo {
+    1: required string key                                 // 角色标识
+    2: required string name                                // 角色名称
+    3: required string instruction                         // 角色身份和考察重点
+    4: required list<string> focus_areas                   // 关注领域
+    5: required string tone                                // 提问语气
+}
+
+// 获取面试官角色列表响应
+struct InterviewPersonaListResponse {
+    1: required list<InterviewPersonaInfo> list            // 内置角色在前，之后为配置新增的角色
+
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 9. 基础响应结构体 ====================
 
 struct EmptyRequest {}
 
@@ -134,7 +606,7 @@ struct BaseResponse {
     254: required string msg
 }
 
-// ==================== 6. 服务定义 ====================
+// ==================== 10. 服务定义 ====================
 
 // 面试服务定义
 service InterviewService {
@@ -182,7 +654,168 @@ service InterviewService {
 
     // 7. 获取简历详情
     ResumeDetailResponse GetResumeDetail(1: ResumeDetailRequest request) (
-        api.get="/api/interview/resume/detail/:file_key",
+        api.get="/api/interview/resume/detail/*file_key",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 8. 开始模拟面试
+    StartInterviewSessionResponse StartInterviewSession(1: StartInterviewSessionRequest request) (
+        api.post="/api/interview/session/start",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 9. 提交回答
+    SubmitInterviewAnswerResponse SubmitInterviewAnswer(1: SubmitInterviewAnswerRequest request) (
+        api.post="/api/interview/session/:id/answer",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 10. 获取下一个问题
+    NextInterviewQuestionResponse GetNextInterviewQuestion(1: InterviewSessionIDRequest request) (
+        api.get="/api/interview/session/:id/next",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 11. 结束模拟面试
+    EndInterviewSessionResponse EndInterviewSession(1: InterviewSessionIDRequest request) (
+        api.post="/api/interview/session/:id/end",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 12. 流式获取下一个问题（SSE：delta 事件推送增量内容，done 事件携带持久化后的轮次，error 事件携带错误信息）
+    InterviewStreamEvent StreamInterviewQuestion(1: InterviewSessionIDRequest request) (
+        api.get="/api/interview/session/:id/stream",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 13. 获取面试评估报告（面试结束后异步生成，status 为 1 时请稍后重试）
+    InterviewEvaluationResponse GetInterviewEvaluation(1: InterviewSessionIDRequest request) (
+        api.get="/api/interview/session/:id/evaluation",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 14. 修改结构化简历信息（保存为新版本，后续开始的面试使用修改后的内容）
+    UpdateResumeProfileResponse UpdateResumeProfile(1: UpdateResumeProfileRequest request) (
+        api.put="/api/interview/resume/profile",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 15. 获取结构化简历信息的全部版本（版本 1 起为模型解析结果，用户修改依次递增）
+    ResumeProfileVersionsResponse GetResumeProfileVersions(1: ResumeProfileVersionsRequest request) (
+        api.get="/api/interview/resume/profile/versions",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 16. 重新解析简历（仅限解析成功或失败的简历，使用当前配置的模型，成功后生成新的结构化信息版本）
+    ReparseResumeResponse ReparseResume(1: ReparseResumeRequest request) (
+        api.post="/api/interview/resume/:file_key/reparse",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 17. 获取岗位描述文件上传URL
+    JobDescriptionUploadUrlResponse GetJobDescriptionUploadUrl(1: JobDescriptionUploadUrlRequest request) (
+        api.get="/api/interview/jd/upload/url",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 18. 创建岗位描述（粘贴文本或上传文件，创建后异步解析，parse_status 为 1 时请稍后查询详情）
+    JobDescriptionResponse CreateJobDescription(1: CreateJobDescriptionRequest request) (
+        api.post="/api/interview/jd/create",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 19. 获取岗位描述列表
+    JobDescriptionListResponse GetJobDescriptionList(1: JobDescriptionListRequest request) (
+        api.get="/api/interview/jd/list",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 20. 获取岗位描述详情
+    JobDescriptionDetailResponse GetJobDescriptionDetail(1: JobDescriptionIDRequest request) (
+        api.get="/api/interview/jd/details/:id",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 21. 删除岗位描述（已开始的面试不受影响）
+    BaseResponse DeleteJobDescription(1: JobDescriptionIDRequest request) (
+        api.delete="/api/interview/jd/delete/:id",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 22. 重新解析岗位描述（解析中的岗位描述需等待完成或超时后重试）
+    JobDescriptionResponse ReparseJobDescription(1: JobDescriptionIDRequest request) (
+        api.post="/api/interview/jd/:id/reparse",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 23. 简历与岗位匹配分析（已覆盖技能、缺失技能、关键词差距和匹配度）
+    JobMatchResponse GetJobMatchReport(1: JobMatchRequest request) (
+        api.get="/api/interview/jd/:id/match",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 24. 获取面试计划列表（含内置计划）
+    InterviewPlanListResponse GetInterviewPlanList(1: EmptyRequest request) (
+        api.get="/api/interview/plan/list",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 25. 获取面试计划详情
+    InterviewPlanResponse GetInterviewPlanDetail(1: InterviewPlanIDRequest request) (
+        api.get="/api/interview/plan/details/:id",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 26. 创建面试计划
+    InterviewPlanResponse CreateInterviewPlan(1: CreateInterviewPlanRequest request) (
+        api.post="/api/interview/plan/create",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 27. 修改面试计划（内置计划不可修改）
+    InterviewPlanResponse UpdateInterviewPlan(1: UpdateInterviewPlanRequest request) (
+        api.put="/api/interview/plan/update/:id",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 28. 删除面试计划（内置计划不可删除，已开始的面试不受影响）
+    BaseResponse DeleteInterviewPlan(1: InterviewPlanIDRequest request) (
+        api.delete="/api/interview/plan/delete/:id",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 29. 获取面试会话的环节进度
+    InterviewSessionRoundsResponse GetInterviewSessionRounds(1: InterviewSessionIDRequest request) (
+        api.get="/api/interview/session/:id/rounds",
+        api.category="interview",
+        api.gen_path="interview"
+    )
+
+    // 30. 获取可组成面试官小组的面试官角色（开始面试时通过 panel_personas 选择）
+    InterviewPersonaListResponse GetInterviewPersonaList(1: EmptyRequest request) (
+        api.get="/api/interview/panel/personas",
         api.category="interview",
         api.gen_path="interview"
     )
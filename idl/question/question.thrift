@@ -0,0 +1,205 @@
+namespace go question
+
+// ==================== 1. 题目 ====================
+
+// 题目信息
+struct QuestionInfo {
+    1: required i64 id
+    2: required i64 creator_id                 // 创建人用户ID，只有创建人可以修改和删除
+    3: required string title                   // 题目
+    4: required string content                 // 题目详细描述（Markdown）
+    5: required string type                    // 题型（concept/coding/system_design/behavioral）
+    6: required string difficulty              // 难度（初级/中级/高级），为空表示未分级
+    7: required list<string> tags              // 标签
+    8: required string reference_answer        // 参考答案（Markdown）
+    9: required string source                  // 来源
+    10: required i64 created_at                // 创建时间（毫秒时间戳）
+    11: required i64 updated_at                // 更新时间（毫秒时间戳）
+}
+
+// 创建题目请求
+struct CreateQuestionRequest {
+    1: required string title (api.body="title")
+    2: optional string content (api.body="content")
+    3: required string type (api.body="type")
+    4: optional string difficulty (api.body="difficulty")
+    5: optional list<string> tags (api.body="tags")
+    6: optional string reference_answer (api.body="reference_answer")
+    7: optional string source (api.body="source")
+}
+
+// 题目响应
+struct QuestionResponse {
+    1: required QuestionInfo data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 题目ID请求
+struct QuestionIDRequest {
+    1: required i64 id (api.path="id")
+}
+
+// 更新题目请求，未传的字段不更新
+struct UpdateQuestionRequest {
+    1: required i64 id (api.path="id")
+    2: optional string title (api.body="title")
+    3: optional string content (api.body="content")
+    4: optional string type (api.body="type")
+    5: optional string difficulty (api.body="difficulty")
+    6: optional list<string> tags (api.body="tags")     // 传空数组时清空标签
+    7: optional string reference_answer (api.body="reference_answer")
+    8: optional string source (api.body="source")
+}
+
+// 题目列表请求
+struct ListQuestionsRequest {
+    1: optional string tags (api.query="tags")              // 标签，多个用逗号分隔，匹配任一标签
+    2: optional string type (api.query="type")              // 题型
+    3: optional string difficulty (api.query="difficulty")  // 难度
+    4: optional string keyword (api.query="keyword")        // 匹配题目
+    5: optional bool mine (api.query="mine")                // 只看自己创建的题目
+    6: optional i32 page (api.query="page", api.vd="$>=1")  // 页码，默认 1
+    7: optional i32 size (api.query="size", api.vd="$>=1&&$<=100")  // 每页数量，默认 20
+}
+
+// 题目列表响应
+struct ListQuestionsResponse {
+    1: required list<QuestionInfo> list
+    2: required i64 total
+    3: required i32 page
+    4: required i32 size
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 删除题目响应
+struct DeleteQuestionResponse {
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 2. 标签 ====================
+
+// 标签及题目数
+struct TagInfo {
+    1: required string tag
+    2: required i64 count
+}
+
+struct ListQuestionTagsRequest {
+}
+
+// 标签列表响应，按题目数倒序
+struct ListQuestionTagsResponse {
+    1: required list<TagInfo> list
+
+    253: required i32 code
+    254: required string msg
+}
+
+// ==================== 3. 导入导出 ====================
+
+// 导入题目请求，题目文件通过 multipart 的 file 字段上传
+struct ImportQuestionsRequest {
+    1: optional string format (api.form="format")   // 文件格式（json/csv/markdown），默认按文件扩展名判断
+}
+
+// 导入失败或跳过的题目
+struct ImportItemError {
+    1: required i32 index          // 在文件中的序号，从 1 开始
+    2: required string title
+    3: required string msg
+}
+
+// 导入结果
+struct ImportQuestionsResult {
+    1: required i32 total                      // 文件中的题目数
+    2: required i32 created                    // 导入成功数
+    3: required list<ImportItemError> skipped  // 已存在相同题目而跳过
+    4: required list<ImportItemError> failed   // 校验失败
+}
+
+// 导入题目响应
+struct ImportQuestionsResponse {
+    1: required ImportQuestionsResult data
+
+    253: required i32 code
+    254: required string msg
+}
+
+// 导出题目请求，筛选条件同题目列表
+struct ExportQuestionsRequest {
+    1: required string format (api.query="format")          // 文件格式（json/csv/markdown）
+    2: optional string tags (api.query="tags")
+    3: optional string type (api.query="type")
+    4: optional string difficulty (api.query="difficulty")
+    5: optional string keyword (api.query="keyword")
+    6: optional bool mine (api.query="mine")
+}
+
+// 导出失败时的响应，成功时直接返回文件内容
+struct ExportQuestionsResponse {
+    253: required i32 code
+    254: required string msg
+}
+
+service QuestionService {
+    // 1. 创建题目
+    QuestionResponse CreateQuestion(1: CreateQuestionRequest request) (
+        api.post="/api/question/create",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 2. 获取题目列表
+    ListQuestionsResponse ListQuestions(1: ListQuestionsRequest request) (
+        api.get="/api/question/list",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 3. 获取题目详情
+    QuestionResponse GetQuestion(1: QuestionIDRequest request) (
+        api.get="/api/question/details/:id",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 4. 更新题目
+    QuestionResponse UpdateQuestion(1: UpdateQuestionRequest request) (
+        api.put="/api/question/update/:id",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 5. 删除题目
+    DeleteQuestionResponse DeleteQuestion(1: QuestionIDRequest request) (
+        api.delete="/api/question/delete/:id",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 6. 获取标签列表
+    ListQuestionTagsResponse ListQuestionTags(1: ListQuestionTagsRequest request) (
+        api.get="/api/question/tags",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 7. 批量导入题目
+    ImportQuestionsResponse ImportQuestions(1: ImportQuestionsRequest request) (
+        api.post="/api/question/import",
+        api.category="question",
+        api.gen_path="question"
+    )
+
+    // 8. 导出题目
+    ExportQuestionsResponse ExportQuestions(1: ExportQuestionsRequest request) (
+        api.get="/api/question/export",
+        api.category="question",
+        api.gen_path="question"
+    )
+}
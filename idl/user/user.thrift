@@ -81,6 +81,9 @@ struct UserModelDetail {
 // 获取用户模型详情响应
 struct GetUserModelResponse {
     1: required UserModelDetail data
+
+    253: required i32            code
+    254: required string         msg
 }
 
 // ==================== 4. 更新用户模型 ====================
@@ -105,6 +108,10 @@ struct UpdateUserModelRequest {
 
 // 更新用户模型响应
 struct UpdateUserModelResponse {
+    1: required UserModelDetail data
+
+    253: required i32            code
+    254: required string         msg
 }
 
 // ==================== 5. 删除用户模型 ====================
@@ -203,6 +210,89 @@ struct ResetPasswordRequest {
 // ==================== 7. 检查用户是否配置了模型 ====================
 struct CheckUserModelConfiguredResponse {
     1: required bool configured  // 是否已配置并启用默认模型（is_default = 1）
+
+    253: required i32            code
+    254: required string         msg
+}
+
+// ==================== 8. 测试用户模型连通性 ====================
+
+// 测试未保存的模型参数请求
+struct TestUserModelParamsRequest {
+    1: required string model_key (api.form="model_key")
+    2: required string protocol (api.form="protocol")
+    3: required string base_url (api.form="base_url")
+    4: required string api_key (api.form="api_key")
+    5: optional string default_params (api.form="default_params")  // JSON string
+    6: optional string config_json (api.form="config_json")        // JSON string
+}
+
+// 连通性测试结果
+struct TestUserModelResult {
+    1: required bool success              // 基础对话是否成功
+    2: required i64 latency_ms            // 基础对话耗时（毫秒）
+    3: required bool support_tool_call    // 是否支持工具调用
+    4: required bool support_json_format  // 是否支持 JSON 格式输出
+    5: optional string error_type         // 错误分类（auth/network/model_not_found/quota/unknown）
+    6: optional string error_msg          // 原始错误信息
+}
+
+// 连通性测试响应
+struct TestUserModelResponse {
+    1: required TestUserModelResult data
+
+    253: required i32            code
+    254: required string         msg
+}
+
+// ==================== 9. 模型用量 ====================
+
+// 用量查询请求
+struct GetUsageRequest {
+    1: optional i32 days (api.query="days")  // 最近几天（含今天），默认 7，最多 90
+}
+
+// 某功能、模型的用量
+struct UsageItem {
+    1: required string feature            // 功能（resume_parse/interview/evaluation/jd_parse）
+    2: required string model              // 模型（协议/模型）
+    3: required bool shared_key           // 是否使用平台共享密钥
+    4: required i64 requests              // 请求次数
+    5: required i64 prompt_tokens         // 输入 token 数
+    6: required i64 completion_tokens     // 输出 token 数
+    7: required i64 total_tokens          // 总 token 数
+}
+
+// 每日用量
+struct DailyUsage {
+    1: required string date               // 日期（YYYY-MM-DD）
+    2: required i64 requests              // 请求次数
+    3: required i64 prompt_tokens         // 输入 token 数
+    4: required i64 completion_tokens     // 输出 token 数
+    5: required i64 total_tokens          // 总 token 数
+    6: required i64 shared_key_tokens     // 使用平台共享密钥的 token 数，计入配额
+    7: required list<UsageItem> items     // 按功能、模型的明细
+}
+
+// 某功能的每日配额
+struct FeatureQuota {
+    1: required string feature            // 功能（resume_parse/interview/evaluation/jd_parse）
+    2: required i64 daily_tokens          // 每天可用的 token 数
+}
+
+// 每日配额
+struct UsageQuota {
+    1: required i64 daily_tokens              // 每天可用的 token 数，0 为不限制
+    2: required list<FeatureQuota> features   // 单独限制的功能
+}
+
+// 用量查询响应
+struct GetUsageResponse {
+    1: required list<DailyUsage> data     // 按日期倒序
+    2: required UsageQuota quota          // 平台共享密钥的每日配额
+
+    253: required i32            code
+    254: required string         msg
 }
 
 // 服务定义
@@ -310,4 +400,25 @@ service UserService {
            api.category="user",
            api.gen_path="user"
        )
+
+       // 16. 测试已保存的用户模型连通性
+       TestUserModelResponse TestUserModel(1: IDRequest request) (
+           api.post="/api/user/model/:id/test",
+           api.category="user",
+           api.gen_path="user"
+       )
+
+       // 17. 测试未保存的模型参数连通性
+       TestUserModelResponse TestUserModelParams(1: TestUserModelParamsRequest request) (
+           api.post="/api/user/model/test",
+           api.category="user",
+           api.gen_path="user"
+       )
+
+       // 18. 获取每日模型用量
+       GetUsageResponse GetUsage(1: GetUsageRequest request) (
+           api.get="/api/user/usage",
+           api.category="user",
+           api.gen_path="user"
+       )
 }
@@ -37,6 +37,7 @@ type Cmdable interface {
 
 type StringCmdable interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) StatusCmd
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) BoolCmd
 	Get(ctx context.Context, key string) StringCmd
 	IncrBy(ctx context.Context, key string, value int64) IntCmd
 	Incr(ctx context.Context, key string) IntCmd
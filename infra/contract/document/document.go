@@ -0,0 +1,15 @@
+package document
+
+import (
+	"context"
+)
+
+// Extractor 从文档原始内容中提取纯文本，尽量保留段落、标题和表格结构
+type Extractor interface {
+	Extract(ctx context.Context, data []byte) (string, error)
+}
+
+type Factory interface {
+	GetExtractor(fileType FileType) (Extractor, error)
+	SupportFileType(fileType FileType) bool
+}
@@ -0,0 +1,88 @@
+package document
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+)
+
+type FileType string
+
+const (
+	FileTypeUnknown  FileType = ""
+	FileTypePDF      FileType = "pdf"
+	FileTypeDOCX     FileType = "docx"
+	FileTypeMarkdown FileType = "md"
+	FileTypeText     FileType = "txt"
+	FileTypeHTML     FileType = "html"
+)
+
+var (
+	pdfMagic = []byte("%PDF-")
+	zipMagic = []byte("PK\x03\x04")
+)
+
+// mimeFileTypes 常见 MIME 类型与文件类型的对应关系
+var mimeFileTypes = map[string]FileType{
+	"application/pdf": FileTypePDF,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": FileTypeDOCX,
+	"text/markdown":   FileTypeMarkdown,
+	"text/x-markdown": FileTypeMarkdown,
+	"text/plain":      FileTypeText,
+	"text/html":       FileTypeHTML,
+}
+
+// fileTypeAliases 扩展名别名
+var fileTypeAliases = map[string]FileType{
+	"markdown": FileTypeMarkdown,
+	"text":     FileTypeText,
+	"htm":      FileTypeHTML,
+}
+
+// ParseFileType 将上传时声明的文件类型（扩展名、带点的扩展名或 MIME 类型）转换为 FileType
+func ParseFileType(filetype string) FileType {
+	filetype = strings.ToLower(strings.TrimSpace(filetype))
+	// 忽略 MIME 类型中的参数，如 text/html; charset=utf-8
+	if idx := strings.Index(filetype, ";"); idx != -1 {
+		filetype = strings.TrimSpace(filetype[:idx])
+	}
+
+	if fileType, ok := mimeFileTypes[filetype]; ok {
+		return fileType
+	}
+
+	filetype = strings.TrimPrefix(filetype, ".")
+	if fileType, ok := fileTypeAliases[filetype]; ok {
+		return fileType
+	}
+
+	return FileType(filetype)
+}
+
+// DetectFileType 优先根据文件头识别文件类型，无法识别时使用声明的文件类型
+func DetectFileType(filetype string, data []byte) FileType {
+	switch {
+	case bytes.HasPrefix(data, pdfMagic):
+		return FileTypePDF
+	case bytes.HasPrefix(data, zipMagic) && isDOCX(data):
+		return FileTypeDOCX
+	}
+
+	return ParseFileType(filetype)
+}
+
+// isDOCX DOCX 是包含 word/document.xml 的 zip 包
+func isDOCX(data []byte) bool {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+
+	for _, file := range reader.File {
+		if file.Name == "word/document.xml" {
+			return true
+		}
+	}
+
+	return false
+}
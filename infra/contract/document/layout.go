@@ -0,0 +1,117 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LayoutExtractor 能输出版面结构的提取器（如 PDF），调用方可按页查看解析结果
+type LayoutExtractor interface {
+	Extractor
+	ExtractDocument(ctx context.Context, data []byte) (*Document, error)
+}
+
+// Rect 矩形区域，单位为 pt，原点在页面左下角
+type Rect struct {
+	X0 float64 `json:"x0"`
+	Y0 float64 `json:"y0"`
+	X1 float64 `json:"x1"`
+	Y1 float64 `json:"y1"`
+}
+
+// Union 返回同时包含两个区域的最小矩形
+func (r Rect) Union(o Rect) Rect {
+	return Rect{
+		X0: min(r.X0, o.X0),
+		Y0: min(r.Y0, o.Y0),
+		X1: max(r.X1, o.X1),
+		Y1: max(r.Y1, o.Y1),
+	}
+}
+
+// Line 一行文本
+type Line struct {
+	Text     string  `json:"text"`
+	BBox     Rect    `json:"bbox"`
+	FontSize float64 `json:"font_size"`
+}
+
+// Block 同一栏内连续的若干行，如一个段落或一个小节
+type Block struct {
+	Column int     `json:"column"` // 所在栏，从 0 开始；跨栏内容（如页眉、姓名）为 -1
+	BBox   Rect    `json:"bbox"`
+	Lines  []*Line `json:"lines"`
+}
+
+// Text 以换行连接块内各行
+func (b *Block) Text() string {
+	lines := make([]string, 0, len(b.Lines))
+	for _, line := range b.Lines {
+		lines = append(lines, line.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Page 单页解析结果，Blocks 已按阅读顺序排列
+type Page struct {
+	Number  int      `json:"number"` // 页码，从 1 开始
+	Width   float64  `json:"width"`
+	Height  float64  `json:"height"`
+	Columns int      `json:"columns"` // 检测到的栏数
+	Scanned bool     `json:"scanned"` // 扫描页（只有图片没有文字），文本来自 OCR
+	Blocks  []*Block `json:"blocks"`
+	Err     string   `json:"err,omitempty"` // 非空表示该页解析失败
+}
+
+// Failed 该页是否解析失败
+func (p *Page) Failed() bool {
+	return p.Err != ""
+}
+
+// Document 结构化文档：页 → 块 → 行
+type Document struct {
+	Pages []*Page `json:"pages"`
+}
+
+// FailedPages 返回解析失败的页码
+func (d *Document) FailedPages() []int {
+	var pages []int
+	for _, page := range d.Pages {
+		if page.Failed() {
+			pages = append(pages, page.Number)
+		}
+	}
+	return pages
+}
+
+// HasText 是否至少有一页提取到了文本
+func (d *Document) HasText() bool {
+	for _, page := range d.Pages {
+		if len(page.Blocks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Text 按阅读顺序输出全文，每页以页码分隔，块之间空一行；失败的页面保留页码并注明原因
+func (d *Document) Text() string {
+	var sb strings.Builder
+	for _, page := range d.Pages {
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		if page.Failed() {
+			sb.WriteString(fmt.Sprintf("=== 第 %d 页（解析失败：%s）===", page.Number, page.Err))
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("=== 第 %d 页 ===", page.Number))
+		for _, block := range page.Blocks {
+			sb.WriteString("\n\n")
+			sb.WriteString(block.Text())
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,26 @@
+package embedding
+
+import "time"
+
+type Config struct {
+	BaseURL string        `json:"base_url,omitempty" yaml:"base_url"`
+	APIKey  string        `json:"api_key,omitempty" yaml:"api_key"`
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout"`
+
+	Model      string `json:"model" yaml:"model"`
+	Dimensions *int   `json:"dimensions,omitempty" yaml:"dimensions,omitempty"` // 输出向量维度，仅部分模型支持
+
+	OpenAI *OpenAIConfig `json:"open_ai,omitempty" yaml:"openai"`
+	Ark    *ArkConfig    `json:"ark,omitempty" yaml:"ark"`
+}
+
+type OpenAIConfig struct {
+	ByAzure    bool   `json:"by_azure,omitempty" yaml:"by_azure"`
+	APIVersion string `json:"api_version,omitempty" yaml:"api_version"`
+}
+
+type ArkConfig struct {
+	Region    string `json:"region" yaml:"region"`
+	AccessKey string `json:"access_key,omitempty" yaml:"access_key"`
+	SecretKey string `json:"secret_key,omitempty" yaml:"secret_key"`
+}
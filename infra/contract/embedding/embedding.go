@@ -0,0 +1,16 @@
+package embedding
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+//go:generate  mockgen -destination ../../../internal/mock/infra/contract/embedding/embedder_mock.go -package mock -source ${GOPATH}/src/github.com/cloudwego/eino/components/embedding/interface.go Embedder
+type Embedder = embedding.Embedder
+
+//go:generate  mockgen -destination ../../../internal/mock/infra/contract/embedding/embedding_factory_mock.go -package mock -source embedding.go Factory
+type Factory interface {
+	CreateEmbedder(ctx context.Context, protocol Protocol, config *Config) (Embedder, error)
+	SupportProtocol(protocol Protocol) bool
+}
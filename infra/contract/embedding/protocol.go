@@ -0,0 +1,10 @@
+package embedding
+
+type Protocol string
+
+const (
+	ProtocolOpenAI Protocol = "openai"
+	ProtocolArk    Protocol = "ark"
+	ProtocolOllama Protocol = "ollama"
+	ProtocolQwen   Protocol = "qwen" // 通义千问的 OpenAI 兼容接口
+)
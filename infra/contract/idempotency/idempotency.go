@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// State 幂等键的处理状态
+type State int
+
+const (
+	StateAcquired   State = iota // 首次处理，调用方获得处理权
+	StateProcessing              // 其他消费者正在处理，处理权在租期过后失效
+	StateDone                    // 已处理完成
+)
+
+// Store 记录消息的处理状态，重复投递的消息据此跳过
+type Store interface {
+	// Acquire 尝试获得 key 的处理权，租期内未 Complete 或 Release 的处理权自动失效
+	Acquire(ctx context.Context, key string, lease time.Duration) (State, error)
+	// Complete 标记处理完成，ttl 内同一 key 的 Acquire 返回 StateDone
+	Complete(ctx context.Context, key string, ttl time.Duration) error
+	// Release 放弃处理权，之后的重试可以重新获得
+	Release(ctx context.Context, key string) error
+}
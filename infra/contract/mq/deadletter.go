@@ -0,0 +1,45 @@
+package contract
+
+import "errors"
+
+// PermanentError 重试也无法成功的错误（如消息格式错误），消费端不再重试，直接投递到死信主题
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent 将消息处理错误标记为不可重试
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+// IsPermanent 是否为不可重试的错误
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
+
+// DeadLetter 死信消息，以 JSON 写入死信主题：保留原始消息及失败信息，便于排查和重新投递
+type DeadLetter struct {
+	Topic     string `json:"topic"`     // 原始主题
+	Partition int32  `json:"partition"` // 原始分区
+	Offset    int64  `json:"offset"`    // 原始偏移量
+	Key       []byte `json:"key"`       // 原始消息 key
+	Value     []byte `json:"value"`     // 原始消息内容
+
+	GroupID   string `json:"group_id"`  // 处理失败的消费组
+	Error     string `json:"error"`     // 最后一次处理的错误
+	Attempts  int    `json:"attempts"`  // 已处理次数
+	Permanent bool   `json:"permanent"` // 是否因不可重试的错误（如消息格式错误）直接投递
+	FailedAt  int64  `json:"failed_at"` // 投递到死信主题的时间（毫秒）
+}
@@ -19,3 +19,15 @@ type KafkaMessage struct {
 	Key       []byte
 	Value     []byte
 }
+
+// PartitionLag 消费组在一个分区上的积压：末尾偏移量与已提交偏移量之差
+type PartitionLag struct {
+	Topic     string
+	Partition int32
+	Committed int64 // 已提交的偏移量，即下一条待消费消息的偏移量
+	End       int64 // 分区末尾，即下一条写入消息的偏移量
+}
+
+func (l *PartitionLag) Lag() int64 {
+	return max(l.End-l.Committed, 0)
+}
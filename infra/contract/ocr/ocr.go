@@ -0,0 +1,11 @@
+package ocr
+
+import (
+	"context"
+)
+
+// OCR 识别图片中的文字，用于扫描件简历
+type OCR interface {
+	// Recognize 识别一张图片（PNG、JPEG 等），返回按行排列的文本
+	Recognize(ctx context.Context, image []byte) (string, error)
+}
@@ -0,0 +1,26 @@
+package vectorstore
+
+import "context"
+
+// Vector 一条已向量化的记录
+type Vector struct {
+	ID     string
+	Vector []float64
+	Digest string // 向量化时使用的文本和模型的摘要，变化时需要重新向量化
+}
+
+// Hit 检索结果，Score 为余弦相似度，越大越相似
+type Hit struct {
+	ID    string
+	Score float64
+}
+
+// Store 向量存储，按余弦相似度检索
+type Store interface {
+	Upsert(ctx context.Context, vectors ...*Vector) error
+	Delete(ctx context.Context, ids ...string) error
+	// Search 返回与 query 最相似的 topK 条记录，按相似度倒序
+	Search(ctx context.Context, query []float64, topK int) ([]*Hit, error)
+	// Digests 所有记录的摘要，key 为记录ID，用于与数据源对账
+	Digests(ctx context.Context) (map[string]string, error)
+}
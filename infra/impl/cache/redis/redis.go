@@ -123,6 +123,11 @@ func (r *redisImpl) Set(ctx context.Context, key string, value interface{}, expi
 	return r.client.Set(ctx, key, value, expiration)
 }
 
+// SetNX implements cache.Cmdable.
+func (r *redisImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return r.client.SetNX(ctx, key, value, expiration)
+}
+
 type pipelineImpl struct {
 	p redis.Pipeliner
 }
@@ -231,3 +236,8 @@ func (p *pipelineImpl) RPush(ctx context.Context, key string, values ...interfac
 func (p *pipelineImpl) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.StatusCmd {
 	return p.p.Set(ctx, key, value, expiration)
 }
+
+// SetNX implements cache.Pipeliner.
+func (p *pipelineImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return p.p.SetNX(ctx, key, value, expiration)
+}
@@ -57,7 +57,7 @@ func (f *defaultFactory) CreateChatModel(ctx context.Context, protocol chatmodel
 	}
 
 	builder, found := f.protocol2Builder[protocol]
-	if !found {
+	if !found || builder == nil {
 		return nil, fmt.Errorf("[CreateChatModel] protocol not support, protocol=%s", protocol)
 	}
 
@@ -0,0 +1,48 @@
+package document
+
+import (
+	"fmt"
+	"mianshiba/infra/contract/document"
+)
+
+var (
+	DocumentDefaultFactory = NewDefaultFactory()
+)
+
+func NewDefaultFactory() document.Factory {
+	return NewFactory(nil)
+}
+
+func NewFactory(customExtractors map[document.FileType]document.Extractor) document.Factory {
+	fileType2Extractor := map[document.FileType]document.Extractor{
+		document.FileTypePDF:      &pdfExtractor{},
+		document.FileTypeDOCX:     &docxExtractor{},
+		document.FileTypeMarkdown: &markdownExtractor{},
+		document.FileTypeText:     &textExtractor{},
+		document.FileTypeHTML:     &htmlExtractor{},
+	}
+
+	for t := range customExtractors {
+		fileType2Extractor[t] = customExtractors[t]
+	}
+
+	return &defaultFactory{fileType2Extractor: fileType2Extractor}
+}
+
+type defaultFactory struct {
+	fileType2Extractor map[document.FileType]document.Extractor
+}
+
+func (f *defaultFactory) GetExtractor(fileType document.FileType) (document.Extractor, error) {
+	extractor, found := f.fileType2Extractor[fileType]
+	if !found || extractor == nil {
+		return nil, fmt.Errorf("[GetExtractor] file type not support, fileType=%s", fileType)
+	}
+
+	return extractor, nil
+}
+
+func (f *defaultFactory) SupportFileType(fileType document.FileType) bool {
+	extractor, found := f.fileType2Extractor[fileType]
+	return found && extractor != nil
+}
@@ -0,0 +1,247 @@
+package document
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const docxMainPart = "word/document.xml"
+
+// docxExtractor 解析 word/document.xml：段落按行输出，标题转为 Markdown 标题，
+// 列表项加 "- " 前缀，表格按行输出、单元格以 " | " 分隔
+type docxExtractor struct{}
+
+// docxTable 解析中的表格，rows 为已完成的行，row/cell 为正在解析的行和单元格
+type docxTable struct {
+	rows [][]string
+	row  []string
+	cell []string
+}
+
+// docxParagraph 解析中的段落；文本框中的段落会嵌套在外层段落内
+type docxParagraph struct {
+	text     strings.Builder
+	style    string
+	listItem bool
+}
+
+func (e *docxExtractor) Extract(ctx context.Context, data []byte) (string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("open docx failed: %w", err)
+	}
+
+	var part *zip.File
+	for _, file := range reader.File {
+		if file.Name == docxMainPart {
+			part = file
+			break
+		}
+	}
+	if part == nil {
+		return "", fmt.Errorf("docx missing %s", docxMainPart)
+	}
+
+	rc, err := part.Open()
+	if err != nil {
+		return "", fmt.Errorf("open %s failed: %w", docxMainPart, err)
+	}
+	defer rc.Close()
+
+	return parseDocxXML(rc)
+}
+
+func parseDocxXML(r io.Reader) (string, error) {
+	var (
+		blocks        []string
+		tables        []*docxTable
+		paragraphs    []*docxParagraph
+		inText        bool
+		fallbackDepth int
+	)
+
+	// emit 将完成的段落或表格写入当前所在的单元格，不在表格中时作为独立的块输出
+	emit := func(text string) {
+		if len(tables) > 0 {
+			table := tables[len(tables)-1]
+			table.cell = append(table.cell, text)
+			return
+		}
+		blocks = append(blocks, text)
+	}
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("parse docx xml failed: %w", err)
+		}
+
+		// mc:Fallback 是兼容旧版本的重复内容，跳过以免文本框内容重复
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "Fallback" || fallbackDepth > 0 {
+				fallbackDepth++
+				continue
+			}
+		case xml.EndElement:
+			if fallbackDepth > 0 {
+				fallbackDepth--
+				continue
+			}
+		default:
+			if fallbackDepth > 0 {
+				continue
+			}
+		}
+
+		var paragraph *docxParagraph
+		if len(paragraphs) > 0 {
+			paragraph = paragraphs[len(paragraphs)-1]
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "p":
+				paragraphs = append(paragraphs, &docxParagraph{})
+			case "pStyle":
+				if paragraph != nil {
+					paragraph.style = xmlAttr(t, "val")
+				}
+			case "numPr":
+				if paragraph != nil {
+					paragraph.listItem = true
+				}
+			case "t":
+				inText = true
+			case "tab":
+				if paragraph != nil {
+					paragraph.text.WriteString("\t")
+				}
+			case "br", "cr":
+				if paragraph != nil {
+					paragraph.text.WriteString("\n")
+				}
+			case "tbl":
+				tables = append(tables, &docxTable{})
+			case "tr":
+				if len(tables) > 0 {
+					tables[len(tables)-1].row = nil
+				}
+			case "tc":
+				if len(tables) > 0 {
+					tables[len(tables)-1].cell = nil
+				}
+			}
+		case xml.CharData:
+			if inText && paragraph != nil {
+				paragraph.text.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if paragraph == nil {
+					continue
+				}
+				paragraphs = paragraphs[:len(paragraphs)-1]
+				if text := strings.TrimSpace(paragraph.text.String()); text != "" {
+					emit(formatDocxParagraph(text, paragraph.style, paragraph.listItem))
+				}
+			case "tc":
+				if len(tables) > 0 {
+					table := tables[len(tables)-1]
+					table.row = append(table.row, strings.Join(table.cell, " "))
+				}
+			case "tr":
+				if len(tables) > 0 {
+					table := tables[len(tables)-1]
+					table.rows = append(table.rows, table.row)
+				}
+			case "tbl":
+				if len(tables) == 0 {
+					continue
+				}
+				table := tables[len(tables)-1]
+				tables = tables[:len(tables)-1]
+				if text := renderDocxTable(table.rows); text != "" {
+					emit(text)
+				}
+			}
+		}
+	}
+
+	return strings.Join(blocks, "\n"), nil
+}
+
+// formatDocxParagraph 根据段落样式输出标题或列表项
+func formatDocxParagraph(text, style string, listItem bool) string {
+	if level := docxHeadingLevel(style); level > 0 {
+		return strings.Repeat("#", level) + " " + text
+	}
+
+	if listItem {
+		return "- " + text
+	}
+
+	return text
+}
+
+// docxHeadingLevel 识别 Title、Heading1~Heading9 样式，非标题返回 0
+func docxHeadingLevel(style string) int {
+	style = strings.ToLower(strings.ReplaceAll(style, " ", ""))
+	if style == "title" {
+		return 1
+	}
+
+	if !strings.HasPrefix(style, "heading") {
+		return 0
+	}
+
+	level, err := strconv.Atoi(strings.TrimPrefix(style, "heading"))
+	if err != nil || level < 1 || level > 9 {
+		return 0
+	}
+
+	return level
+}
+
+func renderDocxTable(rows [][]string) string {
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		empty := true
+		for _, cell := range row {
+			if cell != "" {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			continue
+		}
+		lines = append(lines, strings.Join(row, " | "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func xmlAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
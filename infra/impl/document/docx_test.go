@@ -0,0 +1,94 @@
+package document
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"mianshiba/infra/contract/document"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const docxBody = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+    xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006">
+  <w:body>
+    <w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r><w:t>张三</w:t></w:r></w:p>
+    <w:p><w:r><w:t xml:space="preserve">后端工程师 </w:t></w:r><w:r><w:t>5年经验</w:t></w:r></w:p>
+    <w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr><w:r><w:t>工作经历</w:t></w:r></w:p>
+    <w:tbl>
+      <w:tr>
+        <w:tc><w:p><w:r><w:t>公司</w:t></w:r></w:p></w:tc>
+        <w:tc><w:p><w:r><w:t>职位</w:t></w:r></w:p></w:tc>
+      </w:tr>
+      <w:tr>
+        <w:tc><w:p><w:r><w:t>某科技</w:t></w:r></w:p></w:tc>
+        <w:tc><w:p><w:r><w:t>高级工程师</w:t></w:r></w:p><w:p><w:r><w:t>负责支付系统</w:t></w:r></w:p></w:tc>
+      </w:tr>
+    </w:tbl>
+    <w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr><w:r><w:t>技能</w:t></w:r></w:p>
+    <w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t>Go</w:t></w:r></w:p>
+    <w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t>MySQL</w:t></w:r></w:p>
+    <w:p><w:r><w:t>联系方式：</w:t></w:r><w:r><mc:AlternateContent>
+      <mc:Choice><w:drawing><w:txbxContent><w:p><w:r><w:t>zhangsan@example.com</w:t></w:r></w:p></w:txbxContent></w:drawing></mc:Choice>
+      <mc:Fallback><w:pict><w:txbxContent><w:p><w:r><w:t>zhangsan@example.com</w:t></w:r></w:p></w:txbxContent></w:pict></mc:Fallback>
+    </mc:AlternateContent></w:r></w:p>
+  </w:body>
+</w:document>`
+
+func buildDocx(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestDocxExtractor(t *testing.T) {
+	data := buildDocx(t, map[string]string{
+		"[Content_Types].xml": `<Types/>`,
+		"word/document.xml":   docxBody,
+	})
+
+	assert.Equal(t, document.FileTypeDOCX, document.DetectFileType("application/octet-stream", data))
+
+	extractor, err := DocumentDefaultFactory.GetExtractor(document.FileTypeDOCX)
+	assert.NoError(t, err)
+
+	text, err := extractor.Extract(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, `# 张三
+后端工程师 5年经验
+## 工作经历
+公司 | 职位
+某科技 | 高级工程师 负责支付系统
+## 技能
+- Go
+- MySQL
+zhangsan@example.com
+联系方式：`, text)
+}
+
+func TestDocxExtractorInvalid(t *testing.T) {
+	extractor := &docxExtractor{}
+
+	_, err := extractor.Extract(context.Background(), []byte("not a zip"))
+	assert.Error(t, err)
+
+	_, err = extractor.Extract(context.Background(), buildDocx(t, map[string]string{"xl/workbook.xml": "<workbook/>"}))
+	assert.Error(t, err)
+}
+
+func TestDetectFileType(t *testing.T) {
+	assert.Equal(t, document.FileTypePDF, document.DetectFileType("docx", []byte("%PDF-1.7\n")))
+	assert.Equal(t, document.FileTypeDOCX, document.DetectFileType(".DOCX", []byte("plain")))
+	assert.Equal(t, document.FileTypePDF, document.DetectFileType("application/pdf", nil))
+	assert.Equal(t, document.FileTypeUnknown, document.DetectFileType("", nil))
+}
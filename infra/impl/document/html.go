@@ -0,0 +1,162 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// htmlSkipped 不输出内容的元素
+var htmlSkipped = map[atom.Atom]bool{
+	atom.Head:     true,
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+}
+
+// htmlBlocks 块级元素，前后换行
+var htmlBlocks = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true,
+	atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Fieldset: true,
+	atom.Figcaption: true, atom.Figure: true, atom.Footer: true, atom.Form: true,
+	atom.Header: true, atom.Hr: true, atom.Main: true, atom.Nav: true, atom.Ol: true,
+	atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true, atom.Ul: true,
+	atom.Tr: true, atom.Caption: true,
+}
+
+var htmlHeadings = map[atom.Atom]int{
+	atom.H1: 1, atom.H2: 2, atom.H3: 3, atom.H4: 4, atom.H5: 5, atom.H6: 6,
+}
+
+// htmlExtractor 将 HTML 转为纯文本：标题转为 Markdown 标题，列表项加 "- " 前缀，
+// 表格按行输出、单元格以 " | " 分隔，忽略脚本和样式
+type htmlExtractor struct{}
+
+func (e *htmlExtractor) Extract(ctx context.Context, data []byte) (string, error) {
+	text, err := decodeText(data)
+	if err != nil {
+		return "", err
+	}
+
+	doc, err := html.Parse(strings.NewReader(text))
+	if err != nil {
+		return "", fmt.Errorf("parse html failed: %w", err)
+	}
+
+	var buf bytes.Buffer
+	renderHTMLNode(&buf, doc, false)
+
+	return normalizeText(buf.String()), nil
+}
+
+func renderHTMLNode(buf *bytes.Buffer, n *html.Node, pre bool) {
+	switch n.Type {
+	case html.TextNode:
+		if pre {
+			buf.WriteString(n.Data)
+		} else {
+			writeHTMLText(buf, n.Data)
+		}
+		return
+	case html.CommentNode, html.DoctypeNode:
+		return
+	case html.ElementNode:
+		if htmlSkipped[n.DataAtom] {
+			return
+		}
+	}
+
+	switch {
+	case n.DataAtom == atom.Br:
+		buf.WriteString("\n")
+		return
+	case htmlHeadings[n.DataAtom] > 0:
+		ensureNewline(buf)
+		buf.WriteString(strings.Repeat("#", htmlHeadings[n.DataAtom]) + " ")
+	case n.DataAtom == atom.Li:
+		ensureNewline(buf)
+		buf.WriteString("- ")
+	case n.DataAtom == atom.Td || n.DataAtom == atom.Th:
+		if hasPrevCell(n) {
+			buf.WriteString(" | ")
+		}
+	case htmlBlocks[n.DataAtom]:
+		ensureNewline(buf)
+	}
+
+	start := buf.Len()
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		renderHTMLNode(buf, c, pre || n.DataAtom == atom.Pre)
+	}
+
+	// 外部链接保留地址，如 GitHub、博客
+	if n.DataAtom == atom.A {
+		href := htmlAttr(n, "href")
+		text := strings.TrimSpace(string(buf.Bytes()[start:]))
+		if strings.HasPrefix(href, "http") && text != href {
+			buf.WriteString(" (" + href + ")")
+		}
+	}
+
+	if htmlHeadings[n.DataAtom] > 0 || n.DataAtom == atom.Li || htmlBlocks[n.DataAtom] {
+		ensureNewline(buf)
+	}
+}
+
+// writeHTMLText 折叠空白，与浏览器的渲染方式一致
+func writeHTMLText(buf *bytes.Buffer, text string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		if text != "" && !endsWithSpace(buf) {
+			buf.WriteString(" ")
+		}
+		return
+	}
+
+	if isSpace(rune(text[0])) || text[0] == '\n' {
+		if !endsWithSpace(buf) {
+			buf.WriteString(" ")
+		}
+	}
+	buf.WriteString(strings.Join(fields, " "))
+	if last := text[len(text)-1]; isSpace(rune(last)) || last == '\n' {
+		buf.WriteString(" ")
+	}
+}
+
+func endsWithSpace(buf *bytes.Buffer) bool {
+	b := buf.Bytes()
+	return len(b) == 0 || b[len(b)-1] == ' ' || b[len(b)-1] == '\n'
+}
+
+func ensureNewline(buf *bytes.Buffer) {
+	b := buf.Bytes()
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		buf.WriteString("\n")
+	}
+}
+
+func hasPrevCell(n *html.Node) bool {
+	for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+		if s.DataAtom == atom.Td || s.DataAtom == atom.Th {
+			return true
+		}
+	}
+	return false
+}
+
+func htmlAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+package document
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+var (
+	mdCommentRe    = regexp.MustCompile(`(?s)<!--.*?-->`)
+	mdATXHeadingRe = regexp.MustCompile(`^(#{1,6})[ \t]*(.*?)[ \t]*#*[ \t]*$`)
+	mdRuleRe       = regexp.MustCompile(`^[ \t]*([-*_])([ \t]*[-*_]){2,}[ \t]*$`)
+	mdSetextH1Re   = regexp.MustCompile(`^[ \t]*=+[ \t]*$`)
+	mdSetextH2Re   = regexp.MustCompile(`^[ \t]*-+[ \t]*$`)
+	mdBulletRe     = regexp.MustCompile(`^([ \t]*)[*+][ \t]+`)
+	mdQuoteRe      = regexp.MustCompile(`^[ \t]*(>[ \t]?)+`)
+	mdTableSepRe   = regexp.MustCompile(`^[ \t]*\|?[ \t]*:?-+:?[ \t]*(\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	mdImageRe      = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLinkRe       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdAutoLinkRe   = regexp.MustCompile(`<((?:https?://|mailto:)[^>\s]+)>`)
+	mdStrongRe     = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	mdEmRe         = regexp.MustCompile(`(^|[^\w*])\*(\S(?:[^*]*?\S)?)\*`)
+	mdStrikeRe     = regexp.MustCompile(`~~(.+?)~~`)
+	mdCodeRe       = regexp.MustCompile("`([^`]+)`")
+	mdInlineTagRe  = regexp.MustCompile(`(?i)</?(br|p|div|span|b|i|u|strong|em|a|img|sup|sub|font|center)(\s[^>]*)?/?>`)
+)
+
+// markdownExtractor 去除 Markdown 标记，保留 ATX 风格的标题、列表和表格结构
+type markdownExtractor struct{}
+
+func (e *markdownExtractor) Extract(ctx context.Context, data []byte) (string, error) {
+	text, err := decodeText(data)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeText(markdownToText(text)), nil
+}
+
+func markdownToText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = mdCommentRe.ReplaceAllString(text, "")
+	lines := stripFrontMatter(strings.Split(text, "\n"))
+
+	result := make([]string, 0, len(lines))
+	fence := ""
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		// 代码块内容原样保留
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+				continue
+			}
+			result = append(result, line)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+
+		// Setext 风格标题：下一行为 === 或 ---
+		if trimmed != "" && i+1 < len(lines) && !isMarkdownBlock(trimmed) {
+			next := lines[i+1]
+			if mdSetextH1Re.MatchString(next) {
+				result = append(result, "# "+markdownInline(trimmed))
+				i++
+				continue
+			}
+			if mdSetextH2Re.MatchString(next) {
+				result = append(result, "## "+markdownInline(trimmed))
+				i++
+				continue
+			}
+		}
+
+		if m := mdATXHeadingRe.FindStringSubmatch(trimmed); m != nil {
+			if m[2] != "" {
+				result = append(result, m[1]+" "+markdownInline(m[2]))
+			}
+			continue
+		}
+
+		if mdRuleRe.MatchString(line) || (strings.Contains(trimmed, "-") && mdTableSepRe.MatchString(line)) {
+			continue
+		}
+
+		line = mdQuoteRe.ReplaceAllString(line, "")
+		line = mdBulletRe.ReplaceAllString(line, "$1- ")
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			line = markdownTableRow(line)
+		}
+
+		result = append(result, markdownInline(line))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// stripFrontMatter 去掉文件开头的 YAML front matter
+func stripFrontMatter(lines []string) []string {
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return lines
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if trimmed := strings.TrimSpace(lines[i]); trimmed == "---" || trimmed == "..." {
+			return lines[i+1:]
+		}
+	}
+
+	return lines
+}
+
+// isMarkdownBlock 列表、引用、表格等行不会成为 Setext 标题
+func isMarkdownBlock(line string) bool {
+	return strings.HasPrefix(line, "#") ||
+		strings.HasPrefix(line, ">") ||
+		strings.HasPrefix(line, "|") ||
+		strings.HasPrefix(line, "- ") ||
+		mdBulletRe.MatchString(line)
+}
+
+func markdownTableRow(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+
+	return strings.Join(cells, " | ")
+}
+
+// markdownInline 去除行内标记：图片保留替代文本，链接保留文字和地址
+func markdownInline(line string) string {
+	line = mdImageRe.ReplaceAllString(line, "$1")
+	line = mdLinkRe.ReplaceAllStringFunc(line, func(s string) string {
+		m := mdLinkRe.FindStringSubmatch(s)
+		if m[1] == m[2] {
+			return m[2]
+		}
+		return m[1] + " (" + m[2] + ")"
+	})
+	line = mdAutoLinkRe.ReplaceAllString(line, "$1")
+	line = mdInlineTagRe.ReplaceAllString(line, "")
+	line = mdCodeRe.ReplaceAllString(line, "$1")
+	line = mdStrongRe.ReplaceAllString(line, "$2")
+	line = mdEmRe.ReplaceAllString(line, "$1$2")
+	line = mdStrikeRe.ReplaceAllString(line, "$1")
+
+	return line
+}
@@ -0,0 +1,181 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"mianshiba/infra/contract/document"
+	"mianshiba/infra/contract/ocr"
+	"mianshiba/pkg/pdf"
+)
+
+// NewPDFExtractor 创建 PDF 提取器，ocr 为空时扫描页记为解析失败
+func NewPDFExtractor(ocr ocr.OCR) document.LayoutExtractor {
+	return &pdfExtractor{ocr: ocr}
+}
+
+// pdfExtractor 按字符坐标还原版面：识别分栏并按阅读顺序输出，记录解析失败的页面；
+// 扫描页通过 OCR 识别；无法按版面解析时回退为逐页提取纯文本
+type pdfExtractor struct {
+	ocr ocr.OCR
+}
+
+func (e *pdfExtractor) Extract(ctx context.Context, data []byte) (string, error) {
+	doc, err := e.ExtractDocument(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	return doc.Text(), nil
+}
+
+func (e *pdfExtractor) ExtractDocument(ctx context.Context, data []byte) (*document.Document, error) {
+	doc, err := parsePDFLayout(data)
+	if err == nil {
+		e.recognizeScannedPages(ctx, data, doc)
+		if doc.HasText() {
+			return doc, nil
+		}
+	}
+
+	fallback, fallbackErr := extractPDFPages(data)
+	if fallbackErr != nil {
+		if err != nil {
+			return nil, fmt.Errorf("parse pdf failed: %w, fallback: %v", err, fallbackErr)
+		}
+		return doc, nil
+	}
+
+	if doc != nil && !fallback.HasText() {
+		return doc, nil
+	}
+	return fallback, nil
+}
+
+// recognizeScannedPages 提取扫描页中的图片交给 OCR 识别，识别结果替换该页的版面内容
+func (e *pdfExtractor) recognizeScannedPages(ctx context.Context, data []byte, doc *document.Document) {
+	var scanned []*document.Page
+	var nums []int
+	for _, page := range doc.Pages {
+		if page.Scanned && !page.Failed() {
+			scanned = append(scanned, page)
+			nums = append(nums, page.Number)
+		}
+	}
+	if len(scanned) == 0 {
+		return
+	}
+
+	fail := func(page *document.Page, reason string) {
+		page.Columns, page.Blocks = 0, nil
+		page.Err = reason
+	}
+
+	if e.ocr == nil {
+		for _, page := range scanned {
+			fail(page, "scanned page, ocr not configured")
+		}
+		return
+	}
+
+	images, err := pdf.ExtractPageImages(data, nums)
+	if err != nil {
+		for _, page := range scanned {
+			fail(page, fmt.Sprintf("extract images failed: %v", err))
+		}
+		return
+	}
+
+	for _, page := range scanned {
+		// 自上而下识别
+		pageImages := images[page.Number]
+		sort.SliceStable(pageImages, func(i, j int) bool {
+			return pageImages[i].Y+pageImages[i].Height > pageImages[j].Y+pageImages[j].Height
+		})
+
+		var blocks []*document.Block
+		var ocrErr error
+		for _, img := range pageImages {
+			text, err := e.ocr.Recognize(ctx, img.Data)
+			if err != nil {
+				ocrErr = err
+				break
+			}
+			bbox := document.Rect{X0: round1(img.X), Y0: round1(img.Y), X1: round1(img.X + img.Width), Y1: round1(img.Y + img.Height)}
+			blocks = append(blocks, ocrBlocks(text, bbox)...)
+		}
+
+		switch {
+		case ocrErr != nil:
+			fail(page, fmt.Sprintf("ocr failed: %v", ocrErr))
+		case len(blocks) == 0:
+			fail(page, "no text recognized")
+		default:
+			page.Columns, page.Blocks = 1, blocks
+		}
+	}
+}
+
+// ocrBlocks 按空行切分识别结果；OCR 没有行坐标，块的位置取图片区域
+func ocrBlocks(text string, bbox document.Rect) []*document.Block {
+	var blocks []*document.Block
+	for _, paragraph := range strings.Split(normalizeText(collapseWideSpaces(text)), "\n\n") {
+		block := &document.Block{BBox: bbox}
+		for _, line := range strings.Split(paragraph, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				block.Lines = append(block.Lines, &document.Line{Text: line})
+			}
+		}
+		if len(block.Lines) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// collapseWideSpaces 去掉 OCR 在相邻汉字之间插入的空格
+func collapseWideSpaces(text string) string {
+	runes := []rune(text)
+	var sb strings.Builder
+	for i, r := range runes {
+		if r == ' ' && i > 0 && i+1 < len(runes) && isWide(runes[i-1]) && isWide(runes[i+1]) {
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// extractPDFPages 逐页提取纯文本，每页作为一个块，不含坐标
+func extractPDFPages(data []byte) (*document.Document, error) {
+	pages, err := pdf.ExtractPages(data, "")
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &document.Document{}
+	for _, p := range pages {
+		page := &document.Page{Number: p.Number}
+		if p.Err != nil {
+			page.Err = p.Err.Error()
+			doc.Pages = append(doc.Pages, page)
+			continue
+		}
+
+		block := &document.Block{}
+		for _, line := range strings.Split(normalizeText(p.Text), "\n") {
+			if line != "" {
+				block.Lines = append(block.Lines, &document.Line{Text: line})
+			}
+		}
+		if len(block.Lines) > 0 {
+			page.Columns = 1
+			page.Blocks = []*document.Block{block}
+		}
+		doc.Pages = append(doc.Pages, page)
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,502 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"mianshiba/infra/contract/document"
+
+	layoutpdf "github.com/dslipak/pdf"
+)
+
+const (
+	defaultFontSize   = 10.0
+	lineTolerance     = 0.5  // 基线高度差小于 字号*lineTolerance 视为同一行
+	wordGapRatio      = 0.15 // 字符间距超过 字号*wordGapRatio 时补空格
+	segmentGapRatio   = 2.0  // 字符间距超过 字号*segmentGapRatio 时断开为两段，两段可能属于不同栏
+	blockGapRatio     = 1.8  // 行距超过 字号*blockGapRatio 时开始新的块
+	fontChangeRatio   = 0.2  // 相邻行字号变化超过该比例时开始新的块，如小节标题
+	minGutterWidth    = 8.0  // 栏间空白的最小宽度
+	minColumnSegments = 3    // 每栏至少包含的文本段数
+	gutterCrossRatio  = 0.1  // 允许跨越栏间空白的文本段（如姓名、页眉）占比
+	minTextGlyphs     = 5    // 可见字符少于该数量且含有图片的页面视为扫描页
+)
+
+// glyph PDF 内容流中绘制的单个字符，坐标为基线起点
+type glyph struct {
+	x, y, w, size float64
+	s             string
+}
+
+// segment 同一行内连续的一段文字，行内出现大间距时断开
+type segment struct {
+	text      string
+	x0, x1, y float64
+	size      float64
+	line      int // 所在行的序号，自上而下
+	column    int // 所在栏，跨栏为 -1
+}
+
+// parsePDFLayout 逐页解析字符坐标并还原版面；单页失败只记录在该页，不影响其他页
+func parsePDFLayout(data []byte) (doc *document.Document, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			doc, err = nil, fmt.Errorf("open pdf failed: %v", r)
+		}
+	}()
+
+	reader, err := layoutpdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open pdf failed: %w", err)
+	}
+
+	doc = &document.Document{}
+	for i := 1; i <= reader.NumPage(); i++ {
+		doc.Pages = append(doc.Pages, parsePDFPage(reader, i))
+	}
+
+	return doc, nil
+}
+
+func parsePDFPage(reader *layoutpdf.Reader, num int) (page *document.Page) {
+	page = &document.Page{Number: num}
+	defer func() {
+		// 内容流损坏时解析库会 panic
+		if r := recover(); r != nil {
+			page.Columns, page.Blocks = 0, nil
+			page.Err = fmt.Sprint(r)
+		}
+	}()
+
+	p := reader.Page(num)
+	if p.V.IsNull() {
+		page.Err = "page not found"
+		return page
+	}
+
+	if box := mediaBox(p); box.Len() == 4 {
+		page.Width = box.Index(2).Float64() - box.Index(0).Float64()
+		page.Height = box.Index(3).Float64() - box.Index(1).Float64()
+	}
+
+	texts := p.Content().Text
+	glyphs := make([]glyph, 0, len(texts))
+	for _, t := range texts {
+		size := t.FontSize
+		if size <= 0 {
+			size = defaultFontSize
+		}
+		glyphs = append(glyphs, glyph{x: t.X, y: t.Y, w: t.W, size: size, s: t.S})
+	}
+
+	page.Columns, page.Blocks = layoutGlyphs(glyphs)
+	page.Scanned = countVisible(glyphs) < minTextGlyphs && hasImages(p.Resources(), 0)
+	return page
+}
+
+func countVisible(glyphs []glyph) int {
+	n := 0
+	for _, g := range glyphs {
+		if strings.TrimSpace(g.s) != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// hasImages 页面资源中是否引用了图片，表单对象内的图片也算
+func hasImages(resources layoutpdf.Value, depth int) bool {
+	xobjects := resources.Key("XObject")
+	for _, name := range xobjects.Keys() {
+		xobj := xobjects.Key(name)
+		switch xobj.Key("Subtype").Name() {
+		case "Image":
+			return true
+		case "Form":
+			if depth < 2 && hasImages(xobj.Key("Resources"), depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mediaBox 页面尺寸可能继承自上级 Pages 节点
+func mediaBox(p layoutpdf.Page) layoutpdf.Value {
+	for v := p.V; !v.IsNull(); v = v.Key("Parent") {
+		if box := v.Key("MediaBox"); !box.IsNull() {
+			return box
+		}
+	}
+	return layoutpdf.Value{}
+}
+
+// layoutGlyphs 字符 → 行 → 段，检测栏间空白后按阅读顺序组织成块
+func layoutGlyphs(glyphs []glyph) (int, []*document.Block) {
+	segments := splitSegments(groupLines(fixZeroWidths(glyphs)))
+	if len(segments) == 0 {
+		return 0, nil
+	}
+
+	gutters := detectGutters(segments)
+	assignColumns(segments, gutters)
+
+	return len(gutters) + 1, buildBlocks(segments, len(gutters)+1)
+}
+
+// fixZeroWidths 复合字体（中文简历常见）缺少宽度表，同一次绘制的字符会重叠在同一位置，按字符类型估算宽度后重新排开
+func fixZeroWidths(glyphs []glyph) []glyph {
+	for i := 0; i < len(glyphs); {
+		j := i + 1
+		if glyphs[i].w == 0 {
+			for j < len(glyphs) && glyphs[j].w == 0 && glyphs[j].y == glyphs[i].y &&
+				glyphs[j].x >= glyphs[j-1].x && glyphs[j].x-glyphs[j-1].x < glyphs[j].size*0.1 {
+				j++
+			}
+
+			offset := 0.0
+			for k := i; k < j; k++ {
+				w := estimateWidth(glyphs[k])
+				glyphs[k].x += offset
+				glyphs[k].w = w
+				offset += w
+			}
+		}
+		i = j
+	}
+
+	return glyphs
+}
+
+func estimateWidth(g glyph) float64 {
+	r, _ := utf8.DecodeRuneInString(g.s)
+	switch {
+	case isWide(r):
+		return g.size
+	case unicode.IsSpace(r):
+		return g.size * 0.25
+	default:
+		return g.size * 0.5
+	}
+}
+
+// isWide 全角字符，相邻的全角字符之间不补空格
+func isWide(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303F) || (r >= 0xFF00 && r <= 0xFFEF)
+}
+
+// groupLines 按基线自上而下聚合成行，行内按横坐标排序
+func groupLines(glyphs []glyph) [][]glyph {
+	sorted := make([]glyph, len(glyphs))
+	copy(sorted, glyphs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].y > sorted[j].y
+	})
+
+	var lines [][]glyph
+	var top glyph
+	for _, g := range sorted {
+		n := len(lines)
+		if n > 0 && top.y-g.y <= max(top.size, g.size)*lineTolerance {
+			lines[n-1] = append(lines[n-1], g)
+			continue
+		}
+		lines = append(lines, []glyph{g})
+		top = g
+	}
+
+	for _, line := range lines {
+		sort.SliceStable(line, func(i, j int) bool {
+			return line[i].x < line[j].x
+		})
+	}
+
+	return lines
+}
+
+// splitSegments 行内按字符间距补空格，间距过大时断开
+func splitSegments(lines [][]glyph) []*segment {
+	var segments []*segment
+	for idx, line := range lines {
+		var cur *segment
+		var sb strings.Builder
+		var prev glyph
+		space := false
+
+		flush := func() {
+			if cur != nil {
+				cur.text = sb.String()
+				segments = append(segments, cur)
+			}
+			cur = nil
+			sb.Reset()
+		}
+
+		for _, g := range line {
+			if strings.TrimSpace(g.s) == "" {
+				space = cur != nil
+				continue
+			}
+
+			if cur != nil {
+				// 伪粗体会将同一字符错位绘制两次
+				if g.s == prev.s && math.Abs(g.x-prev.x) < g.size*0.2 {
+					continue
+				}
+
+				gap := g.x - (prev.x + prev.w)
+				if gap > max(g.size, prev.size)*segmentGapRatio {
+					flush()
+				} else {
+					r, _ := utf8.DecodeRuneInString(g.s)
+					p, _ := utf8.DecodeLastRuneInString(prev.s)
+					if space || (gap > g.size*wordGapRatio && !(isWide(r) && isWide(p))) {
+						sb.WriteByte(' ')
+					}
+				}
+			}
+
+			if cur == nil {
+				cur = &segment{x0: g.x, y: g.y, line: idx}
+			}
+			sb.WriteString(g.s)
+			cur.x1 = max(cur.x1, g.x+g.w)
+			cur.size = max(cur.size, g.size)
+			prev, space = g, false
+		}
+		flush()
+	}
+
+	return segments
+}
+
+// detectGutters 统计每个横坐标上覆盖的文本段数，找出几乎没有文字经过的竖直空白作为栏间距
+func detectGutters(segments []*segment) [][2]float64 {
+	minX, maxX := segments[0].x0, segments[0].x1
+	sizes := make([]float64, 0, len(segments))
+	for _, s := range segments {
+		minX, maxX = min(minX, s.x0), max(maxX, s.x1)
+		sizes = append(sizes, s.size)
+	}
+	sort.Float64s(sizes)
+	minWidth := max(minGutterWidth, sizes[len(sizes)/2])
+
+	width := int(math.Ceil(maxX - minX))
+	if width <= 0 {
+		return nil
+	}
+
+	coverage := make([]int, width+1)
+	for _, s := range segments {
+		for b := int(s.x0 - minX); b < int(math.Ceil(s.x1-minX)) && b <= width; b++ {
+			coverage[b]++
+		}
+	}
+
+	allowed := int(float64(len(segments)) * gutterCrossRatio)
+	var gutters [][2]float64
+	for b := 0; b <= width; {
+		if coverage[b] > allowed {
+			b++
+			continue
+		}
+
+		start := b
+		for b <= width && coverage[b] <= allowed {
+			b++
+		}
+		// 页面两侧的空白不是栏间距
+		if start == 0 || b > width {
+			continue
+		}
+
+		lo, hi := minX+float64(start), minX+float64(b)
+		if hi-lo >= minWidth && isGutter(segments, lo, hi) {
+			gutters = append(gutters, [2]float64{lo, hi})
+		}
+	}
+
+	return gutters
+}
+
+// isGutter 空白两侧都要有足够的文本段，避免把偶然对齐的空隙当成分栏
+func isGutter(segments []*segment, lo, hi float64) bool {
+	left, right := 0, 0
+	for _, s := range segments {
+		switch {
+		case s.x1 <= hi:
+			left++
+		case s.x0 >= lo:
+			right++
+		}
+	}
+	return left >= minColumnSegments && right >= minColumnSegments
+}
+
+func assignColumns(segments []*segment, gutters [][2]float64) {
+	for _, s := range segments {
+		s.column = 0
+		for _, g := range gutters {
+			if s.x0 < g[1] && s.x1 > g[0] {
+				s.column = -1
+				break
+			}
+			if s.x0 >= g[1] {
+				s.column++
+			}
+		}
+	}
+}
+
+// textLine 同一行内的文本段
+type textLine struct {
+	segments []*segment
+	spanning bool // 跨栏，如姓名、联系方式、页脚
+}
+
+func (l *textLine) column() (int, bool) {
+	c := l.segments[0].column
+	for _, s := range l.segments[1:] {
+		if s.column != c {
+			return 0, false
+		}
+	}
+	return c, true
+}
+
+// buildBlocks 跨栏的行将页面分成若干横带，横带内逐栏自上而下输出
+func buildBlocks(segments []*segment, columns int) []*document.Block {
+	var lines []*textLine
+	for i := 0; i < len(segments); {
+		line := &textLine{}
+		for j := i; j < len(segments) && segments[j].line == segments[i].line; j++ {
+			line.segments = append(line.segments, segments[j])
+			line.spanning = line.spanning || segments[j].column < 0
+		}
+		lines = append(lines, line)
+		i += len(line.segments)
+	}
+	if columns > 1 {
+		promoteHeaders(lines)
+	}
+
+	var blocks []*document.Block
+	cols := make([][]*segment, columns)
+	var span []*segment
+
+	flushCols := func() {
+		for c := range cols {
+			blocks = append(blocks, makeBlocks(cols[c], c)...)
+			cols[c] = nil
+		}
+	}
+	flushSpan := func() {
+		blocks = append(blocks, makeBlocks(span, -1)...)
+		span = nil
+	}
+
+	for _, line := range lines {
+		if line.spanning {
+			flushCols()
+			span = append(span, line.segments...)
+			continue
+		}
+
+		flushSpan()
+		for _, s := range line.segments {
+			cols[s.column] = append(cols[s.column], s)
+		}
+	}
+	flushCols()
+	flushSpan()
+
+	return blocks
+}
+
+// promoteHeaders 横带开头只落在一栏、且与其他栏内容上下分离的行（如居中的姓名）按跨栏处理
+func promoteHeaders(lines []*textLine) {
+	leading := true
+	for i, line := range lines {
+		if line.spanning {
+			leading = true
+			continue
+		}
+		if !leading {
+			continue
+		}
+
+		c, single := line.column()
+		if single && isHeaderLine(line, c, lines[i+1:]) {
+			line.spanning = true
+			continue
+		}
+		leading = false
+	}
+}
+
+// isHeaderLine 同一横带内其他栏最上方的内容是否在该行下方足够远处；横带内没有其他栏时也按跨栏处理
+func isHeaderLine(line *textLine, column int, rest []*textLine) bool {
+	s := line.segments[0]
+	limit := s.y - s.size*blockGapRatio
+	for _, next := range rest {
+		if next.spanning {
+			return true
+		}
+		for _, seg := range next.segments {
+			if seg.column != column {
+				return seg.y < limit
+			}
+		}
+	}
+	return true
+}
+
+// makeBlocks 同一行的段合并为一行，行距或字号明显变化时开始新的块
+func makeBlocks(segments []*segment, column int) []*document.Block {
+	var blocks []*document.Block
+	var cur *document.Block
+	var prevY, prevSize float64
+
+	for i := 0; i < len(segments); {
+		j := i
+		texts := []string{}
+		y, size := segments[i].y, 0.0
+		x0, x1 := segments[i].x0, segments[i].x1
+		for j < len(segments) && segments[j].line == segments[i].line {
+			texts = append(texts, segments[j].text)
+			size = max(size, segments[j].size)
+			x0, x1 = min(x0, segments[j].x0), max(x1, segments[j].x1)
+			j++
+		}
+
+		// 行高按字号近似：基线下留出下伸部分
+		line := &document.Line{
+			Text:     strings.Join(texts, " "),
+			BBox:     document.Rect{X0: round1(x0), Y0: round1(y - size*0.2), X1: round1(x1), Y1: round1(y + size*0.8)},
+			FontSize: round1(size),
+		}
+
+		if cur == nil || prevY-y > max(size, prevSize)*blockGapRatio ||
+			math.Abs(size-prevSize) > max(size, prevSize)*fontChangeRatio {
+			cur = &document.Block{Column: column, BBox: line.BBox}
+			blocks = append(blocks, cur)
+		}
+		cur.Lines = append(cur.Lines, line)
+		cur.BBox = cur.BBox.Union(line.BBox)
+
+		prevY, prevSize = y, size
+		i = j
+	}
+
+	return blocks
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
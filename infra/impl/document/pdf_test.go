@@ -0,0 +1,120 @@
+package document
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"mianshiba/infra/contract/document"
+	"mianshiba/infra/impl/ocr/fake"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// go test ./infra/impl/document -run TestPDFLayoutGolden -update 重新生成 testdata 下的 .golden 文件
+var update = flag.Bool("update", false, "update golden files")
+
+// scannedText 扫描页的 OCR 结果，模拟 tesseract 在汉字之间插入空格
+const scannedText = "张 三\n后端 工程师 | Go\n\n工作 经历\n某支付公司 2021 - 至今\n"
+
+func TestPDFLayoutGolden(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.pdf")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			assert.NoError(t, err)
+
+			doc, err := NewPDFExtractor(fake.New(scannedText)).ExtractDocument(context.Background(), data)
+			assert.NoError(t, err)
+			got := dumpDocument(doc)
+
+			golden := strings.TrimSuffix(file, ".pdf") + ".golden"
+			if *update {
+				assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestPDFLayoutFailedPages(t *testing.T) {
+	data, err := os.ReadFile("testdata/multi_page_broken.pdf")
+	assert.NoError(t, err)
+
+	doc, err := (&pdfExtractor{}).ExtractDocument(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2}, doc.FailedPages())
+
+	text, err := DocumentDefaultFactory.GetExtractor(document.FileTypePDF)
+	assert.NoError(t, err)
+	_, ok := text.(document.LayoutExtractor)
+	assert.True(t, ok)
+}
+
+func TestPDFScannedPages(t *testing.T) {
+	data, err := os.ReadFile("testdata/scanned.pdf")
+	assert.NoError(t, err)
+
+	// 未配置 OCR 时扫描页记为失败，其余页面正常输出
+	doc, err := (&pdfExtractor{}).ExtractDocument(context.Background(), data)
+	assert.NoError(t, err)
+	assert.True(t, doc.Pages[0].Scanned)
+	assert.Equal(t, []int{1}, doc.FailedPages())
+	assert.True(t, doc.HasText())
+
+	engine := fake.New()
+	engine.Err = fmt.Errorf("engine crashed")
+	doc, err = NewPDFExtractor(engine).ExtractDocument(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, "ocr failed: engine crashed", doc.Pages[0].Err)
+
+	// 识别时收到的是 PNG 图片
+	engine = fake.New(scannedText)
+	_, err = NewPDFExtractor(engine).ExtractDocument(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Len(t, engine.Images(), 1)
+	assert.True(t, strings.HasPrefix(string(engine.Images()[0]), "\x89PNG"))
+}
+
+func TestPDFExtractorInvalid(t *testing.T) {
+	_, err := (&pdfExtractor{}).ExtractDocument(context.Background(), []byte("%PDF-1.4\nbroken"))
+	assert.Error(t, err)
+}
+
+// dumpDocument 输出版面结构和阅读顺序的全文，便于对比
+func dumpDocument(doc *document.Document) string {
+	var sb strings.Builder
+	for _, page := range doc.Pages {
+		if page.Failed() {
+			fmt.Fprintf(&sb, "page %d failed: %s\n", page.Number, page.Err)
+			continue
+		}
+
+		fmt.Fprintf(&sb, "page %d size=%gx%g columns=%d scanned=%t\n", page.Number, page.Width, page.Height, page.Columns, page.Scanned)
+		for _, block := range page.Blocks {
+			fmt.Fprintf(&sb, "  block column=%d %s\n", block.Column, dumpRect(block.BBox))
+			for _, line := range block.Lines {
+				fmt.Fprintf(&sb, "    %s size=%g %q\n", dumpRect(line.BBox), line.FontSize, line.Text)
+			}
+		}
+	}
+
+	sb.WriteString("--- text ---\n")
+	sb.WriteString(doc.Text())
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func dumpRect(r document.Rect) string {
+	return fmt.Sprintf("[%g %g %g %g]", r.X0, r.Y0, r.X1, r.Y1)
+}
@@ -0,0 +1,87 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+type textExtractor struct{}
+
+func (e *textExtractor) Extract(ctx context.Context, data []byte) (string, error) {
+	text, err := decodeText(data)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeText(text), nil
+}
+
+// decodeText 将文本文件解码为 UTF-8：识别 BOM，非法 UTF-8 时按 GBK 解码（Windows 下常见）
+func decodeText(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, utf8BOM):
+		return string(data[len(utf8BOM):]), nil
+	case bytes.HasPrefix(data, utf16LEBOM):
+		decoded, err := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder().Bytes(data)
+		if err != nil {
+			return "", fmt.Errorf("decode utf-16 text failed: %w", err)
+		}
+		return string(decoded), nil
+	case bytes.HasPrefix(data, utf16BEBOM):
+		decoded, err := unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder().Bytes(data)
+		if err != nil {
+			return "", fmt.Errorf("decode utf-16 text failed: %w", err)
+		}
+		return string(decoded), nil
+	}
+
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+
+	decoded, err := simplifiedchinese.GBK.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("decode gbk text failed: %w", err)
+	}
+	return string(decoded), nil
+}
+
+// normalizeText 统一换行符，去除行尾空白，合并连续空行
+func normalizeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+	blank := false
+	for _, line := range lines {
+		line = strings.TrimRightFunc(line, isSpace)
+		if line == "" {
+			if !blank && len(result) > 0 {
+				result = append(result, "")
+			}
+			blank = true
+			continue
+		}
+		result = append(result, line)
+		blank = false
+	}
+
+	return strings.TrimSpace(strings.Join(result, "\n"))
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\u00a0' || r == '\u3000'
+}
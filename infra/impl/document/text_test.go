@@ -0,0 +1,90 @@
+package document
+
+import (
+	"context"
+	"testing"
+
+	"mianshiba/infra/contract/document"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestMarkdownExtractor(t *testing.T) {
+	data := []byte("---\ntitle: resume\n---\n" +
+		"张三\n===\n\n" +
+		"<!-- 模板说明 -->\n" +
+		"**后端工程师** | [GitHub](https://github.com/zhangsan)\n\n" +
+		"## 技能 ##\n" +
+		"* Go\n" +
+		"+ `MySQL`、*Redis*\n\n" +
+		"工作经历\n---\n" +
+		"| 公司 | 职位 |\n| --- | :---: |\n| 某科技 | 高级工程师 |\n\n" +
+		"***\n" +
+		"```go\nfunc main() {}\n```\n" +
+		"> 简历更新于 2025 年\n")
+
+	text, err := (&markdownExtractor{}).Extract(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, `# 张三
+
+后端工程师 | GitHub (https://github.com/zhangsan)
+
+## 技能
+- Go
+- MySQL、Redis
+
+## 工作经历
+公司 | 职位
+某科技 | 高级工程师
+
+func main() {}
+简历更新于 2025 年`, text)
+}
+
+func TestHTMLExtractor(t *testing.T) {
+	data := []byte(`<!DOCTYPE html>
+<html><head><title>简历</title><style>h1{color:red}</style></head>
+<body>
+  <h1>张三</h1>
+  <p>后端工程师，<b>5年</b>经验<br>邮箱：zhangsan@example.com</p>
+  <script>alert(1)</script>
+  <h2>技能</h2>
+  <ul><li>Go</li><li>MySQL &amp; Redis</li></ul>
+  <table><tr><th>公司</th><th>职位</th></tr><tr><td>某科技</td><td>高级工程师</td></tr></table>
+  <p><a href="https://github.com/zhangsan">GitHub</a></p>
+</body></html>`)
+
+	text, err := (&htmlExtractor{}).Extract(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, `# 张三
+后端工程师，5年经验
+邮箱：zhangsan@example.com
+## 技能
+- Go
+- MySQL & Redis
+公司 | 职位
+某科技 | 高级工程师
+GitHub (https://github.com/zhangsan)`, text)
+}
+
+func TestTextExtractor(t *testing.T) {
+	gbk, err := simplifiedchinese.GBK.NewEncoder().Bytes([]byte("张三\r\n\r\n\r\n后端工程师  \r\n"))
+	assert.NoError(t, err)
+
+	text, err := (&textExtractor{}).Extract(context.Background(), gbk)
+	assert.NoError(t, err)
+	assert.Equal(t, "张三\n\n后端工程师", text)
+
+	text, err = (&textExtractor{}).Extract(context.Background(), []byte("\xEF\xBB\xBF张三"))
+	assert.NoError(t, err)
+	assert.Equal(t, "张三", text)
+}
+
+func TestParseFileType(t *testing.T) {
+	assert.Equal(t, document.FileTypeMarkdown, document.ParseFileType(".Markdown"))
+	assert.Equal(t, document.FileTypeHTML, document.ParseFileType("text/html; charset=utf-8"))
+	assert.Equal(t, document.FileTypeHTML, document.ParseFileType("htm"))
+	assert.Equal(t, document.FileTypeText, document.ParseFileType("text/plain"))
+	assert.False(t, DocumentDefaultFactory.SupportFileType(document.ParseFileType("xlsx")))
+}
@@ -0,0 +1,208 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/infra/contract/embedding"
+	"net/http"
+	"net/url"
+
+	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	einoEmbedding "github.com/cloudwego/eino/components/embedding"
+	"github.com/eino-contrib/ollama/api"
+	"github.com/volcengine/volcengine-go-sdk/service/arkruntime"
+	"github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
+)
+
+const (
+	defaultQwenBaseURL   = "https://dashscope.aliyuncs.com/compatible-mode/v1"
+	defaultOllamaBaseURL = "http://localhost:11434"
+)
+
+type Builder func(ctx context.Context, config *embedding.Config) (embedding.Embedder, error)
+
+var (
+	EmbeddingDefaultFactory = NewDefaultFactory()
+)
+
+func NewDefaultFactory() embedding.Factory {
+	return NewFactory(nil)
+}
+
+func NewFactory(customFactory map[embedding.Protocol]Builder) embedding.Factory {
+	protocol2Builder := map[embedding.Protocol]Builder{
+		embedding.ProtocolOpenAI: openAIBuilder,
+		embedding.ProtocolArk:    arkBuilder,
+		embedding.ProtocolOllama: ollamaBuilder,
+		embedding.ProtocolQwen:   qwenBuilder,
+	}
+
+	for p := range customFactory {
+		protocol2Builder[p] = customFactory[p]
+	}
+
+	return &defaultFactory{protocol2Builder: protocol2Builder}
+}
+
+type defaultFactory struct {
+	protocol2Builder map[embedding.Protocol]Builder
+}
+
+func (f *defaultFactory) CreateEmbedder(ctx context.Context, protocol embedding.Protocol, config *embedding.Config) (embedding.Embedder, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[CreateEmbedder] config not provided")
+	}
+
+	builder, found := f.protocol2Builder[protocol]
+	if !found || builder == nil {
+		return nil, fmt.Errorf("[CreateEmbedder] protocol not support, protocol=%s", protocol)
+	}
+
+	return builder(ctx, config)
+}
+
+func (f *defaultFactory) SupportProtocol(protocol embedding.Protocol) bool {
+	_, found := f.protocol2Builder[protocol]
+	return found
+}
+
+func openAIBuilder(ctx context.Context, config *embedding.Config) (embedding.Embedder, error) {
+	cfg := &openai.EmbeddingConfig{
+		APIKey:     config.APIKey,
+		BaseURL:    config.BaseURL,
+		Model:      config.Model,
+		Dimensions: config.Dimensions,
+		HTTPClient: &http.Client{Timeout: config.Timeout},
+	}
+	if config.OpenAI != nil {
+		cfg.ByAzure = config.OpenAI.ByAzure
+		cfg.APIVersion = config.OpenAI.APIVersion
+	}
+	return openai.NewEmbeddingClient(ctx, cfg)
+}
+
+func qwenBuilder(ctx context.Context, config *embedding.Config) (embedding.Embedder, error) {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultQwenBaseURL
+	}
+	return openai.NewEmbeddingClient(ctx, &openai.EmbeddingConfig{
+		APIKey:     config.APIKey,
+		BaseURL:    baseURL,
+		Model:      config.Model,
+		Dimensions: config.Dimensions,
+		HTTPClient: &http.Client{Timeout: config.Timeout},
+	})
+}
+
+func arkBuilder(ctx context.Context, config *embedding.Config) (embedding.Embedder, error) {
+	var opts []arkruntime.ConfigOption
+	if config.BaseURL != "" {
+		opts = append(opts, arkruntime.WithBaseUrl(config.BaseURL))
+	}
+	if config.Timeout > 0 {
+		opts = append(opts, arkruntime.WithTimeout(config.Timeout))
+	}
+
+	var client *arkruntime.Client
+	if config.Ark != nil {
+		if config.Ark.Region != "" {
+			opts = append(opts, arkruntime.WithRegion(config.Ark.Region))
+		}
+		if config.APIKey == "" && config.Ark.AccessKey != "" {
+			client = arkruntime.NewClientWithAkSk(config.Ark.AccessKey, config.Ark.SecretKey, opts...)
+		}
+	}
+	if client == nil {
+		client = arkruntime.NewClientWithApiKey(config.APIKey, opts...)
+	}
+
+	return &arkEmbedder{client: client, model: config.Model, dimensions: config.Dimensions}, nil
+}
+
+func ollamaBuilder(ctx context.Context, config *embedding.Config) (embedding.Embedder, error) {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultOllamaBaseURL
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("[CreateEmbedder] invalid ollama base url %q: %w", baseURL, err)
+	}
+
+	client := api.NewClient(base, &http.Client{Timeout: config.Timeout})
+	return &ollamaEmbedder{client: client, model: config.Model, dimensions: config.Dimensions}, nil
+}
+
+// arkEmbedder 方舟向量化接口
+type arkEmbedder struct {
+	client     *arkruntime.Client
+	model      string
+	dimensions *int
+}
+
+func (e *arkEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...einoEmbedding.Option) ([][]float64, error) {
+	req := model.EmbeddingRequestStrings{
+		Input: texts,
+		Model: *einoEmbedding.GetCommonOptions(&einoEmbedding.Options{Model: &e.model}, opts...).Model,
+	}
+	if e.dimensions != nil {
+		req.Dimensions = *e.dimensions
+	}
+
+	resp, err := e.client.CreateEmbeddings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("[arkEmbedder] create embeddings failed: %w", err)
+	}
+	if len(resp.Data) != len(texts) {
+		return nil, fmt.Errorf("[arkEmbedder] expect %d embeddings, got %d", len(texts), len(resp.Data))
+	}
+
+	vectors := make([][]float64, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return nil, fmt.Errorf("[arkEmbedder] embedding index %d out of range", d.Index)
+		}
+		vectors[d.Index] = toFloat64(d.Embedding)
+	}
+	return vectors, nil
+}
+
+// ollamaEmbedder ollama 向量化接口
+type ollamaEmbedder struct {
+	client     *api.Client
+	model      string
+	dimensions *int
+}
+
+func (e *ollamaEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...einoEmbedding.Option) ([][]float64, error) {
+	req := &api.EmbedRequest{
+		Model: *einoEmbedding.GetCommonOptions(&einoEmbedding.Options{Model: &e.model}, opts...).Model,
+		Input: texts,
+	}
+	if e.dimensions != nil {
+		req.Dimensions = *e.dimensions
+	}
+
+	resp, err := e.client.Embed(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("[ollamaEmbedder] embed failed: %w", err)
+	}
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("[ollamaEmbedder] expect %d embeddings, got %d", len(texts), len(resp.Embeddings))
+	}
+
+	vectors := make([][]float64, len(resp.Embeddings))
+	for i, v := range resp.Embeddings {
+		vectors[i] = toFloat64(v)
+	}
+	return vectors, nil
+}
+
+func toFloat64(v []float32) []float64 {
+	out := make([]float64, len(v))
+	for i := range v {
+		out[i] = float64(v[i])
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mianshiba/infra/contract/cache"
+	"mianshiba/infra/contract/idempotency"
+)
+
+const (
+	valueProcessing = "processing"
+	valueDone       = "done"
+)
+
+// New 基于 Redis 的幂等键存储
+func New(client cache.Cmdable) idempotency.Store {
+	return &redisStore{cli: client}
+}
+
+type redisStore struct {
+	cli cache.Cmdable
+}
+
+func (s *redisStore) Acquire(ctx context.Context, key string, lease time.Duration) (idempotency.State, error) {
+	// 读取状态和抢占之间处理权可能恰好过期，重试一次
+	for range 2 {
+		ok, err := s.cli.SetNX(ctx, key, valueProcessing, lease).Result()
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return idempotency.StateAcquired, nil
+		}
+
+		value, err := s.cli.Get(ctx, key).Result()
+		if errors.Is(err, cache.Nil) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if value == valueDone {
+			return idempotency.StateDone, nil
+		}
+		return idempotency.StateProcessing, nil
+	}
+
+	return idempotency.StateProcessing, nil
+}
+
+func (s *redisStore) Complete(ctx context.Context, key string, ttl time.Duration) error {
+	return s.cli.Set(ctx, key, valueDone, ttl).Err()
+}
+
+func (s *redisStore) Release(ctx context.Context, key string) error {
+	return s.cli.Del(ctx, key).Err()
+}
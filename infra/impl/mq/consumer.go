@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"mianshiba/conf"
 	mq "mianshiba/infra/contract/mq"
-	"mianshiba/pkg/logs"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
@@ -14,14 +14,14 @@ type kafkaConsumer struct {
 	consumer *kafka.Consumer
 }
 
-// NewConsumer 创建一个新的Kafka消费者实例
-func NewConsumer(ctx context.Context) (mq.KafkaConsumer, error) {
+// newKafkaConsumer 创建一个新的Kafka消费者实例
+func newKafkaConsumer() (*kafkaConsumer, error) {
 	// 构建Kafka配置
 	config := &kafka.ConfigMap{
 		"bootstrap.servers":  conf.Global.Kafka.Brokers,
 		"group.id":           conf.Global.Kafka.GroupID,
 		"auto.offset.reset":  "earliest", // 从最早的偏移量开始消费
-		"enable.auto.commit": true,       // 自动提交偏移量
+		"enable.auto.commit": false,      // 处理成功或投递到死信主题后再提交偏移量
 	}
 
 	// 创建Kafka消费者
@@ -30,51 +30,91 @@ func NewConsumer(ctx context.Context) (mq.KafkaConsumer, error) {
 		return nil, fmt.Errorf("failed to create kafka consumer: %v", err)
 	}
 
-	return &kafkaConsumer{
-		consumer: c,
-	}, nil
+	return &kafkaConsumer{consumer: c}, nil
 }
 
-// Consume 开始消费指定主题的消息
-func (kc *kafkaConsumer) Consume(ctx context.Context, topics []string, handler func(ctx context.Context, message *mq.KafkaMessage) error) error {
-	// 订阅主题
+// Subscribe 订阅主题，从消费组已提交的偏移量开始读取
+func (kc *kafkaConsumer) Subscribe(topics []string) error {
 	if err := kc.consumer.SubscribeTopics(topics, nil); err != nil {
 		return fmt.Errorf("failed to subscribe to topics: %v", err)
 	}
+	return nil
+}
 
-	// 消费循环
+// Read 读取下一条消息，ctx 取消时返回 ctx.Err()
+func (kc *kafkaConsumer) Read(ctx context.Context) (*mq.KafkaMessage, error) {
 	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-			// 从Kafka获取消息，超时时间为1秒
-			msg, err := kc.consumer.ReadMessage(1000)
-			if err != nil {
-				// 处理超时或其他错误
-				if err.(kafka.Error).Code() == kafka.ErrTimedOut {
-					continue // 超时，继续下一次循环
-				}
-				return fmt.Errorf("failed to read message: %v", err)
-			}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 
-			// 构建自定义KafkaMessage结构
-			kafkaMsg := &mq.KafkaMessage{
-				Topic:     *msg.TopicPartition.Topic,
-				Partition: msg.TopicPartition.Partition,
-				Offset:    int64(msg.TopicPartition.Offset),
-				Key:       msg.Key,
-				Value:     msg.Value,
+		// 从Kafka获取消息，超时时间为1秒
+		msg, err := kc.consumer.ReadMessage(time.Second)
+		if err != nil {
+			// 处理超时或其他错误
+			if err.(kafka.Error).Code() == kafka.ErrTimedOut {
+				continue // 超时，继续下一次循环
 			}
+			return nil, fmt.Errorf("failed to read message: %v", err)
+		}
 
-			// 调用消息处理器处理消息
-			if err := handler(ctx, kafkaMsg); err != nil {
-				logs.Errorf("Failed to handle message from topic %s, partition %d, offset %d: %v",
-					kafkaMsg.Topic, kafkaMsg.Partition, kafkaMsg.Offset, err)
-				// 消息处理失败时，根据需要进行重试或其他处理
-			}
+		// 构建自定义KafkaMessage结构
+		return &mq.KafkaMessage{
+			Topic:     *msg.TopicPartition.Topic,
+			Partition: msg.TopicPartition.Partition,
+			Offset:    int64(msg.TopicPartition.Offset),
+			Key:       msg.Key,
+			Value:     msg.Value,
+		}, nil
+	}
+}
+
+// Commit 提交分区的消费进度，offset 为下一条待消费消息的偏移量
+func (kc *kafkaConsumer) Commit(ctx context.Context, topic string, partition int32, offset int64) error {
+	_, err := kc.consumer.CommitOffsets([]kafka.TopicPartition{
+		{Topic: &topic, Partition: partition, Offset: kafka.Offset(offset)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit offset, topic %s, partition %d, offset %d: %v", topic, partition, offset, err)
+	}
+	return nil
+}
+
+// Lag 当前分配到的各分区的积压消息数，末尾偏移量取最近一次拉取时的缓存值
+func (kc *kafkaConsumer) Lag(ctx context.Context) ([]mq.PartitionLag, error) {
+	assigned, err := kc.consumer.Assignment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment: %v", err)
+	}
+	if len(assigned) == 0 {
+		return nil, nil
+	}
+
+	committed, err := kc.consumer.Committed(assigned, 5000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get committed offsets: %v", err)
+	}
+
+	lags := make([]mq.PartitionLag, 0, len(committed))
+	for _, tp := range committed {
+		low, high, err := kc.consumer.GetWatermarkOffsets(*tp.Topic, tp.Partition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offsets of topic %s, partition %d: %v", *tp.Topic, tp.Partition, err)
+		}
+
+		// 尚未提交过的分区从最早的消息开始消费
+		position := int64(tp.Offset)
+		if position < 0 {
+			position = low
 		}
+		lags = append(lags, mq.PartitionLag{
+			Topic:     *tp.Topic,
+			Partition: tp.Partition,
+			Committed: position,
+			End:       high,
+		})
 	}
+	return lags, nil
 }
 
 // Close 关闭Kafka消费者
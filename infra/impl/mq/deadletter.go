@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mianshiba/conf"
+	mq "mianshiba/infra/contract/mq"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// DeadLetterRecord 死信主题中的一条消息
+type DeadLetterRecord struct {
+	Partition int32 // 死信主题中的分区
+	Offset    int64 // 死信主题中的偏移量
+	*mq.DeadLetter
+}
+
+// ReadDeadLetters 从头读取死信主题中的全部消息，读到各分区末尾即返回，不提交偏移量，不影响业务消费组
+// memory 实现的死信消息只在服务进程内，无法从其他进程读取
+func ReadDeadLetters(ctx context.Context) ([]*DeadLetterRecord, error) {
+	if Backend() != BackendKafka {
+		return nil, fmt.Errorf("dead letters can only be read from kafka backend, current backend is %s", Backend())
+	}
+
+	topic := conf.Global.Kafka.DeadLetterTopic
+	if topic == "" {
+		return nil, fmt.Errorf("dead letter topic not configured")
+	}
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  conf.Global.Kafka.Brokers,
+		"group.id":           conf.Global.Kafka.GroupID + "_dead_letter_admin",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka consumer: %v", err)
+	}
+	defer c.Close()
+
+	metadata, err := c.GetMetadata(&topic, false, 5000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of topic %s: %v", topic, err)
+	}
+
+	// 记录各分区当前的末尾位置，读到末尾即结束
+	var partitions []kafka.TopicPartition
+	ends := make(map[int32]int64)
+	for _, p := range metadata.Topics[topic].Partitions {
+		low, high, err := c.QueryWatermarkOffsets(topic, p.ID, 5000)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query offsets of topic %s, partition %d: %v", topic, p.ID, err)
+		}
+		if high > low {
+			partitions = append(partitions, kafka.TopicPartition{Topic: &topic, Partition: p.ID, Offset: kafka.Offset(low)})
+			ends[p.ID] = high
+		}
+	}
+
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+	if err := c.Assign(partitions); err != nil {
+		return nil, fmt.Errorf("failed to assign partitions: %v", err)
+	}
+
+	var records []*DeadLetterRecord
+	for len(ends) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		msg, err := c.ReadMessage(1000)
+		if err != nil {
+			if err.(kafka.Error).Code() == kafka.ErrTimedOut {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read message: %v", err)
+		}
+
+		record := &DeadLetterRecord{
+			Partition:  msg.TopicPartition.Partition,
+			Offset:     int64(msg.TopicPartition.Offset),
+			DeadLetter: &mq.DeadLetter{},
+		}
+		if err := json.Unmarshal(msg.Value, record.DeadLetter); err != nil {
+			return nil, fmt.Errorf("invalid dead letter, partition %d, offset %d: %v", record.Partition, record.Offset, err)
+		}
+		records = append(records, record)
+
+		if record.Offset+1 >= ends[record.Partition] {
+			delete(ends, record.Partition)
+		}
+	}
+
+	return records, nil
+}
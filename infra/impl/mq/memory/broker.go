@@ -0,0 +1,284 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	mq "mianshiba/infra/contract/mq"
+)
+
+// Store 持久化消息和消费进度，进程重启后未提交的消息重新投递
+type Store interface {
+	// Load 按写入顺序返回全部消息，以及各消费组在各主题上下一条待消费的偏移量（key 为 groupID/topic）
+	Load(ctx context.Context) (messages []*mq.KafkaMessage, offsets map[string]int64, err error)
+	Append(ctx context.Context, msg *mq.KafkaMessage) error
+	Commit(ctx context.Context, groupID string, topic string, offset int64) error
+	// Compact 删除各主题中偏移量小于 before[topic] 的消息，消费进度保持不变
+	Compact(ctx context.Context, before map[string]int64) error
+}
+
+// Broker 进程内消息队列：每个主题一个分区，偏移量即消息在主题中的序号，消费组独立记录消费进度
+// 生产者和消费者需在同一进程内共用一个 Broker，适用于本地开发和测试
+type Broker struct {
+	store Store // 为空时不持久化
+
+	mu      sync.Mutex
+	topics  map[string]*topicLog
+	offsets map[string]int64 // groupID/topic → 下一条待消费的偏移量
+	notify  chan struct{}    // 有新消息时关闭并替换，唤醒等待的消费者
+}
+
+// topicLog 主题中保留的消息，base 为第一条保留消息的偏移量
+type topicLog struct {
+	base     int64
+	messages []*mq.KafkaMessage
+}
+
+// end 下一条写入消息的偏移量
+func (l *topicLog) end() int64 {
+	return l.base + int64(len(l.messages))
+}
+
+// NewBroker 创建 Broker，store 不为空时从中恢复消息和消费进度，
+// 并清理所有已提交消费组都已消费过的消息，避免持久化的消息无限增长
+func NewBroker(ctx context.Context, store Store) (*Broker, error) {
+	b := &Broker{
+		store:   store,
+		topics:  make(map[string]*topicLog),
+		offsets: make(map[string]int64),
+		notify:  make(chan struct{}),
+	}
+
+	if store == nil {
+		return b, nil
+	}
+
+	messages, offsets, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load messages: %w", err)
+	}
+	for key, offset := range offsets {
+		b.offsets[key] = offset
+	}
+
+	// 各主题中最小的已提交偏移量，之前的消息所有消费组都已消费
+	committed := make(map[string]int64)
+	for key, offset := range offsets {
+		topic := key[strings.LastIndex(key, "/")+1:]
+		if current, ok := committed[topic]; !ok || offset < current {
+			committed[topic] = offset
+		}
+	}
+
+	for topic, offset := range committed {
+		b.topicLog(topic).base = offset
+	}
+	consumed := 0
+	for _, msg := range messages {
+		if msg.Offset < committed[msg.Topic] {
+			consumed++
+			continue
+		}
+		l := b.topicLog(msg.Topic)
+		if len(l.messages) == 0 {
+			l.base = msg.Offset
+		}
+		l.messages = append(l.messages, msg)
+	}
+
+	if consumed > 0 {
+		if err := store.Compact(ctx, committed); err != nil {
+			return nil, fmt.Errorf("failed to compact messages: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+// topicLog 返回主题的消息，不存在时创建；调用方需持有 b.mu 或处于初始化阶段
+func (b *Broker) topicLog(topic string) *topicLog {
+	l, ok := b.topics[topic]
+	if !ok {
+		l = &topicLog{}
+		b.topics[topic] = l
+	}
+	return l
+}
+
+// Producer 返回写入该 Broker 的生产者
+func (b *Broker) Producer() mq.KafkaProducer {
+	return &producer{broker: b}
+}
+
+// Consumer 返回以 groupID 消费该 Broker 的消费者，同一消费组在进程内只应有一个消费者
+func (b *Broker) Consumer(groupID string) *Consumer {
+	return &Consumer{broker: b, groupID: groupID}
+}
+
+func (b *Broker) publish(ctx context.Context, topic string, key []byte, value []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	l := b.topicLog(topic)
+	msg := &mq.KafkaMessage{
+		Topic:  topic,
+		Offset: l.end(),
+		Key:    key,
+		Value:  value,
+	}
+	if b.store != nil {
+		if err := b.store.Append(ctx, msg); err != nil {
+			return fmt.Errorf("failed to persist message: %w", err)
+		}
+	}
+
+	l.messages = append(l.messages, msg)
+	close(b.notify)
+	b.notify = make(chan struct{})
+
+	return nil
+}
+
+// next 返回各主题中 cursor 处的第一条消息，cursor 处的消息已被清理时从最早保留的消息开始；没有时返回等待新消息的通道
+func (b *Broker) next(topics []string, cursor map[string]int64) (*mq.KafkaMessage, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, topic := range topics {
+		l, ok := b.topics[topic]
+		if !ok {
+			continue
+		}
+		if offset := max(cursor[topic], l.base); offset < l.end() {
+			return l.messages[offset-l.base], nil
+		}
+	}
+
+	return nil, b.notify
+}
+
+func (b *Broker) commit(ctx context.Context, groupID string, topic string, offset int64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.store != nil {
+		if err := b.store.Commit(ctx, groupID, topic, offset); err != nil {
+			return fmt.Errorf("failed to persist offset: %w", err)
+		}
+	}
+	b.offsets[offsetKey(groupID, topic)] = offset
+
+	return nil
+}
+
+func offsetKey(groupID string, topic string) string {
+	return groupID + "/" + topic
+}
+
+type producer struct {
+	broker *Broker
+}
+
+func (p *producer) SendMessage(ctx context.Context, topic string, key []byte, value []byte) error {
+	return p.broker.publish(ctx, topic, key, value)
+}
+
+func (p *producer) Close() error {
+	return nil
+}
+
+// Consumer 进程内消费者：Read 按写入顺序读取消息，Commit 记录消费进度，未提交的消息在重启后重新投递
+type Consumer struct {
+	broker  *Broker
+	groupID string
+
+	topics []string
+	cursor map[string]int64 // 各主题下一条待读取消息的偏移量，可领先于已提交的偏移量
+}
+
+// Subscribe 订阅主题，从各主题已提交的偏移量开始读取
+func (c *Consumer) Subscribe(topics []string) error {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+
+	c.topics = topics
+	c.cursor = make(map[string]int64, len(topics))
+	for _, topic := range topics {
+		c.cursor[topic] = c.broker.offsets[offsetKey(c.groupID, topic)]
+	}
+	return nil
+}
+
+// Read 返回各主题中最早的一条未读消息，没有时等待新消息，ctx 取消时返回 ctx.Err()
+func (c *Consumer) Read(ctx context.Context) (*mq.KafkaMessage, error) {
+	for {
+		msg, wait := c.broker.next(c.topics, c.cursor)
+		if msg != nil {
+			c.cursor[msg.Topic] = msg.Offset + 1
+			return msg, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Commit 提交主题的消费进度，offset 为下一条待消费消息的偏移量；主题只有一个分区，partition 忽略
+func (c *Consumer) Commit(ctx context.Context, topic string, partition int32, offset int64) error {
+	return c.broker.commit(ctx, c.groupID, topic, offset)
+}
+
+// Lag 各主题的积压消息数
+func (c *Consumer) Lag(ctx context.Context) ([]mq.PartitionLag, error) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+
+	lags := make([]mq.PartitionLag, 0, len(c.topics))
+	for _, topic := range c.topics {
+		var end int64
+		if l, ok := c.broker.topics[topic]; ok {
+			end = l.end()
+		}
+		lags = append(lags, mq.PartitionLag{
+			Topic:     topic,
+			Committed: c.broker.offsets[offsetKey(c.groupID, topic)],
+			End:       end,
+		})
+	}
+	return lags, nil
+}
+
+// Consume 依次处理各主题中的未消费消息，处理成功后提交偏移量；处理器返回错误时不提交并返回该错误
+func (c *Consumer) Consume(ctx context.Context, topics []string, handler func(ctx context.Context, message *mq.KafkaMessage) error) error {
+	if err := c.Subscribe(topics); err != nil {
+		return err
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		msg, err := c.Read(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := handler(ctx, msg); err != nil {
+			return err
+		}
+
+		if err := c.Commit(ctx, msg.Topic, msg.Partition, msg.Offset+1); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	return nil
+}
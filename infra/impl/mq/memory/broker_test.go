@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mq "mianshiba/infra/contract/mq"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// consumeN 消费 n 条消息后停止，返回消费到的消息内容
+func consumeN(t *testing.T, c mq.KafkaConsumer, topics []string, n int) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var values []string
+	err := c.Consume(ctx, topics, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		values = append(values, string(msg.Value))
+		if len(values) == n {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	return values
+}
+
+func TestBrokerConsume(t *testing.T) {
+	ctx := context.Background()
+	b, err := NewBroker(ctx, nil)
+	assert.NoError(t, err)
+
+	p := b.Producer()
+	assert.NoError(t, p.SendMessage(ctx, "resume", []byte("1"), []byte("a")))
+	assert.NoError(t, p.SendMessage(ctx, "evaluation", []byte("2"), []byte("b")))
+
+	// 消费者启动后写入的消息同样能收到
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = p.SendMessage(ctx, "resume", []byte("3"), []byte("c"))
+	}()
+	assert.Equal(t, []string{"a", "b", "c"}, consumeN(t, b.Consumer("g1"), []string{"resume", "evaluation"}, 3))
+
+	// 消费组各自记录进度
+	assert.Equal(t, []string{"a", "c"}, consumeN(t, b.Consumer("g2"), []string{"resume"}, 2))
+
+	// 处理失败时不提交，再次消费时重新收到该消息
+	failed := errors.New("db unavailable")
+	err = b.Consumer("g3").Consume(ctx, []string{"resume"}, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		return failed
+	})
+	assert.ErrorIs(t, err, failed)
+	assert.Equal(t, []string{"a"}, consumeN(t, b.Consumer("g3"), []string{"resume"}, 1))
+}
+
+func TestBrokerFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mq", "mq.log")
+
+	store, err := NewFileStore(path)
+	assert.NoError(t, err)
+	b, err := NewBroker(ctx, store)
+	assert.NoError(t, err)
+
+	p := b.Producer()
+	for _, v := range []string{"a", "b", "c"} {
+		assert.NoError(t, p.SendMessage(ctx, "resume", nil, []byte(v)))
+	}
+	assert.Equal(t, []string{"a"}, consumeN(t, b.Consumer("g"), []string{"resume"}, 1))
+
+	// 重启后从未提交的消息继续消费，新消息的偏移量接着已有的消息
+	store, err = NewFileStore(path)
+	assert.NoError(t, err)
+	b, err = NewBroker(ctx, store)
+	assert.NoError(t, err)
+
+	assert.NoError(t, b.Producer().SendMessage(ctx, "resume", nil, []byte("d")))
+	assert.Equal(t, []string{"b", "c", "d"}, consumeN(t, b.Consumer("g"), []string{"resume"}, 3))
+}
+
+func TestBrokerFileStoreTornTail(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mq.log")
+
+	store, err := NewFileStore(path)
+	assert.NoError(t, err)
+	b, err := NewBroker(ctx, store)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Producer().SendMessage(ctx, "resume", nil, []byte("a")))
+
+	// 模拟写入最后一条记录时进程退出
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	assert.NoError(t, err)
+	_, err = f.WriteString(`{"type":"message","topic":"resu`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	// 重启时截掉不完整的记录，之后追加的消息在下次重启时仍能读取
+	store, err = NewFileStore(path)
+	assert.NoError(t, err)
+	b, err = NewBroker(ctx, store)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Producer().SendMessage(ctx, "resume", nil, []byte("b")))
+
+	store, err = NewFileStore(path)
+	assert.NoError(t, err)
+	b, err = NewBroker(ctx, store)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, consumeN(t, b.Consumer("g"), []string{"resume"}, 2))
+}
+
+func TestBrokerFileStoreCompact(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mq.log")
+
+	store, err := NewFileStore(path)
+	assert.NoError(t, err)
+	b, err := NewBroker(ctx, store)
+	assert.NoError(t, err)
+
+	p := b.Producer()
+	for _, v := range []string{"a", "b", "c"} {
+		assert.NoError(t, p.SendMessage(ctx, "resume", nil, []byte(v)))
+	}
+	assert.Equal(t, []string{"a", "b"}, consumeN(t, b.Consumer("g1"), []string{"resume"}, 2))
+	assert.Equal(t, []string{"a"}, consumeN(t, b.Consumer("g2"), []string{"resume"}, 1))
+
+	// 重启时清理所有消费组都已消费的消息
+	store, err = NewFileStore(path)
+	assert.NoError(t, err)
+	_, err = NewBroker(ctx, store)
+	assert.NoError(t, err)
+
+	store, err = NewFileStore(path)
+	assert.NoError(t, err)
+	messages, offsets, err := store.Load(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, map[string]int64{"g1/resume": 2, "g2/resume": 1}, offsets)
+
+	// 偏移量接着清理前的消息，各消费组从各自的进度继续消费
+	b, err = NewBroker(ctx, store)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Producer().SendMessage(ctx, "resume", nil, []byte("d")))
+	assert.Equal(t, []string{"c", "d"}, consumeN(t, b.Consumer("g1"), []string{"resume"}, 2))
+	assert.Equal(t, []string{"b", "c", "d"}, consumeN(t, b.Consumer("g2"), []string{"resume"}, 3))
+	// 新的消费组从最早保留的消息开始消费
+	assert.Equal(t, []string{"b"}, consumeN(t, b.Consumer("g3"), []string{"resume"}, 1))
+}
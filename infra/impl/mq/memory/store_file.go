@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	mq "mianshiba/infra/contract/mq"
+)
+
+const (
+	fileRecordMessage = "message"
+	fileRecordCommit  = "commit"
+)
+
+// fileRecord 文件中的一行：一条消息或一次提交
+type fileRecord struct {
+	Type    string `json:"type"`
+	Topic   string `json:"topic"`
+	Offset  int64  `json:"offset"`
+	Key     []byte `json:"key,omitempty"`
+	Value   []byte `json:"value,omitempty"`
+	GroupID string `json:"group_id,omitempty"`
+}
+
+// NewFileStore 以 JSON Lines 追加写入本地文件，目录不存在时自动创建
+func NewFileStore(path string) (Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory of %s: %w", path, err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	return &fileStore{path: path, file: f}, nil
+}
+
+type fileStore struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+func (s *fileStore) Load(ctx context.Context) ([]*mq.KafkaMessage, map[string]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.load()
+}
+
+func (s *fileStore) load() ([]*mq.KafkaMessage, map[string]int64, error) {
+	if _, err := s.file.Seek(0, 0); err != nil {
+		return nil, nil, err
+	}
+
+	var messages []*mq.KafkaMessage
+	offsets := make(map[string]int64)
+
+	var size int64 // 完整记录的总长度
+	reader := bufio.NewReader(s.file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// 最后一行不完整说明写入时进程退出，截掉该行，避免之后追加的记录与其拼接
+			if len(line) > 0 {
+				if err := s.file.Truncate(size); err != nil {
+					return nil, nil, fmt.Errorf("failed to truncate incomplete record: %w", err)
+				}
+			}
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		size += int64(len(line))
+
+		var record fileRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, nil, fmt.Errorf("invalid record %q: %w", line, err)
+		}
+
+		switch record.Type {
+		case fileRecordMessage:
+			messages = append(messages, &mq.KafkaMessage{
+				Topic:  record.Topic,
+				Offset: record.Offset,
+				Key:    record.Key,
+				Value:  record.Value,
+			})
+		case fileRecordCommit:
+			offsets[offsetKey(record.GroupID, record.Topic)] = record.Offset
+		}
+	}
+
+	return messages, offsets, nil
+}
+
+func (s *fileStore) Append(ctx context.Context, msg *mq.KafkaMessage) error {
+	return s.write(messageRecord(msg))
+}
+
+func (s *fileStore) Commit(ctx context.Context, groupID string, topic string, offset int64) error {
+	return s.write(commitRecord(groupID, topic, offset))
+}
+
+// Compact 将保留的消息和各消费组最新的消费进度写入新文件，再替换原文件
+func (s *fileStore) Compact(ctx context.Context, before map[string]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages, offsets, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", tmpPath, err)
+	}
+	defer os.Remove(tmpPath)
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, msg := range messages {
+		if msg.Offset < before[msg.Topic] {
+			continue
+		}
+		if err := encoder.Encode(messageRecord(msg)); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	for key, offset := range offsets {
+		sep := strings.LastIndex(key, "/")
+		if err := encoder.Encode(commitRecord(key[:sep], key[sep+1:], offset)); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", s.path, err)
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", s.path, err)
+	}
+	s.file.Close()
+	s.file = f
+
+	return nil
+}
+
+func (s *fileStore) write(record *fileRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func messageRecord(msg *mq.KafkaMessage) *fileRecord {
+	return &fileRecord{
+		Type:   fileRecordMessage,
+		Topic:  msg.Topic,
+		Offset: msg.Offset,
+		Key:    msg.Key,
+		Value:  msg.Value,
+	}
+}
+
+func commitRecord(groupID string, topic string, offset int64) *fileRecord {
+	return &fileRecord{
+		Type:    fileRecordCommit,
+		Topic:   topic,
+		Offset:  offset,
+		GroupID: groupID,
+	}
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	mq "mianshiba/infra/contract/mq"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// mqMessage mq_message 表中的一条消息
+type mqMessage struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement:true"`
+	Topic     string `gorm:"column:topic"`
+	MsgOffset int64  `gorm:"column:msg_offset"`
+	MsgKey    []byte `gorm:"column:msg_key"`
+	Payload   []byte `gorm:"column:payload"`
+	CreatedAt int64  `gorm:"column:created_at;autoCreateTime:false"`
+}
+
+func (*mqMessage) TableName() string {
+	return "mq_message"
+}
+
+// mqConsumerOffset mq_consumer_offset 表中一个消费组在一个主题上的消费进度
+type mqConsumerOffset struct {
+	GroupID    string `gorm:"column:group_id;primaryKey"`
+	Topic      string `gorm:"column:topic;primaryKey"`
+	NextOffset int64  `gorm:"column:next_offset"`
+	UpdatedAt  int64  `gorm:"column:updated_at;autoUpdateTime:false"`
+}
+
+func (*mqConsumerOffset) TableName() string {
+	return "mq_consumer_offset"
+}
+
+// NewMySQLStore 将消息和消费进度写入 mq_message、mq_consumer_offset 表
+func NewMySQLStore(db *gorm.DB) Store {
+	return &mysqlStore{db: db}
+}
+
+type mysqlStore struct {
+	db *gorm.DB
+}
+
+func (s *mysqlStore) Load(ctx context.Context) ([]*mq.KafkaMessage, map[string]int64, error) {
+	var rows []*mqMessage
+	if err := s.db.WithContext(ctx).Order("id").Find(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	messages := make([]*mq.KafkaMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, &mq.KafkaMessage{
+			Topic:  row.Topic,
+			Offset: row.MsgOffset,
+			Key:    row.MsgKey,
+			Value:  row.Payload,
+		})
+	}
+
+	var commits []*mqConsumerOffset
+	if err := s.db.WithContext(ctx).Find(&commits).Error; err != nil {
+		return nil, nil, err
+	}
+
+	offsets := make(map[string]int64, len(commits))
+	for _, commit := range commits {
+		offsets[offsetKey(commit.GroupID, commit.Topic)] = commit.NextOffset
+	}
+
+	return messages, offsets, nil
+}
+
+func (s *mysqlStore) Append(ctx context.Context, msg *mq.KafkaMessage) error {
+	return s.db.WithContext(ctx).Create(&mqMessage{
+		Topic:     msg.Topic,
+		MsgOffset: msg.Offset,
+		MsgKey:    msg.Key,
+		Payload:   msg.Value,
+		CreatedAt: time.Now().UnixMilli(),
+	}).Error
+}
+
+func (s *mysqlStore) Commit(ctx context.Context, groupID string, topic string, offset int64) error {
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"next_offset", "updated_at"}),
+	}).Create(&mqConsumerOffset{
+		GroupID:    groupID,
+		Topic:      topic,
+		NextOffset: offset,
+		UpdatedAt:  time.Now().UnixMilli(),
+	}).Error
+}
+
+func (s *mysqlStore) Compact(ctx context.Context, before map[string]int64) error {
+	for topic, offset := range before {
+		err := s.db.WithContext(ctx).Where("topic = ? AND msg_offset < ?", topic, offset).Delete(&mqMessage{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package kafka
+
+import (
+	"expvar"
+	"fmt"
+	mq "mianshiba/infra/contract/mq"
+)
+
+// consumerMetrics 消费端指标，通过 expvar 发布在 /debug/vars 的 mq_consumer 下
+var consumerMetrics = newMetrics()
+
+type metrics struct {
+	inflight  *expvar.Int // 处理中的消息数
+	processed *expvar.Int // 处理成功（含投递到死信主题）的消息数
+	failed    *expvar.Int // 处理失败导致消费停止的消息数
+	lag       *expvar.Map // 各分区的积压消息数，key 为 topic/partition
+}
+
+func newMetrics() *metrics {
+	m := &metrics{
+		inflight:  new(expvar.Int),
+		processed: new(expvar.Int),
+		failed:    new(expvar.Int),
+		lag:       new(expvar.Map).Init(),
+	}
+
+	vars := expvar.NewMap("mq_consumer")
+	vars.Set("inflight", m.inflight)
+	vars.Set("processed", m.processed)
+	vars.Set("failed", m.failed)
+	vars.Set("lag", m.lag)
+
+	return m
+}
+
+func (m *metrics) setLag(lag *mq.PartitionLag) {
+	v := new(expvar.Int)
+	v.Set(lag.Lag())
+	m.lag.Set(fmt.Sprintf("%s/%d", lag.Topic, lag.Partition), v)
+}
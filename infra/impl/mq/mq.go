@@ -0,0 +1,133 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"mianshiba/conf"
+	mq "mianshiba/infra/contract/mq"
+	"mianshiba/infra/impl/mq/memory"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+const (
+	BackendKafka  = "kafka"
+	BackendMemory = "memory" // 进程内消息队列，生产者和消费者须在同一进程内
+
+	persistFile  = "file"
+	persistMySQL = "mysql"
+
+	defaultMemoryPath = "./data/mq.log"
+)
+
+var (
+	brokerOnce sync.Once
+	broker     *memory.Broker
+	brokerErr  error
+)
+
+// Backend 当前配置的消息队列实现
+func Backend() string {
+	if conf.Global.Kafka.Backend == "" {
+		return BackendKafka
+	}
+	return conf.Global.Kafka.Backend
+}
+
+// NewProducer 按配置创建生产者；db 仅在 memory 实现持久化到 MySQL 时使用
+func NewProducer(ctx context.Context, db *gorm.DB) (mq.KafkaProducer, error) {
+	switch Backend() {
+	case BackendKafka:
+		return newKafkaProducer("mianshiba-producer", "1") // 至少一个副本确认
+	case BackendMemory:
+		b, err := memoryBroker(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		return b.Producer(), nil
+	default:
+		return nil, fmt.Errorf("unknown mq backend %q", conf.Global.Kafka.Backend)
+	}
+}
+
+// NewConsumer 按配置创建消费者：多个 worker 并发处理，失败的消息按重试策略重试，仍失败时投递到死信主题
+// db 仅在 memory 实现持久化到 MySQL 时使用
+func NewConsumer(ctx context.Context, db *gorm.DB) (mq.KafkaConsumer, error) {
+	retry, err := newRetryPolicy(&conf.Global.Kafka.Retry)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := newPoolOptions(&conf.Global.Kafka.Consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &retryConsumer{
+		groupID:         conf.Global.Kafka.GroupID,
+		deadLetterTopic: conf.Global.Kafka.DeadLetterTopic,
+		retry:           retry,
+	}
+
+	switch Backend() {
+	case BackendKafka:
+		if rc.deadLetterTopic != "" {
+			// 死信消息不能丢失
+			if rc.deadLetter, err = newKafkaProducer("mianshiba-dead-letter", "all"); err != nil {
+				return nil, fmt.Errorf("failed to create dead letter producer: %w", err)
+			}
+		}
+		kc, err := newKafkaConsumer()
+		if err != nil {
+			if rc.deadLetter != nil {
+				rc.deadLetter.Close()
+			}
+			return nil, err
+		}
+		rc.consumer = newPoolConsumer(kc, opts)
+	case BackendMemory:
+		b, err := memoryBroker(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		rc.consumer = newPoolConsumer(b.Consumer(rc.groupID), opts)
+		if rc.deadLetterTopic != "" {
+			rc.deadLetter = b.Producer()
+		}
+	default:
+		return nil, fmt.Errorf("unknown mq backend %q", conf.Global.Kafka.Backend)
+	}
+
+	return rc, nil
+}
+
+// memoryBroker 进程内共用的 Broker，首次调用时按配置创建
+func memoryBroker(ctx context.Context, db *gorm.DB) (*memory.Broker, error) {
+	brokerOnce.Do(func() {
+		var store memory.Store
+		switch cfg := conf.Global.Kafka.Memory; cfg.Persist {
+		case "":
+		case persistFile:
+			path := cfg.Path
+			if path == "" {
+				path = defaultMemoryPath
+			}
+			if store, brokerErr = memory.NewFileStore(path); brokerErr != nil {
+				return
+			}
+		case persistMySQL:
+			if db == nil {
+				brokerErr = fmt.Errorf("mysql persistence of memory mq requires a db connection")
+				return
+			}
+			store = memory.NewMySQLStore(db)
+		default:
+			brokerErr = fmt.Errorf("unknown memory mq persist %q", cfg.Persist)
+			return
+		}
+
+		broker, brokerErr = memory.NewBroker(ctx, store)
+	})
+
+	return broker, brokerErr
+}
@@ -0,0 +1,287 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"mianshiba/conf"
+	mq "mianshiba/infra/contract/mq"
+	"mianshiba/pkg/logs"
+	"time"
+)
+
+const (
+	defaultWorkers         = 4
+	defaultShutdownTimeout = 5 * time.Minute
+	defaultLagInterval     = 30 * time.Second
+)
+
+// source 按分区顺序读取消息的底层消费者，由 poolConsumer 并发处理并按分区提交偏移量
+type source interface {
+	Subscribe(topics []string) error
+	// Read 读取下一条消息，ctx 取消时返回 ctx.Err()
+	Read(ctx context.Context) (*mq.KafkaMessage, error)
+	// Commit 提交分区的消费进度，offset 为下一条待消费消息的偏移量
+	Commit(ctx context.Context, topic string, partition int32, offset int64) error
+	// Lag 各分区的积压消息数
+	Lag(ctx context.Context) ([]mq.PartitionLag, error)
+	Close() error
+}
+
+// poolOptions 并发处理的配置
+type poolOptions struct {
+	workers         int
+	shutdownTimeout time.Duration
+	lagInterval     time.Duration
+}
+
+func newPoolOptions(cfg *conf.KafkaConsumerConfig) (poolOptions, error) {
+	opts := poolOptions{
+		workers:         cfg.Workers,
+		shutdownTimeout: defaultShutdownTimeout,
+		lagInterval:     defaultLagInterval,
+	}
+	if opts.workers <= 0 {
+		opts.workers = defaultWorkers
+	}
+
+	var err error
+	if cfg.ShutdownTimeout != "" {
+		if opts.shutdownTimeout, err = time.ParseDuration(cfg.ShutdownTimeout); err != nil {
+			return opts, fmt.Errorf("invalid kafka consumer shutdown_timeout %q: %w", cfg.ShutdownTimeout, err)
+		}
+	}
+	if cfg.LagInterval != "" {
+		if opts.lagInterval, err = time.ParseDuration(cfg.LagInterval); err != nil {
+			return opts, fmt.Errorf("invalid kafka consumer lag_interval %q: %w", cfg.LagInterval, err)
+		}
+	}
+
+	return opts, nil
+}
+
+// ShutdownTimeout 消费者退出时等待处理中消息完成的时长
+func ShutdownTimeout() (time.Duration, error) {
+	opts, err := newPoolOptions(&conf.Global.Kafka.Consumer)
+	return opts.shutdownTimeout, err
+}
+
+// poolConsumer 由多个 worker 并发处理消息：同一分区内 key 相同的消息交给同一个 worker，按顺序处理；
+// 每个分区只提交连续处理完成的最大偏移量，未完成的消息及其之后的消息在重启后重新投递
+type poolConsumer struct {
+	source source
+	opts   poolOptions
+}
+
+func newPoolConsumer(source source, opts poolOptions) *poolConsumer {
+	return &poolConsumer{source: source, opts: opts}
+}
+
+// handled worker 处理完一条消息
+type handled struct {
+	msg *mq.KafkaMessage
+	err error
+}
+
+// Consume 开始消费指定主题的消息，直到 ctx 取消或处理器返回错误
+// 停止后不再读取新消息，等待处理中的消息完成（最多 shutdownTimeout，超时后取消其 ctx）并提交偏移量后返回
+func (pc *poolConsumer) Consume(ctx context.Context, topics []string, handler func(ctx context.Context, message *mq.KafkaMessage) error) error {
+	if err := pc.source.Subscribe(topics); err != nil {
+		return err
+	}
+
+	// 处理中的消息不随 ctx 取消，退出时等待其完成
+	handleCtx, cancelHandle := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandle()
+	readCtx, stopRead := context.WithCancel(ctx)
+	defer stopRead()
+
+	// 处理中的消息数不超过 maxInflight，读取随之暂停，worker 的队列和结果通道因此不会阻塞
+	maxInflight := pc.opts.workers * 2
+	results := make(chan handled, maxInflight)
+	queues := make([]chan *mq.KafkaMessage, pc.opts.workers)
+	for i := range queues {
+		queues[i] = make(chan *mq.KafkaMessage, maxInflight)
+		go func(queue <-chan *mq.KafkaMessage) {
+			for msg := range queue {
+				results <- handled{msg: msg, err: handler(handleCtx, msg)}
+			}
+		}(queues[i])
+	}
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+	}()
+
+	messages := make(chan *mq.KafkaMessage)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := pc.source.Read(readCtx)
+			if err != nil {
+				readErr <- err
+				return
+			}
+			select {
+			case messages <- msg:
+			case <-readCtx.Done():
+				readErr <- readCtx.Err()
+				return
+			}
+		}
+	}()
+
+	lagTicker := time.NewTicker(pc.opts.lagInterval)
+	defer lagTicker.Stop()
+
+	tracker := newOffsetTracker()
+	inflight := 0
+	stopping := false
+	var stopErr error
+	var shutdownTimer <-chan time.Time
+
+	cancelled := ctx.Done()
+	commitCtx := context.WithoutCancel(ctx)
+
+	stop := func(err error) {
+		if stopping {
+			return
+		}
+		stopping = true
+		cancelled = nil
+		stopErr = err
+		stopRead()
+		shutdownTimer = time.After(pc.opts.shutdownTimeout)
+		if inflight > 0 {
+			logs.Infof("Consumer stopping, waiting for %d in-flight messages", inflight)
+		}
+	}
+
+	for !stopping || inflight > 0 {
+		var incoming <-chan *mq.KafkaMessage
+		if !stopping && inflight < maxInflight {
+			incoming = messages
+		}
+
+		select {
+		case msg := <-incoming:
+			tracker.add(msg)
+			queues[pc.worker(msg)] <- msg
+			inflight++
+			consumerMetrics.inflight.Set(int64(inflight))
+
+		case r := <-results:
+			inflight--
+			consumerMetrics.inflight.Set(int64(inflight))
+			if r.err != nil {
+				// 处理失败的消息不提交，其后的消息也不再提交
+				consumerMetrics.failed.Add(1)
+				stop(r.err)
+				continue
+			}
+
+			consumerMetrics.processed.Add(1)
+			if offset, ok := tracker.done(r.msg); ok {
+				if err := pc.source.Commit(commitCtx, r.msg.Topic, r.msg.Partition, offset); err != nil {
+					// 提交失败的消息会重新投递，由处理器幂等处理
+					logs.Errorf("Failed to commit offset: %v", err)
+				}
+			}
+
+		case err := <-readErr:
+			if ctx.Err() == nil {
+				stop(err)
+			} else {
+				stop(ctx.Err())
+			}
+
+		case <-cancelled:
+			stop(ctx.Err())
+
+		case <-lagTicker.C:
+			pc.reportLag(ctx)
+
+		case <-shutdownTimer:
+			logs.Errorf("Consumer shutdown timeout after %s, cancel %d in-flight messages", pc.opts.shutdownTimeout, inflight)
+			cancelHandle()
+			shutdownTimer = nil
+		}
+	}
+
+	return stopErr
+}
+
+// worker 同一分区内 key 相同的消息交给同一个 worker；没有 key 的消息无需保序，按偏移量分散
+func (pc *poolConsumer) worker(msg *mq.KafkaMessage) int {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%s/%d/", msg.Topic, msg.Partition)
+	if len(msg.Key) > 0 {
+		_, _ = h.Write(msg.Key)
+	} else {
+		_, _ = fmt.Fprintf(h, "%d", msg.Offset)
+	}
+	return int(h.Sum32() % uint32(pc.opts.workers))
+}
+
+func (pc *poolConsumer) reportLag(ctx context.Context) {
+	lags, err := pc.source.Lag(ctx)
+	if err != nil {
+		logs.Errorf("Failed to get consumer lag: %v", err)
+		return
+	}
+
+	for _, lag := range lags {
+		consumerMetrics.setLag(&lag)
+		logs.Infof("Consumer lag: topic=%s, partition=%d, committed=%d, end=%d, lag=%d",
+			lag.Topic, lag.Partition, lag.Committed, lag.End, lag.Lag())
+	}
+}
+
+func (pc *poolConsumer) Close() error {
+	return pc.source.Close()
+}
+
+// offsetTracker 记录各分区已读取但未提交的消息，计算可以提交的偏移量
+type offsetTracker struct {
+	partitions map[string]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	pending []int64        // 按读取顺序（即偏移量递增）排列的未提交消息
+	done    map[int64]bool // 已处理完成但前面仍有未完成消息的偏移量
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{partitions: make(map[string]*partitionOffsets)}
+}
+
+func (t *offsetTracker) partition(msg *mq.KafkaMessage) *partitionOffsets {
+	key := fmt.Sprintf("%s/%d", msg.Topic, msg.Partition)
+	p, ok := t.partitions[key]
+	if !ok {
+		p = &partitionOffsets{done: make(map[int64]bool)}
+		t.partitions[key] = p
+	}
+	return p
+}
+
+func (t *offsetTracker) add(msg *mq.KafkaMessage) {
+	p := t.partition(msg)
+	p.pending = append(p.pending, msg.Offset)
+}
+
+// done 标记消息处理完成，返回该分区可以提交的偏移量（下一条待消费消息的偏移量）；
+// 前面仍有未完成的消息时返回 false
+func (t *offsetTracker) done(msg *mq.KafkaMessage) (int64, bool) {
+	p := t.partition(msg)
+	p.done[msg.Offset] = true
+
+	committed := int64(-1)
+	for len(p.pending) > 0 && p.done[p.pending[0]] {
+		committed = p.pending[0] + 1
+		delete(p.done, p.pending[0])
+		p.pending = p.pending[1:]
+	}
+	return committed, committed >= 0
+}
@@ -0,0 +1,106 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	mq "mianshiba/infra/contract/mq"
+	"mianshiba/infra/impl/mq/memory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetTracker(t *testing.T) {
+	tracker := newOffsetTracker()
+	msgs := make([]*mq.KafkaMessage, 4)
+	for i := range msgs {
+		msgs[i] = &mq.KafkaMessage{Topic: "resume_parser", Offset: int64(i)}
+		tracker.add(msgs[i])
+	}
+
+	// 前面的消息未完成时不提交
+	_, ok := tracker.done(msgs[1])
+	assert.False(t, ok)
+	_, ok = tracker.done(msgs[3])
+	assert.False(t, ok)
+
+	offset, ok := tracker.done(msgs[0])
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), offset)
+
+	offset, ok = tracker.done(msgs[2])
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), offset)
+}
+
+func TestPoolConsumer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	b, err := memory.NewBroker(ctx, nil)
+	assert.NoError(t, err)
+	for i := range 20 {
+		key := fmt.Sprintf("resume/%d", i%4)
+		assert.NoError(t, b.Producer().SendMessage(ctx, "resume_parser", []byte(key), []byte(fmt.Sprint(i))))
+	}
+
+	source := b.Consumer("g")
+	pc := newPoolConsumer(source, poolOptions{workers: 4, shutdownTimeout: time.Second, lagInterval: time.Hour})
+
+	var mu sync.Mutex
+	byKey := make(map[string][]string)
+	handled := 0
+	err = pc.Consume(ctx, []string{"resume_parser"}, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		byKey[string(msg.Key)] = append(byKey[string(msg.Key)], string(msg.Value))
+		if handled++; handled == 20 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// key 相同的消息按写入顺序处理
+	assert.Len(t, byKey, 4)
+	for i := range 4 {
+		key := fmt.Sprintf("resume/%d", i)
+		assert.Equal(t, []string{fmt.Sprint(i), fmt.Sprint(i + 4), fmt.Sprint(i + 8), fmt.Sprint(i + 12), fmt.Sprint(i + 16)}, byKey[key])
+	}
+
+	lags, err := source.Lag(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), lags[0].Lag())
+}
+
+func TestPoolConsumerGracefulShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b, err := memory.NewBroker(ctx, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Producer().SendMessage(ctx, "resume_parser", []byte("k"), []byte("slow")))
+
+	source := b.Consumer("g")
+	pc := newPoolConsumer(source, poolOptions{workers: 2, shutdownTimeout: time.Second, lagInterval: time.Hour})
+
+	// 收到退出信号时处理中的消息继续完成，完成后提交
+	finished := false
+	err = pc.Consume(ctx, []string{"resume_parser"}, func(handleCtx context.Context, msg *mq.KafkaMessage) error {
+		cancel()
+		time.Sleep(20 * time.Millisecond)
+		finished = handleCtx.Err() == nil
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, finished)
+
+	lags, err := source.Lag(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), lags[0].Lag())
+}
@@ -4,21 +4,28 @@ import (
 	"context"
 	"fmt"
 	"mianshiba/conf"
-	mq "mianshiba/infra/contract/mq"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
 type kafkaProducer struct {
 	producer *kafka.Producer
+	timeout  time.Duration // 等待投递结果的超时时间
 }
 
-func NewProducer(ctx context.Context) (mq.KafkaProducer, error) {
+// newKafkaProducer 创建Kafka生产者，acks 为 broker 确认级别
+func newKafkaProducer(clientID string, acks string) (*kafkaProducer, error) {
+	timeout, err := sendTimeout()
+	if err != nil {
+		return nil, err
+	}
+
 	// 使用配置创建Kafka生产者
 	p, err := kafka.NewProducer(&kafka.ConfigMap{
 		"bootstrap.servers": conf.Global.Kafka.Brokers,
-		"client.id":         "mianshiba-producer",
-		"acks":              "1", // 至少一个副本确认
+		"client.id":         clientID,
+		"acks":              acks,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kafka producer: %v", err)
@@ -40,9 +47,11 @@ func NewProducer(ctx context.Context) (mq.KafkaProducer, error) {
 
 	return &kafkaProducer{
 		producer: p,
+		timeout:  timeout,
 	}, nil
 }
 
+// SendMessage 发送消息并等待 broker 确认，返回 nil 表示消息已写入
 func (kp *kafkaProducer) SendMessage(ctx context.Context, topic string, key []byte, value []byte) error {
 	// 创建消息
 	msg := &kafka.Message{
@@ -52,7 +61,36 @@ func (kp *kafkaProducer) SendMessage(ctx context.Context, topic string, key []by
 	}
 
 	// 发送消息到Kafka
-	return kp.producer.Produce(msg, nil)
+	deliveryChan := make(chan kafka.Event, 1)
+	if err := kp.producer.Produce(msg, deliveryChan); err != nil {
+		return err
+	}
+
+	// 等待投递结果
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(kp.timeout):
+		return fmt.Errorf("timeout waiting for delivery to topic %s", topic)
+	case e := <-deliveryChan:
+		if m, ok := e.(*kafka.Message); ok && m.TopicPartition.Error != nil {
+			return m.TopicPartition.Error
+		}
+		return nil
+	}
+}
+
+// sendTimeout 等待投递结果的超时时间
+func sendTimeout() (time.Duration, error) {
+	if conf.Global.Kafka.Timeout == "" {
+		return defaultSendTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(conf.Global.Kafka.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid kafka timeout %q: %w", conf.Global.Kafka.Timeout, err)
+	}
+	return timeout, nil
 }
 
 func (kp *kafkaProducer) Close() error {
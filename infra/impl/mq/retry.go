@@ -0,0 +1,151 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mianshiba/conf"
+	mq "mianshiba/infra/contract/mq"
+	"mianshiba/pkg/logs"
+	"time"
+)
+
+const (
+	defaultMaxAttempts  = 3
+	defaultRetryBackoff = time.Second
+	defaultMaxBackoff   = 30 * time.Second
+	defaultSendTimeout  = 5 * time.Second
+)
+
+// retryPolicy 处理失败时的重试策略
+type retryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+}
+
+func newRetryPolicy(cfg *conf.KafkaRetryConfig) (retryPolicy, error) {
+	policy := retryPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		backoff:     defaultRetryBackoff,
+		maxBackoff:  defaultMaxBackoff,
+	}
+	if policy.maxAttempts <= 0 {
+		policy.maxAttempts = defaultMaxAttempts
+	}
+
+	var err error
+	if cfg.Backoff != "" {
+		if policy.backoff, err = time.ParseDuration(cfg.Backoff); err != nil {
+			return policy, fmt.Errorf("invalid kafka retry backoff %q: %w", cfg.Backoff, err)
+		}
+	}
+	if cfg.MaxBackoff != "" {
+		if policy.maxBackoff, err = time.ParseDuration(cfg.MaxBackoff); err != nil {
+			return policy, fmt.Errorf("invalid kafka retry max_backoff %q: %w", cfg.MaxBackoff, err)
+		}
+	}
+
+	return policy, nil
+}
+
+// delay 第 attempt 次处理失败后的等待时间
+func (p retryPolicy) delay(attempt int) time.Duration {
+	delay := p.backoff
+	for i := 1; i < attempt && delay < p.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxBackoff)
+}
+
+// retryConsumer 为底层消费者增加失败重试和死信投递，各消息队列实现共用
+type retryConsumer struct {
+	consumer   mq.KafkaConsumer
+	deadLetter mq.KafkaProducer // 投递死信消息，未配置死信主题时为空
+
+	groupID         string
+	deadLetterTopic string
+	retry           retryPolicy
+}
+
+// Consume 开始消费指定主题的消息
+// 消息处理成功、或重试后仍失败并已投递到死信主题时提交偏移量；投递死信失败时返回错误，未提交的消息在重启后重新消费
+func (rc *retryConsumer) Consume(ctx context.Context, topics []string, handler func(ctx context.Context, message *mq.KafkaMessage) error) error {
+	return rc.consumer.Consume(ctx, topics, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		attempts, err := rc.handleWithRetry(ctx, msg, handler)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return rc.sendDeadLetter(ctx, msg, attempts, err)
+	})
+}
+
+// handleWithRetry 调用消息处理器，失败时按重试策略重试，不可重试的错误直接返回；返回处理次数和最后一次的错误
+func (rc *retryConsumer) handleWithRetry(ctx context.Context, msg *mq.KafkaMessage, handler func(ctx context.Context, message *mq.KafkaMessage) error) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := handler(ctx, msg)
+		if err == nil {
+			return attempt, nil
+		}
+
+		logs.Errorf("Failed to handle message from topic %s, partition %d, offset %d (attempt %d/%d): %v",
+			msg.Topic, msg.Partition, msg.Offset, attempt, rc.retry.maxAttempts, err)
+
+		if mq.IsPermanent(err) || attempt >= rc.retry.maxAttempts {
+			return attempt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(rc.retry.delay(attempt)):
+		}
+	}
+}
+
+// sendDeadLetter 将处理失败的消息连同失败信息投递到死信主题，并等待投递结果
+func (rc *retryConsumer) sendDeadLetter(ctx context.Context, msg *mq.KafkaMessage, attempts int, handleErr error) error {
+	if rc.deadLetter == nil {
+		logs.Errorf("Dead letter topic not configured, drop message from topic %s, partition %d, offset %d, value: %s",
+			msg.Topic, msg.Partition, msg.Offset, string(msg.Value))
+		return nil
+	}
+
+	value, err := json.Marshal(&mq.DeadLetter{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		GroupID:   rc.groupID,
+		Error:     handleErr.Error(),
+		Attempts:  attempts,
+		Permanent: mq.IsPermanent(handleErr),
+		FailedAt:  time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead letter: %v", err)
+	}
+
+	if err := rc.deadLetter.SendMessage(ctx, rc.deadLetterTopic, msg.Key, value); err != nil {
+		return fmt.Errorf("failed to send dead letter to topic %s: %v", rc.deadLetterTopic, err)
+	}
+
+	logs.Infof("Message from topic %s, partition %d, offset %d moved to dead letter topic %s after %d attempts",
+		msg.Topic, msg.Partition, msg.Offset, rc.deadLetterTopic, attempts)
+	return nil
+}
+
+// Close 关闭底层消费者和死信生产者
+func (rc *retryConsumer) Close() error {
+	err := rc.consumer.Close()
+	if rc.deadLetter != nil {
+		if closeErr := rc.deadLetter.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
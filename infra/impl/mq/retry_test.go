@@ -0,0 +1,100 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"mianshiba/conf"
+	mq "mianshiba/infra/contract/mq"
+	"mianshiba/infra/impl/mq/memory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy, err := newRetryPolicy(&conf.KafkaRetryConfig{Backoff: "1s", MaxBackoff: "5s"})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultMaxAttempts, policy.maxAttempts)
+	assert.Equal(t, time.Second, policy.delay(1))
+	assert.Equal(t, 2*time.Second, policy.delay(2))
+	assert.Equal(t, 4*time.Second, policy.delay(3))
+	assert.Equal(t, 5*time.Second, policy.delay(4))
+
+	_, err = newRetryPolicy(&conf.KafkaRetryConfig{Backoff: "soon"})
+	assert.Error(t, err)
+}
+
+func TestHandleWithRetry(t *testing.T) {
+	rc := &retryConsumer{retry: retryPolicy{maxAttempts: 3, backoff: time.Millisecond, maxBackoff: time.Millisecond}}
+	msg := &mq.KafkaMessage{Topic: "resume_parser"}
+
+	calls := 0
+	attempts, err := rc.handleWithRetry(context.Background(), msg, func(ctx context.Context, message *mq.KafkaMessage) error {
+		calls++
+		if calls < 2 {
+			return errors.New("db unavailable")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	attempts, err = rc.handleWithRetry(context.Background(), msg, func(ctx context.Context, message *mq.KafkaMessage) error {
+		return errors.New("db unavailable")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// 不可重试的错误只处理一次
+	attempts, err = rc.handleWithRetry(context.Background(), msg, func(ctx context.Context, message *mq.KafkaMessage) error {
+		return mq.Permanent(errors.New("invalid character"))
+	})
+	assert.True(t, mq.IsPermanent(err))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryConsumerDeadLetter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	b, err := memory.NewBroker(ctx, nil)
+	assert.NoError(t, err)
+	rc := &retryConsumer{
+		consumer:        b.Consumer("g"),
+		deadLetter:      b.Producer(),
+		groupID:         "g",
+		deadLetterTopic: "dead_letter",
+		retry:           retryPolicy{maxAttempts: 2, backoff: time.Millisecond, maxBackoff: time.Millisecond},
+	}
+
+	p := b.Producer()
+	assert.NoError(t, p.SendMessage(ctx, "resume_parser", []byte("k"), []byte("bad")))
+	assert.NoError(t, p.SendMessage(ctx, "resume_parser", []byte("k"), []byte("good")))
+
+	// 失败的消息投递到死信主题后继续消费下一条
+	var handled []string
+	err = rc.Consume(ctx, []string{"resume_parser"}, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		handled = append(handled, string(msg.Value))
+		if string(msg.Value) == "bad" {
+			return errors.New("db unavailable")
+		}
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"bad", "bad", "good"}, handled)
+
+	var deadLetter mq.DeadLetter
+	err = b.Consumer("admin").Consume(context.Background(), []string{"dead_letter"}, func(ctx context.Context, msg *mq.KafkaMessage) error {
+		assert.NoError(t, json.Unmarshal(msg.Value, &deadLetter))
+		return errors.New("stop")
+	})
+	assert.EqualError(t, err, "stop")
+	assert.Equal(t, "resume_parser", deadLetter.Topic)
+	assert.Equal(t, []byte("bad"), deadLetter.Value)
+	assert.Equal(t, 2, deadLetter.Attempts)
+	assert.Equal(t, "db unavailable", deadLetter.Error)
+}
@@ -0,0 +1,46 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"mianshiba/infra/contract/ocr"
+)
+
+// OCR 测试用的识别器，依次返回预置的文本，不依赖本地 OCR 引擎
+type OCR struct {
+	Texts []string // 按调用顺序返回，调用次数超过时重复最后一条
+	Err   error
+
+	mu     sync.Mutex
+	images [][]byte
+}
+
+var _ ocr.OCR = (*OCR)(nil)
+
+func New(texts ...string) *OCR {
+	return &OCR{Texts: texts}
+}
+
+func (f *OCR) Recognize(ctx context.Context, image []byte) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.images = append(f.images, image)
+	if f.Err != nil {
+		return "", f.Err
+	}
+	if len(f.Texts) == 0 {
+		return "", nil
+	}
+
+	return f.Texts[min(len(f.images), len(f.Texts))-1], nil
+}
+
+// Images 返回收到的图片，便于断言调用次数和内容
+func (f *OCR) Images() [][]byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.images
+}
@@ -0,0 +1,77 @@
+package tesseract
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"mianshiba/conf"
+	"mianshiba/infra/contract/ocr"
+)
+
+const (
+	defaultBinary    = "tesseract"
+	defaultLanguages = "chi_sim+eng"
+	defaultTimeout   = 30 * time.Second
+)
+
+// New 按全局配置创建 tesseract 识别器，需要本地已安装 tesseract 及对应语言包
+func New(ctx context.Context) (ocr.OCR, error) {
+	return NewWithConfig(&conf.Global.OCR)
+}
+
+func NewWithConfig(cfg *conf.OCRConfig) (ocr.OCR, error) {
+	binary := cfg.Binary
+	if binary == "" {
+		binary = defaultBinary
+	}
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, fmt.Errorf("tesseract not found, binary=%s, err=%w", binary, err)
+	}
+
+	languages := cfg.Languages
+	if languages == "" {
+		languages = defaultLanguages
+	}
+
+	timeout := defaultTimeout
+	if cfg.Timeout != "" {
+		timeout, err = time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ocr timeout %q: %w", cfg.Timeout, err)
+		}
+	}
+
+	return &tesseractOCR{binary: path, languages: languages, timeout: timeout}, nil
+}
+
+type tesseractOCR struct {
+	binary    string
+	languages string
+	timeout   time.Duration
+}
+
+// Recognize 通过标准输入传入图片，从标准输出读取识别结果，不落临时文件
+func (t *tesseractOCR) Recognize(ctx context.Context, image []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.binary, "stdin", "stdout", "-l", t.languages, "--psm", "3")
+	cmd.Stdin = bytes.NewReader(image)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("tesseract timeout after %s: %w", t.timeout, ctx.Err())
+		}
+		return "", fmt.Errorf("tesseract failed: %w, stderr: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
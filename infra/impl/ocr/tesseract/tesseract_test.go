@@ -0,0 +1,40 @@
+package tesseract
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mianshiba/conf"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 用脚本代替 tesseract：校验参数并原样输出标准输入
+const stubScript = `#!/bin/sh
+[ "$1" = "stdin" ] && [ "$2" = "stdout" ] && [ "$4" = "chi_sim" ] || { echo "bad args: $*" >&2; exit 1; }
+cat
+`
+
+func TestTesseractRecognize(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "tesseract")
+	assert.NoError(t, os.WriteFile(binary, []byte(stubScript), 0o755))
+
+	engine, err := NewWithConfig(&conf.OCRConfig{Binary: binary, Languages: "chi_sim", Timeout: "5s"})
+	assert.NoError(t, err)
+
+	text, err := engine.Recognize(context.Background(), []byte("张三 后端工程师"))
+	assert.NoError(t, err)
+	assert.Equal(t, "张三 后端工程师", text)
+
+	engine, err = NewWithConfig(&conf.OCRConfig{Binary: binary, Languages: "eng"})
+	assert.NoError(t, err)
+	_, err = engine.Recognize(context.Background(), []byte("x"))
+	assert.ErrorContains(t, err, "bad args")
+}
+
+func TestTesseractNotInstalled(t *testing.T) {
+	_, err := NewWithConfig(&conf.OCRConfig{Binary: filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+}
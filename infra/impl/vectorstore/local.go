@@ -0,0 +1,288 @@
+package vectorstore
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"mianshiba/infra/contract/vectorstore"
+)
+
+const (
+	fileRecordUpsert = "upsert"
+	fileRecordDelete = "delete"
+
+	// 文件中已失效的记录超过有效记录数且不少于该值时，加载时重写文件
+	minCompactRecords = 100
+)
+
+// fileRecord 文件中的一行：写入或删除一条向量
+type fileRecord struct {
+	Op     string    `json:"op"`
+	ID     string    `json:"id"`
+	Vector []float64 `json:"vector,omitempty"`
+	Digest string    `json:"digest,omitempty"`
+}
+
+// NewLocalStore 进程内的向量存储，检索时逐条计算相似度，适合万级以下的数据量
+// path 不为空时以 JSON Lines 追加写入本地文件，启动时从文件恢复；为空时只保存在内存中
+func NewLocalStore(path string) (vectorstore.Store, error) {
+	s := &localStore{vectors: make(map[string]*vectorstore.Vector)}
+	if path == "" {
+		return s, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory of %s: %w", path, err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	s.path = path
+	s.file = f
+
+	records, err := s.load()
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	if stale := records - len(s.vectors); stale >= minCompactRecords && stale > len(s.vectors) {
+		if err = s.compact(); err != nil {
+			_ = s.file.Close()
+			return nil, fmt.Errorf("failed to compact %s: %w", path, err)
+		}
+	}
+
+	return s, nil
+}
+
+type localStore struct {
+	mu      sync.RWMutex
+	vectors map[string]*vectorstore.Vector // 向量已归一化，相似度即点积
+
+	path string
+	file *os.File
+}
+
+func (s *localStore) Upsert(ctx context.Context, vectors ...*vectorstore.Vector) error {
+	records := make([]*fileRecord, 0, len(vectors))
+	for _, v := range vectors {
+		normalized := normalize(v.Vector)
+		if normalized == nil {
+			return fmt.Errorf("vector of %s is empty or zero", v.ID)
+		}
+		records = append(records, &fileRecord{Op: fileRecordUpsert, ID: v.ID, Vector: normalized, Digest: v.Digest})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(records); err != nil {
+		return err
+	}
+	for _, r := range records {
+		s.vectors[r.ID] = &vectorstore.Vector{ID: r.ID, Vector: r.Vector, Digest: r.Digest}
+	}
+	return nil
+}
+
+func (s *localStore) Delete(ctx context.Context, ids ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make([]*fileRecord, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := s.vectors[id]; ok {
+			records = append(records, &fileRecord{Op: fileRecordDelete, ID: id})
+		}
+	}
+
+	if err := s.write(records); err != nil {
+		return err
+	}
+	for _, r := range records {
+		delete(s.vectors, r.ID)
+	}
+	return nil
+}
+
+func (s *localStore) Search(ctx context.Context, query []float64, topK int) ([]*vectorstore.Hit, error) {
+	query = normalize(query)
+	if query == nil {
+		return nil, fmt.Errorf("query vector is empty or zero")
+	}
+
+	s.mu.RLock()
+	hits := make([]*vectorstore.Hit, 0, len(s.vectors))
+	for id, v := range s.vectors {
+		// 更换向量模型后维度不同的旧向量等待重新向量化，检索时跳过
+		if len(v.Vector) != len(query) {
+			continue
+		}
+		hits = append(hits, &vectorstore.Hit{ID: id, Score: dot(query, v.Vector)})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if topK > 0 && len(hits) > topK {
+		hits = hits[:topK]
+	}
+	return hits, nil
+}
+
+func (s *localStore) Digests(ctx context.Context) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	digests := make(map[string]string, len(s.vectors))
+	for id, v := range s.vectors {
+		digests[id] = v.Digest
+	}
+	return digests, nil
+}
+
+// load 从文件恢复向量，返回文件中的记录数
+func (s *localStore) load() (int, error) {
+	if _, err := s.file.Seek(0, 0); err != nil {
+		return 0, err
+	}
+
+	records := 0
+	var size int64 // 完整记录的总长度
+	reader := bufio.NewReader(s.file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// 最后一行不完整说明写入时进程退出，截掉该行，避免之后追加的记录与其拼接
+			if len(line) > 0 {
+				if err := s.file.Truncate(size); err != nil {
+					return 0, fmt.Errorf("failed to truncate incomplete record: %w", err)
+				}
+			}
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		size += int64(len(line))
+
+		var record fileRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return 0, fmt.Errorf("invalid record %q: %w", line, err)
+		}
+
+		records++
+		switch record.Op {
+		case fileRecordUpsert:
+			s.vectors[record.ID] = &vectorstore.Vector{ID: record.ID, Vector: record.Vector, Digest: record.Digest}
+		case fileRecordDelete:
+			delete(s.vectors, record.ID)
+		}
+	}
+
+	return records, nil
+}
+
+// compact 只保留有效记录，写入临时文件后替换原文件
+func (s *localStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, v := range s.vectors {
+		if err = encoder.Encode(&fileRecord{Op: fileRecordUpsert, ID: v.ID, Vector: v.Vector, Digest: v.Digest}); err != nil {
+			_ = tmp.Close()
+			return err
+		}
+	}
+	if err = writer.Flush(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmpPath, s.path); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	_ = s.file.Close()
+	s.file = f
+	return nil
+}
+
+// write 追加写入文件，调用方持有写锁
+func (s *localStore) write(records []*fileRecord) error {
+	if s.file == nil || len(records) == 0 {
+		return nil
+	}
+
+	var buf []byte
+	for _, r := range records {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+
+	if _, err := s.file.Write(buf); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// normalize 返回单位向量，零向量返回 nil
+func normalize(v []float64) []float64 {
+	var norm float64
+	for _, x := range v {
+		norm += x * x
+	}
+	if norm == 0 {
+		return nil
+	}
+
+	norm = math.Sqrt(norm)
+	out := make([]float64, len(v))
+	for i, x := range v {
+		out[i] = x / norm
+	}
+	return out
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
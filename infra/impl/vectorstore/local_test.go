@@ -0,0 +1,90 @@
+package vectorstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mianshiba/infra/contract/vectorstore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStoreSearch(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStore("")
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Upsert(ctx,
+		&vectorstore.Vector{ID: "a", Vector: []float64{1, 0, 0}, Digest: "da"},
+		&vectorstore.Vector{ID: "b", Vector: []float64{1, 1, 0}, Digest: "db"},
+		&vectorstore.Vector{ID: "c", Vector: []float64{0, 0, 3}, Digest: "dc"},
+		&vectorstore.Vector{ID: "d", Vector: []float64{1, 0}, Digest: "dd"}, // 维度不同，检索时跳过
+	))
+
+	hits, err := s.Search(ctx, []float64{2, 0, 0}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, hits, 2)
+	assert.Equal(t, "a", hits[0].ID)
+	assert.InDelta(t, 1.0, hits[0].Score, 1e-9)
+	assert.Equal(t, "b", hits[1].ID)
+	assert.InDelta(t, 0.7071, hits[1].Score, 1e-4)
+
+	assert.Error(t, s.Upsert(ctx, &vectorstore.Vector{ID: "e", Vector: []float64{0, 0, 0}}))
+	_, err = s.Search(ctx, nil, 1)
+	assert.Error(t, err)
+}
+
+func TestLocalStoreFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "index", "questions.jsonl")
+
+	s, err := NewLocalStore(path)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Upsert(ctx,
+		&vectorstore.Vector{ID: "a", Vector: []float64{1, 0}, Digest: "v1"},
+		&vectorstore.Vector{ID: "b", Vector: []float64{0, 1}, Digest: "v1"},
+	))
+	assert.NoError(t, s.Upsert(ctx, &vectorstore.Vector{ID: "a", Vector: []float64{1, 1}, Digest: "v2"}))
+	assert.NoError(t, s.Delete(ctx, "b", "missing"))
+
+	// 重新打开后恢复最新状态
+	reopened, err := NewLocalStore(path)
+	assert.NoError(t, err)
+	digests, err := reopened.Digests(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "v2"}, digests)
+
+	hits, err := reopened.Search(ctx, []float64{0, 1}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+	assert.InDelta(t, 0.7071, hits[0].Score, 1e-4)
+}
+
+func TestLocalStoreFileTornTail(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "questions.jsonl")
+
+	s, err := NewLocalStore(path)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Upsert(ctx, &vectorstore.Vector{ID: "a", Vector: []float64{1, 0}, Digest: "v1"}))
+
+	// 模拟写入最后一条记录时进程退出
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	assert.NoError(t, err)
+	_, err = f.WriteString(`{"op":"upsert","id":"b","vec`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	// 重新打开时截掉不完整的记录，之后追加的记录在下次打开时仍能读取
+	s, err = NewLocalStore(path)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Upsert(ctx, &vectorstore.Vector{ID: "b", Vector: []float64{0, 1}, Digest: "v1"}))
+
+	s, err = NewLocalStore(path)
+	assert.NoError(t, err)
+	digests, err := s.Digests(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "v1", "b": "v1"}, digests)
+}
@@ -1,7 +1,6 @@
 package encrypt
 
 import (
-	"fmt"
 	"mianshiba/conf"
 
 	"github.com/coze-dev/coze-studio/backend/domain/plugin/encrypt"
@@ -21,7 +20,6 @@ func getSecret() string {
 // AES-CBC 解密实现
 func EncryptAPIKey(apiKey string) (string, error) {
 	secret := getSecret()
-	fmt.Printf("secret: %s\n", secret)
 	return encrypt.EncryptByAES([]byte(apiKey), secret)
 }
 
@@ -0,0 +1,88 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	"github.com/unidoc/unipdf/v3/extractor"
+	"github.com/unidoc/unipdf/v3/model"
+)
+
+// PageImage 页面中绘制的图片，Data 为 PNG 编码，坐标单位为 pt
+type PageImage struct {
+	Data                []byte
+	X, Y, Width, Height float64
+}
+
+// ExtractPageImages 提取指定页面中的图片，供扫描件 OCR 使用
+func ExtractPageImages(pdfBytes []byte, pageNums []int) (map[int][]*PageImage, error) {
+	pdfReader, err := model.NewPdfReader(bytes.NewReader(pdfBytes))
+	if err != nil {
+		return nil, fmt.Errorf("创建PDF阅读器失败: %v", err)
+	}
+
+	isEncrypted, err := pdfReader.IsEncrypted()
+	if err == nil && isEncrypted {
+		success, err := pdfReader.Decrypt([]byte(""))
+		if err != nil || !success {
+			return nil, fmt.Errorf("PDF已加密，无法解密")
+		}
+	}
+
+	result := make(map[int][]*PageImage, len(pageNums))
+	for _, pageNum := range pageNums {
+		images, err := extractImages(pdfReader, pageNum)
+		if err != nil {
+			return nil, fmt.Errorf("提取第%d页图片失败: %v", pageNum, err)
+		}
+		result[pageNum] = images
+	}
+
+	return result, nil
+}
+
+func extractImages(pdfReader *model.PdfReader, pageNum int) (images []*PageImage, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("解析页面异常: %v", r)
+		}
+	}()
+
+	page, err := pdfReader.GetPage(pageNum)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := extractor.New(page)
+	if err != nil {
+		return nil, err
+	}
+
+	marks, err := ex.ExtractPageImages(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mark := range marks.Images {
+		img, err := mark.Image.ToGoImage()
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+
+		images = append(images, &PageImage{
+			Data:   buf.Bytes(),
+			X:      mark.X,
+			Y:      mark.Y,
+			Width:  mark.Width,
+			Height: mark.Height,
+		})
+	}
+
+	return images, nil
+}
@@ -60,17 +60,24 @@ func TryParsePDFWithMultipleEncodings(pdfBytes []byte) (string, error) {
 	return "", fmt.Errorf("所有编码尝试失败: %v", lastErr)
 }
 
-// ParsePDFContentWithEncoding 支持编码转换的PDF解析
-func ParsePDFContentWithEncoding(pdfBytes []byte, encoding string) (string, error) {
+// PageText 单页文本提取结果，Err 非空表示该页提取失败
+type PageText struct {
+	Number int
+	Text   string
+	Err    error
+}
+
+// ExtractPages 逐页提取文本，失败的页面通过 Err 返回，不会被跳过
+func ExtractPages(pdfBytes []byte, encoding string) ([]*PageText, error) {
 	if len(pdfBytes) == 0 {
-		return "", fmt.Errorf("PDF字节数据为空")
+		return nil, fmt.Errorf("PDF字节数据为空")
 	}
 
 	// 创建PDF阅读器
 	reader := bytes.NewReader(pdfBytes)
 	pdfReader, err := model.NewPdfReader(reader)
 	if err != nil {
-		return "", fmt.Errorf("创建PDF阅读器失败: %v", err)
+		return nil, fmt.Errorf("创建PDF阅读器失败: %v", err)
 	}
 
 	// 检查加密
@@ -78,46 +85,77 @@ func ParsePDFContentWithEncoding(pdfBytes []byte, encoding string) (string, erro
 	if err == nil && isEncrypted {
 		success, err := pdfReader.Decrypt([]byte(""))
 		if err != nil || !success {
-			return "", fmt.Errorf("PDF已加密，无法解密")
+			return nil, fmt.Errorf("PDF已加密，无法解密")
 		}
 	}
 
 	// 获取页数
 	numPages, err := pdfReader.GetNumPages()
 	if err != nil {
-		return "", fmt.Errorf("获取页数失败: %v", err)
+		return nil, fmt.Errorf("获取页数失败: %v", err)
 	}
 
-	var allText strings.Builder
-	allText.WriteString(fmt.Sprintf("PDF总页数: %d\n\n", numPages))
-
-	// 逐页提取文本
+	pages := make([]*PageText, 0, numPages)
 	for pageNum := 1; pageNum <= numPages; pageNum++ {
-		page, err := pdfReader.GetPage(pageNum)
-		if err != nil {
-			continue // 跳过错误页
+		text, err := extractPageText(pdfReader, pageNum)
+		if err == nil && encoding != "" {
+			// 编码转换
+			text, err = convertEncoding(text, encoding)
+			if err != nil {
+				fmt.Printf("编码转换失败(第%d页): %v\n", pageNum, err)
+				err = nil
+			}
 		}
+		pages = append(pages, &PageText{Number: pageNum, Text: text, Err: err})
+	}
 
-		extractor, err := extractor.New(page)
-		if err != nil {
-			continue
-		}
+	return pages, nil
+}
 
-		text, err := extractor.ExtractText()
-		if err != nil {
-			continue
+func extractPageText(pdfReader *model.PdfReader, pageNum int) (text string, err error) {
+	// 损坏的页面可能导致解析库 panic，只影响当前页
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("解析页面异常: %v", r)
 		}
+	}()
 
-		// 编码转换
-		if encoding != "" {
-			text, err = convertEncoding(text, encoding)
-			if err != nil {
-				fmt.Printf("编码转换失败(第%d页): %v\n", pageNum, err)
-			}
+	page, err := pdfReader.GetPage(pageNum)
+	if err != nil {
+		return "", fmt.Errorf("获取页面失败: %v", err)
+	}
+
+	ex, err := extractor.New(page)
+	if err != nil {
+		return "", fmt.Errorf("创建文本提取器失败: %v", err)
+	}
+
+	text, err = ex.ExtractText()
+	if err != nil {
+		return "", fmt.Errorf("提取文本失败: %v", err)
+	}
+
+	return text, nil
+}
+
+// ParsePDFContentWithEncoding 支持编码转换的PDF解析，提取失败的页面会在结果中注明
+func ParsePDFContentWithEncoding(pdfBytes []byte, encoding string) (string, error) {
+	pages, err := ExtractPages(pdfBytes, encoding)
+	if err != nil {
+		return "", err
+	}
+
+	var allText strings.Builder
+	allText.WriteString(fmt.Sprintf("PDF总页数: %d\n\n", len(pages)))
+
+	for _, page := range pages {
+		if page.Err != nil {
+			allText.WriteString(fmt.Sprintf("=== 第 %d 页（解析失败：%v）===\n\n", page.Number, page.Err))
+			continue
 		}
 
-		allText.WriteString(fmt.Sprintf("=== 第 %d 页 ===\n", pageNum))
-		allText.WriteString(text)
+		allText.WriteString(fmt.Sprintf("=== 第 %d 页 ===\n", page.Number))
+		allText.WriteString(page.Text)
 		allText.WriteString("\n\n")
 	}
 
@@ -33,11 +33,35 @@ import (
 
 var path2Table2Columns2Model = map[string]map[string]map[string]any{
 	"domain/user/dal/query": {
-		"user":       {},
-		"user_model": {},
+		"user":             {},
+		"user_model":       {},
+		"user_token_usage": {},
 	},
 	"domain/interview/dal/query": {
 		"resume": {},
+		"interview_session": {
+			"focus_areas":         []string{},
+			"question_directions": []string{},
+			"tech_stack":          []string{},
+		},
+		"interview_turn": {},
+		"interview_evaluation": {
+			"strengths":   []string{},
+			"gaps":        []string{},
+			"suggestions": []string{},
+		},
+		"interview_answer_score":  {},
+		"resume_profile_version":  {},
+		"resume_parse_attempt":    {},
+		"outbox_event":            {},
+		"job_description":         {},
+		"interview_plan":          {},
+		"interview_plan_round":    {},
+		"interview_session_round": {},
+	},
+	"domain/question/dal/query": {
+		"question":     {},
+		"question_tag": {},
 	},
 }
 
@@ -0,0 +1,24 @@
+package errno
+
+// Interview: 701 000 000 ~ 701 999 999
+const (
+	ErrInterviewResumeNotFoundCode     = 701000001
+	ErrInterviewResumeNotParsedCode    = 701000002
+	ErrInterviewSessionNotFoundCode    = 701000003
+	ErrInterviewSessionEndedCode       = 701000004
+	ErrInterviewPermissionCode         = 701000005
+	ErrInterviewInvalidParamCode       = 701000006
+	ErrInterviewQuestionNotReadyCode   = 701000007
+	ErrInterviewSessionNotEndedCode    = 701000008
+	ErrInterviewEvaluationNotFoundCode = 701000009
+	ErrInterviewResumeFileTypeCode     = 701000010
+	ErrInterviewResumeParseStatusCode  = 701000011
+
+	ErrInterviewJobDescriptionNotFoundCode    = 701000012
+	ErrInterviewJobDescriptionContentCode     = 701000013
+	ErrInterviewJobDescriptionNotParsedCode   = 701000014
+	ErrInterviewJobDescriptionParseStatusCode = 701000015
+
+	ErrInterviewPlanNotFoundCode = 701000016
+	ErrInterviewPlanInvalidCode  = 701000017
+)
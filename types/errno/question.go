@@ -0,0 +1,9 @@
+package errno
+
+// Question: 702 000 000 ~ 702 999 999
+const (
+	ErrQuestionNotFoundCode     = 702000001
+	ErrQuestionInvalidParamCode = 702000002
+	ErrQuestionPermissionCode   = 702000003
+	ErrQuestionImportFormatCode = 702000004
+)
@@ -12,4 +12,7 @@ const (
 	ErrUserInvalidParamCode           = 700000006
 	ErrUserPermissionCode             = 700000007
 	ErrNotAllowedRegisterCode         = 700000008
+	ErrUserModelNameExistCode         = 700000009
+	ErrUserModelNotFoundCode          = 700000010
+	ErrUserTokenQuotaExceededCode     = 700000011
 )